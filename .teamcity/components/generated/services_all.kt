@@ -15,14 +15,17 @@ val services = mapOf(
     "appflow" to ServiceSpec("AppFlow"),
     "appintegrations" to ServiceSpec("AppIntegrations"),
     "applicationinsights" to ServiceSpec("CloudWatch Application Insights"),
+    "applicationsignals" to ServiceSpec("CloudWatch Application Signals"),
     "appmesh" to ServiceSpec("App Mesh"),
     "apprunner" to ServiceSpec("App Runner"),
     "appstream" to ServiceSpec("AppStream 2.0", vpcLock = true, parallelismOverride = 10),
     "appsync" to ServiceSpec("AppSync"),
+    "artifact" to ServiceSpec("Artifact"),
     "athena" to ServiceSpec("Athena"),
     "auditmanager" to ServiceSpec("Audit Manager"),
     "autoscaling" to ServiceSpec("Auto Scaling", vpcLock = true),
     "autoscalingplans" to ServiceSpec("Auto Scaling Plans"),
+    "b2bi" to ServiceSpec("B2B Data Interchange"),
     "backup" to ServiceSpec("Backup"),
     "batch" to ServiceSpec("Batch", vpcLock = true),
     "bcmdataexports" to ServiceSpec("BCM Data Exports"),
@@ -96,6 +99,7 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "evidently" to ServiceSpec("CloudWatch Evidently"),
     "finspace" to ServiceSpec("FinSpace"),
@@ -109,8 +113,10 @@ val services = mapOf(
     "glue" to ServiceSpec("Glue"),
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
+    "greengrassv2" to ServiceSpec("IoT Greengrass V2"),
     "groundstation" to ServiceSpec("Ground Station"),
     "guardduty" to ServiceSpec("GuardDuty"),
+    "health" to ServiceSpec("Health"),
     "healthlake" to ServiceSpec("HealthLake"),
     "iam" to ServiceSpec("IAM (Identity & Access Management)"),
     "identitystore" to ServiceSpec("SSO Identity Store"),
@@ -120,12 +126,14 @@ val services = mapOf(
     "internetmonitor" to ServiceSpec("CloudWatch Internet Monitor"),
     "iot" to ServiceSpec("IoT Core"),
     "iotanalytics" to ServiceSpec("IoT Analytics"),
+    "iotdeviceadvisor" to ServiceSpec("IoT Device Management"),
     "iotevents" to ServiceSpec("IoT Events"),
     "ipam" to ServiceSpec("VPC IPAM (IP Address Manager)", vpcLock = true, patternOverride = "TestAccIPAM", splitPackageRealPackage = "ec2"),
     "ivs" to ServiceSpec("IVS (Interactive Video)"),
     "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
     "kafka" to ServiceSpec("Managed Streaming for Kafka", vpcLock = true),
     "kafkaconnect" to ServiceSpec("Managed Streaming for Kafka Connect"),
+    "kendraranking" to ServiceSpec("Kendra Intelligent Ranking"),
     "keyspaces" to ServiceSpec("Keyspaces (for Apache Cassandra)"),
     "kinesis" to ServiceSpec("Kinesis"),
     "kinesisvideo" to ServiceSpec("Kinesis Video"),
@@ -208,6 +216,7 @@ val services = mapOf(
     "shield" to ServiceSpec("Shield"),
     "signer" to ServiceSpec("Signer"),
     "simpledb" to ServiceSpec("SDB (SimpleDB)"),
+    "snowball" to ServiceSpec("Snow Family"),
     "sns" to ServiceSpec("SNS (Simple Notification)"),
     "sqs" to ServiceSpec("SQS (Simple Queue)"),
     "ssm" to ServiceSpec("SSM (Systems Manager)", vpcLock = true),
@@ -218,12 +227,17 @@ val services = mapOf(
     "ssoadmin" to ServiceSpec("SSO Admin"),
     "storagegateway" to ServiceSpec("Storage Gateway", vpcLock = true),
     "sts" to ServiceSpec("STS (Security Token)"),
+    "support" to ServiceSpec("Support"),
+    "supportapp" to ServiceSpec("Support App"),
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
+    "textract" to ServiceSpec("Textract"),
+    "timestreaminfluxdb" to ServiceSpec("Timestream for InfluxDB"),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
     "transitgateway" to ServiceSpec("Transit Gateway", vpcLock = true, patternOverride = "TestAccTransitGateway", splitPackageRealPackage = "ec2"),
+    "trustedadvisor" to ServiceSpec("Trusted Advisor"),
     "verifiedaccess" to ServiceSpec("Verified Access", vpcLock = true, patternOverride = "TestAccVerifiedAccess", splitPackageRealPackage = "ec2"),
     "verifiedpermissions" to ServiceSpec("Verified Permissions"),
     "vpc" to ServiceSpec("VPC (Virtual Private Cloud)", vpcLock = true, patternOverride = "TestAccVPC", splitPackageRealPackage = "ec2"),
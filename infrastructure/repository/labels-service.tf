@@ -21,14 +21,17 @@ variable "service_labels" {
     "appintegrations",
     "applicationcostprofiler",
     "applicationinsights",
+    "applicationsignals",
     "appmesh",
     "apprunner",
     "appstream",
     "appsync",
+    "artifact",
     "athena",
     "auditmanager",
     "autoscaling",
     "autoscalingplans",
+    "b2bi",
     "backup",
     "backupgateway",
     "batch",
@@ -122,6 +125,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "emrserverless",
+    "entityresolution",
     "events",
     "evidently",
     "finspace",
@@ -172,6 +176,7 @@ variable "service_labels" {
     "kafka",
     "kafkaconnect",
     "kendra",
+    "kendraranking",
     "keyspaces",
     "kinesis",
     "kinesisanalytics",
@@ -316,9 +321,11 @@ variable "service_labels" {
     "storagegateway",
     "sts",
     "support",
+    "supportapp",
     "swf",
     "synthetics",
     "textract",
+    "timestreaminfluxdb",
     "timestreamquery",
     "timestreamwrite",
     "transcribe",
@@ -326,6 +333,7 @@ variable "service_labels" {
     "transfer",
     "transitgateway",
     "translate",
+    "trustedadvisor",
     "verifiedaccess",
     "verifiedpermissions",
     "voiceid",
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type apiErrorKeyT string
+
+var apiErrorKey apiErrorKeyT = "API_ERROR"
+
+// apiError holds the error returned by the most recent failed AWS API call made with a Context.
+type apiError struct {
+	err  error
+	lock sync.Mutex
+}
+
+// NewAPIErrorContext returns a Context in which the error returned by the most recent failed AWS API call is recorded.
+func NewAPIErrorContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, apiErrorKey, &apiError{})
+}
+
+// APIErrorFromContext returns the error returned by the most recent failed AWS API call recorded in Context.
+func APIErrorFromContext(ctx context.Context) error {
+	v, ok := ctx.Value(apiErrorKey).(*apiError)
+	if !ok {
+		return nil
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	return v.err
+}
+
+// RecordAPIError records the specified error, if any, as the error returned by the most recent failed AWS API call made with Context.
+func RecordAPIError(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	v, ok := ctx.Value(apiErrorKey).(*apiError)
+	if !ok {
+		return
+	}
+
+	v.lock.Lock()
+	v.err = err
+	v.lock.Unlock()
+}
+
+// apiConfigWithErrorRecorder returns copies of the AWS SDK configurations whose API clients record
+// the final error of each API call, after any SDK retries, in the call's Context.
+func apiConfigWithErrorRecorder(cfg *aws_sdkv2.Config, sess *session_sdkv1.Session) (*aws_sdkv2.Config, *session_sdkv1.Session) {
+	v := cfg.Copy()
+	v.APIOptions = append(v.APIOptions, func(stack *middleware.Stack) error {
+		// Initialize middleware run before the retry middleware and so see the error of the last attempt.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tfAPIErrorRecorder", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleInitialize(ctx, in)
+			RecordAPIError(ctx, err)
+			return out, metadata, err
+		}), middleware.Before)
+	})
+
+	s := sess.Copy()
+	// Complete handlers run once after any retries.
+	s.Handlers.Complete.PushBack(func(r *request.Request) {
+		RecordAPIError(r.Context(), r.Error)
+	})
+
+	return &v, s
+}
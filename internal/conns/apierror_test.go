@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestAPIErrorFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Errors are not recorded without a recording Context.
+	RecordAPIError(ctx, errors.New("first"))
+	if err := APIErrorFromContext(ctx); err != nil {
+		t.Errorf("APIErrorFromContext() = %v, want nil", err)
+	}
+
+	ctx = NewAPIErrorContext(ctx)
+	RecordAPIError(ctx, errors.New("first"))
+	RecordAPIError(ctx, nil)
+	RecordAPIError(ctx, errors.New("second"))
+
+	if got, want := APIErrorFromContext(ctx), "second"; got == nil || got.Error() != want {
+		t.Errorf("APIErrorFromContext() = %v, want %v", got, want)
+	}
+}
+
+func TestAPIConfigWithErrorRecorder(t *testing.T) {
+	t.Parallel()
+
+	sess, err := session_sdkv1.NewSession(&aws_sdkv1.Config{Region: aws_sdkv1.String("us-west-2")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, sess := apiConfigWithErrorRecorder(&aws_sdkv2.Config{}, sess)
+
+	t.Run("AWS SDK for Go v2", func(t *testing.T) {
+		t.Parallel()
+
+		stack := middleware.NewStack("test", func() interface{} { return nil })
+		for _, f := range cfg.APIOptions {
+			if err := f(stack); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		apiErr := &smithy.GenericAPIError{Code: "Throttling"}
+		handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, interface{}) (interface{}, middleware.Metadata, error) {
+			return nil, middleware.Metadata{}, apiErr
+		}), stack)
+
+		ctx := NewAPIErrorContext(context.Background())
+		if _, _, err := handler.Handle(ctx, struct{}{}); !errors.Is(err, apiErr) {
+			t.Fatalf("Handle() error = %v, want %v", err, apiErr)
+		}
+
+		if got, want := APIErrorFromContext(ctx), error(apiErr); got != want {
+			t.Errorf("APIErrorFromContext() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("AWS SDK for Go v1", func(t *testing.T) {
+		t.Parallel()
+
+		r := request.New(*sess.Config, metadata.ClientInfo{}, sess.Handlers, nil, &request.Operation{Name: "Test"}, nil, nil)
+		ctx := NewAPIErrorContext(context.Background())
+		r.SetContext(ctx)
+		apiErr := awserr.New("ThrottlingException", "Rate exceeded", nil)
+		r.Error = apiErr
+
+		r.Handlers.Complete.Run(r)
+
+		if got, want := APIErrorFromContext(ctx), error(apiErr); got != want {
+			t.Errorf("APIErrorFromContext() = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// apiCallLimiter limits the number of concurrent API calls to a service.
+// A call holds one of the limiter's slots from when its request, including any retry, is sent until its response body is closed.
+type apiCallLimiter struct {
+	slots chan struct{}
+}
+
+// newAPICallLimiter returns a limiter that allows up to maxCalls concurrent calls.
+func newAPICallLimiter(maxCalls int) *apiCallLimiter {
+	return &apiCallLimiter{
+		slots: make(chan struct{}, maxCalls),
+	}
+}
+
+// do sends an HTTP request using the specified function once a slot is free or fails if the request's Context is done first.
+func (l *apiCallLimiter) do(request *http.Request, f func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	ctx := request.Context()
+
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	response, err := f(request)
+
+	if err != nil || response == nil || response.Body == nil {
+		l.release()
+
+		return response, err
+	}
+
+	// The call is in progress until the SDK has read the response.
+	response.Body = &apiCallLimiterBody{ReadCloser: response.Body, release: l.release}
+
+	return response, nil
+}
+
+func (l *apiCallLimiter) release() {
+	<-l.slots
+}
+
+// apiCallLimiterBody frees its limiter slot when the response body is closed.
+type apiCallLimiterBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *apiCallLimiterBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAPICallLimiter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxCalls = 3
+		calls    = 30
+	)
+
+	limiter := newAPICallLimiter(maxCalls)
+
+	var inFlight, maxInFlight, sent atomic.Int32
+	f := func(*http.Request) (*http.Response, error) {
+		sent.Add(1)
+		n := inFlight.Add(1)
+		for {
+			if m := maxInFlight.Load(); n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		return &http.Response{Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			request, _ := http.NewRequest(http.MethodPost, "https://example.com", nil)
+			response, err := limiter.do(request, f)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			// The call is in flight until its response body is closed.
+			inFlight.Add(-1)
+			response.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got, want := int(sent.Load()), calls; got != want {
+		t.Errorf("calls sent = %d, want %d", got, want)
+	}
+	if got, want := int(maxInFlight.Load()), maxCalls; got > want {
+		t.Errorf("concurrent calls = %d, want at most %d", got, want)
+	}
+}
+
+func TestAPICallLimiterReleasedOnClose(t *testing.T) {
+	t.Parallel()
+
+	limiter := newAPICallLimiter(1)
+	f := func(*http.Request) (*http.Response, error) {
+		return &http.Response{Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "https://example.com", nil)
+	response, err := limiter.do(request, f)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Closing the body more than once frees only one slot.
+	response.Body.Close()
+	response.Body.Close()
+
+	if got, want := len(limiter.slots), 0; got != want {
+		t.Errorf("slots in use = %d, want %d", got, want)
+	}
+
+	if _, err := limiter.do(request, func(*http.Request) (*http.Response, error) { return nil, io.ErrUnexpectedEOF }); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := len(limiter.slots), 0; got != want {
+		t.Errorf("slots in use after error = %d, want %d", got, want)
+	}
+}
+
+func TestAPICallLimiterContextDone(t *testing.T) {
+	t.Parallel()
+
+	limiter := newAPICallLimiter(1)
+
+	// The only slot is held until the response body is closed.
+	request, _ := http.NewRequest(http.MethodPost, "https://example.com", nil)
+	response, err := limiter.do(request, func(*http.Request) (*http.Response, error) {
+		return &http.Response{Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer response.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	request, _ = http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com", nil)
+	_, err = limiter.do(request, func(*http.Request) (*http.Response, error) {
+		t.Fatal("request sent without a free slot")
+		return nil, nil
+	})
+
+	if err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// apiCallMiddleware wraps the sending of AWS API HTTP requests.
+type apiCallMiddleware interface {
+	do(*http.Request, func(*http.Request) (*http.Response, error)) (*http.Response, error)
+}
+
+// middlewareHTTPClient is an AWS SDK for Go v2 HTTP client that sends requests via a middleware.
+type middlewareHTTPClient struct {
+	client     aws.HTTPClient
+	middleware apiCallMiddleware
+}
+
+func (c *middlewareHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	return c.middleware.do(request, c.client.Do)
+}
+
+// middlewareRoundTripper is an HTTP transport, used by AWS SDK for Go v1 API clients, that sends requests via a middleware.
+type middlewareRoundTripper struct {
+	transport  http.RoundTripper
+	middleware apiCallMiddleware
+}
+
+func (t *middlewareRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	return t.middleware.do(request, t.transport.RoundTrip)
+}
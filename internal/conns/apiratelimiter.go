@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// apiRateLimiter limits the rate of API calls to a service using a token bucket.
+// A token is taken from the bucket before each request, including retries, is sent.
+type apiRateLimiter struct {
+	limiter *rate.Limiter
+}
+
+// newAPIRateLimiter returns a limiter whose bucket holds burst tokens and is refilled at requestsPerSecond tokens per second.
+func newAPIRateLimiter(requestsPerSecond float64, burst int) *apiRateLimiter {
+	return &apiRateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), burst),
+	}
+}
+
+// do sends an HTTP request using the specified function once a token is available or fails if the request's Context is done first.
+func (l *apiRateLimiter) do(request *http.Request, f func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if err := l.limiter.Wait(request.Context()); err != nil {
+		return nil, err
+	}
+
+	return f(request)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAPIRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		requestsPerSecond = 50
+		burst             = 5
+		calls             = 30
+	)
+
+	limiter := newAPIRateLimiter(requestsPerSecond, burst)
+
+	var sent atomic.Int32
+	f := func(*http.Request) (*http.Response, error) {
+		sent.Add(1)
+
+		return &http.Response{Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			request, _ := http.NewRequest(http.MethodPost, "https://example.com", nil)
+			response, err := limiter.do(request, f)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			response.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got, want := int(sent.Load()), calls; got != want {
+		t.Errorf("calls sent = %d, want %d", got, want)
+	}
+
+	// The burst is sent immediately and the remaining calls at the configured rate.
+	if got, want := time.Since(start), time.Duration(calls-burst)*time.Second/requestsPerSecond; got < want-10*time.Millisecond {
+		t.Errorf("elapsed = %s, want at least %s", got, want)
+	}
+}
+
+func TestAPIRateLimiterContextDone(t *testing.T) {
+	t.Parallel()
+
+	limiter := newAPIRateLimiter(1, 1)
+
+	request, _ := http.NewRequest(http.MethodPost, "https://example.com", nil)
+	if _, err := limiter.do(request, func(*http.Request) (*http.Response, error) { return &http.Response{}, nil }); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	request, _ = http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com", nil)
+	_, err := limiter.do(request, func(*http.Request) (*http.Response, error) {
+		t.Fatal("request sent without a token")
+		return nil, nil
+	})
+
+	if err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	arn_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/arn"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	stscreds_sdkv1 "github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	sts_sdkv1 "github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// ForAssumeRole returns an AWSClient that makes API calls using the credentials of the specified IAM role.
+// The role is assumed using the AWSClient's credentials, so role chaining from the provider's own `assume_role` is supported.
+// If no role is specified the AWSClient itself is returned.
+// Otherwise the client is created on first use and cached.
+func (c *AWSClient) ForAssumeRole(ctx context.Context, assumeRole *awsbase.AssumeRole) (*AWSClient, error) {
+	if assumeRole == nil || assumeRole.RoleARN == "" {
+		return c, nil
+	}
+
+	roleARN, err := arn_sdkv2.Parse(assumeRole.RoleARN)
+	if err != nil {
+		return nil, fmt.Errorf("parsing IAM Role ARN (%s): %w", assumeRole.RoleARN, err)
+	}
+
+	// fmt prints maps sorted by key, so equivalent configurations have the same key.
+	key := fmt.Sprintf("%+v", *assumeRole)
+
+	c.lock.Lock()
+	v, ok := c.assumeRoleClients[key]
+	c.lock.Unlock()
+
+	if ok {
+		return v, nil
+	}
+
+	c.lock.Lock()
+	client := c.clone()
+	c.lock.Unlock()
+	client.AccountID = roleARN.AccountID
+	client.Partition = roleARN.Partition
+	// The caller identity is retrieved on first use with the assumed role's credentials.
+	client.callerARN = ""
+
+	if c.awsConfig != nil {
+		provider := stscreds_sdkv2.NewAssumeRoleProvider(c.STSClient(ctx), assumeRole.RoleARN, func(opts *stscreds_sdkv2.AssumeRoleOptions) {
+			opts.RoleSessionName = assumeRole.SessionName
+			opts.Duration = assumeRole.Duration
+			if assumeRole.ExternalID != "" {
+				opts.ExternalID = aws_sdkv2.String(assumeRole.ExternalID)
+			}
+			if assumeRole.Policy != "" {
+				opts.Policy = aws_sdkv2.String(assumeRole.Policy)
+			}
+			for _, v := range assumeRole.PolicyARNs {
+				opts.PolicyARNs = append(opts.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{Arn: aws_sdkv2.String(v)})
+			}
+			if assumeRole.SourceIdentity != "" {
+				opts.SourceIdentity = aws_sdkv2.String(assumeRole.SourceIdentity)
+			}
+			for k, v := range assumeRole.Tags {
+				opts.Tags = append(opts.Tags, ststypes_sdkv2.Tag{Key: aws_sdkv2.String(k), Value: aws_sdkv2.String(v)})
+			}
+			opts.TransitiveTagKeys = assumeRole.TransitiveTagKeys
+		})
+		credentials := aws_sdkv2.NewCredentialsCache(provider)
+
+		// Fail early with a clear error rather than on the resource's first API call.
+		if _, err := credentials.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assuming IAM Role (%s): %w", assumeRole.RoleARN, err)
+		}
+
+		client.awsConfig.Credentials = credentials
+	}
+
+	if c.session != nil {
+		credentials := stscreds_sdkv1.NewCredentials(c.session, assumeRole.RoleARN, func(p *stscreds_sdkv1.AssumeRoleProvider) {
+			if assumeRole.SessionName != "" {
+				p.RoleSessionName = assumeRole.SessionName
+			}
+			if assumeRole.Duration != 0 {
+				p.Duration = assumeRole.Duration
+			}
+			if assumeRole.ExternalID != "" {
+				p.ExternalID = aws_sdkv1.String(assumeRole.ExternalID)
+			}
+			if assumeRole.Policy != "" {
+				p.Policy = aws_sdkv1.String(assumeRole.Policy)
+			}
+			for _, v := range assumeRole.PolicyARNs {
+				p.PolicyArns = append(p.PolicyArns, &sts_sdkv1.PolicyDescriptorType{Arn: aws_sdkv1.String(v)})
+			}
+			if assumeRole.SourceIdentity != "" {
+				p.SourceIdentity = aws_sdkv1.String(assumeRole.SourceIdentity)
+			}
+			for k, v := range assumeRole.Tags {
+				p.Tags = append(p.Tags, &sts_sdkv1.Tag{Key: aws_sdkv1.String(k), Value: aws_sdkv1.String(v)})
+			}
+			p.TransitiveTagKeys = aws_sdkv1.StringSlice(assumeRole.TransitiveTagKeys)
+		})
+		client.session = c.session.Copy(aws_sdkv1.NewConfig().WithCredentials(credentials))
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if v, ok := c.assumeRoleClients[key]; ok {
+		return v, nil
+	}
+	if c.assumeRoleClients == nil {
+		c.assumeRoleClients = make(map[string]*AWSClient)
+	}
+	c.assumeRoleClients[key] = client
+
+	return client, nil
+}
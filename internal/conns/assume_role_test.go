@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestAWSClientForAssumeRole(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &AWSClient{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	if got, err := c.ForAssumeRole(ctx, nil); err != nil || got != c {
+		t.Errorf("ForAssumeRole(nil) = %v, %v, want the default client", got, err)
+	}
+	if got, err := c.ForAssumeRole(ctx, &awsbase.AssumeRole{}); err != nil || got != c {
+		t.Errorf("ForAssumeRole with no role ARN = %v, %v, want the default client", got, err)
+	}
+	if _, err := c.ForAssumeRole(ctx, &awsbase.AssumeRole{RoleARN: "invalid"}); err == nil {
+		t.Errorf("ForAssumeRole with invalid role ARN returned no error")
+	}
+
+	assumeRole := &awsbase.AssumeRole{
+		RoleARN:     "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+		SessionName: "test",
+		Tags:        map[string]string{"k1": "v1", "k2": "v2"},
+	}
+	got, err := c.ForAssumeRole(ctx, assumeRole)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got == c {
+		t.Fatalf("ForAssumeRole returned the default client")
+	}
+	if got, want := got.AccountID, "210987654321"; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+	if got.Region != c.Region {
+		t.Errorf("Region = %q, want %q", got.Region, c.Region)
+	}
+	if c.AccountID != "123456789012" {
+		t.Errorf("default AccountID changed to %q", c.AccountID)
+	}
+
+	again, err := c.ForAssumeRole(ctx, &awsbase.AssumeRole{
+		RoleARN:     "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+		SessionName: "test",
+		Tags:        map[string]string{"k2": "v2", "k1": "v1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if again != got {
+		t.Errorf("ForAssumeRole did not return the cached client")
+	}
+
+	other, err := c.ForAssumeRole(ctx, &awsbase.AssumeRole{
+		RoleARN:     "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+		SessionName: "other",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if other == got {
+		t.Errorf("ForAssumeRole with a different session name returned the cached client")
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	AuditLogResultSuccess = "success"
+	AuditLogResultFailure = "failure"
+)
+
+// AuditLogEntry is a single record in the provider's change audit log.
+type AuditLogEntry struct {
+	Timestamp    time.Time `json:"timestamp"`
+	AccountID    string    `json:"account_id"`
+	Region       string    `json:"region"`
+	ResourceType string    `json:"resource_type"`
+	Action       string    `json:"action"`
+	ID           string    `json:"id"`
+	Result       string    `json:"result"`
+	RequestIDs   []string  `json:"request_ids"`
+	CallerARN    string    `json:"caller_arn"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// AuditLog appends one JSON object per line to a file for every mutating resource operation.
+type AuditLog struct {
+	path string
+	lock sync.Mutex
+}
+
+func NewAuditLog(path string) *AuditLog {
+	return &AuditLog{
+		path: path,
+	}
+}
+
+// Append writes the entry to the end of the audit log file, creating the file if necessary.
+func (l *AuditLog) Append(entry AuditLogEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("opening audit log (%s): %w", l.path, err)
+	}
+
+	_, err = f.Write(b)
+
+	if errClose := f.Close(); err == nil {
+		err = errClose
+	}
+
+	if err != nil {
+		return fmt.Errorf("writing audit log (%s): %w", l.path, err)
+	}
+
+	return nil
+}
+
+type apiRequestIDsKeyT string
+
+var apiRequestIDsKey apiRequestIDsKeyT = "API_REQUEST_IDS"
+
+// apiRequestIDs accumulates the request IDs of AWS API calls made with a Context.
+type apiRequestIDs struct {
+	ids  []string
+	lock sync.Mutex
+}
+
+// NewAPIRequestIDsContext returns a Context in which the request IDs of AWS API calls are recorded.
+func NewAPIRequestIDsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, apiRequestIDsKey, &apiRequestIDs{})
+}
+
+// APIRequestIDsFromContext returns the request IDs of AWS API calls recorded in Context.
+func APIRequestIDsFromContext(ctx context.Context) []string {
+	v, ok := ctx.Value(apiRequestIDsKey).(*apiRequestIDs)
+	if !ok {
+		return nil
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	ids := make([]string, len(v.ids))
+	copy(ids, v.ids)
+
+	return ids
+}
+
+// apiRequestIDRecorder records the request ID of each AWS API response in the request's Context.
+type apiRequestIDRecorder struct{}
+
+func (apiRequestIDRecorder) do(request *http.Request, f func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	response, err := f(request)
+
+	if response == nil {
+		return response, err
+	}
+
+	v, ok := request.Context().Value(apiRequestIDsKey).(*apiRequestIDs)
+	if !ok {
+		return response, err
+	}
+
+	for _, header := range []string{"X-Amzn-Requestid", "X-Amz-Request-Id"} {
+		if id := response.Header.Get(header); id != "" {
+			v.lock.Lock()
+			v.ids = append(v.ids, id)
+			v.lock.Unlock()
+
+			break
+		}
+	}
+
+	return response, err
+}
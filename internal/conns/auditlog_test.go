@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAuditLogAppend(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	log := NewAuditLog(path)
+
+	entries := []AuditLogEntry{
+		{
+			Timestamp:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			AccountID:    "123456789012",
+			Region:       "us-west-2", //lintignore:AWSAT003
+			ResourceType: "aws_sns_topic",
+			Action:       "create",
+			ID:           "arn:aws:sns:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			Result:       AuditLogResultSuccess,
+			RequestIDs:   []string{"req-1", "req-2"},
+			CallerARN:    "arn:aws:iam::123456789012:user/test", //lintignore:AWSAT005
+		},
+		{
+			Timestamp:    time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC),
+			AccountID:    "123456789012",
+			Region:       "us-west-2", //lintignore:AWSAT003
+			ResourceType: "aws_sns_topic",
+			Action:       "delete",
+			ID:           "arn:aws:sns:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			Result:       AuditLogResultFailure,
+			RequestIDs:   []string{},
+			Error:        "AccessDenied",
+		},
+	}
+
+	for _, entry := range entries {
+		if err := log.Append(entry); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer f.Close()
+
+	var got []AuditLogEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry AuditLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got = append(got, entry)
+	}
+
+	if diff := cmp.Diff(got, entries); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAPIRequestIDRecorder(t *testing.T) {
+	t.Parallel()
+
+	recorder := apiRequestIDRecorder{}
+	f := func(request *http.Request) (*http.Response, error) {
+		header := make(http.Header)
+		header.Set("X-Amzn-Requestid", request.URL.Path[1:])
+		return &http.Response{Header: header}, nil
+	}
+
+	ctx := NewAPIRequestIDsContext(context.Background())
+	for _, id := range []string{"req-1", "req-2"} {
+		request, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com/"+id, nil)
+		if _, err := recorder.do(request, f); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// Requests made without a recording Context are ignored.
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://example.com/req-3", nil)
+	if _, err := recorder.do(request, f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(APIRequestIDsFromContext(ctx), []string{"req-1", "req-2"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
@@ -31,14 +32,26 @@ import (
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
-
+	AccountID                   string
+	AuditLog                    *AuditLog
+	DefaultTagsConfig           *tftags.DefaultConfig
+	DefaultTimeoutsConfig       DefaultTimeoutsConfig
+	DeletionProtectionTagConfig *tftags.DeletionProtectionConfig
+	IgnoreTagsConfig            *tftags.IgnoreConfig
+	Partition                   string
+	ReadOnly                    bool
+	Region                      string
+	ServicePackages             map[string]ServicePackage
+	ThrottlingRetryConfig       ThrottlingRetryConfig
+	Tracer                      *Tracer
+
+	apiCallLimiters           map[string]*apiCallLimiter // From provider configuration.
+	apiRateLimiters           map[string]*apiRateLimiter // From provider configuration.
+	apiReadCaches             map[string]*apiReadCache
+	assumeRoleClients         map[string]*AWSClient
 	awsConfig                 *aws_sdkv2.Config
+	callerARN                 string
+	circuitBreaker            *circuitBreaker // From provider configuration.
 	clients                   map[string]any
 	conns                     map[string]any
 	dnsSuffix                 string
@@ -46,10 +59,12 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	regionalClients           map[string]*AWSClient
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
+	securityChecks            string // From provider configuration.
 	stsRegion                 string // From provider configuration.
 }
 
@@ -166,6 +181,11 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// SecurityChecks returns the security_checks provider configuration value.
+func (c *AWSClient) SecurityChecks(context.Context) string {
+	return c.securityChecks
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
@@ -179,6 +199,29 @@ func (c *AWSClient) HTTPClient(context.Context) *http.Client {
 	return c.httpClient
 }
 
+// CallerARN returns the ARN of the AWS principal making API calls, retrieving it from STS on first use.
+func (c *AWSClient) CallerARN(ctx context.Context) (string, error) {
+	c.lock.Lock()
+	callerARN := c.callerARN
+	c.lock.Unlock()
+
+	if callerARN != "" {
+		return callerARN, nil
+	}
+
+	output, err := c.STSClient(ctx).GetCallerIdentity(ctx, &sts_sdkv2.GetCallerIdentityInput{})
+
+	if err != nil {
+		return "", err
+	}
+
+	c.lock.Lock()
+	c.callerARN = aws_sdkv2.ToString(output.Arn)
+	c.lock.Unlock()
+
+	return aws_sdkv2.ToString(output.Arn), nil
+}
+
 // RegisterLogger places the configured logger into Context so it can be used via `tflog`.
 func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
@@ -293,6 +336,30 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	var middlewares []apiCallMiddleware
+	// Request IDs are recorded for the audit log from the final response of each API call.
+	if c.AuditLog != nil {
+		middlewares = append(middlewares, apiRequestIDRecorder{})
+	}
+	// Cached responses don't count towards the API call limits.
+	if cache, ok := c.apiReadCaches[servicePackageName]; ok {
+		middlewares = append(middlewares, cache)
+	}
+	// Calls waiting for a token don't hold one of the concurrent API call limiter's slots.
+	if limiter, ok := c.apiRateLimiters[servicePackageName]; ok {
+		middlewares = append(middlewares, limiter)
+	}
+	if limiter, ok := c.apiCallLimiters[servicePackageName]; ok {
+		middlewares = append(middlewares, limiter)
+	}
+	if len(middlewares) > 0 {
+		m["aws_sdkv2_config"], m["session"] = c.apiConfigWithMiddleware(middlewares...)
+	}
+	// API errors are recorded so that resource operations failing because requests were throttled can be retried.
+	// They are also recorded to count consecutive failures for the circuit breaker.
+	if len(c.ThrottlingRetryConfig) > 0 || c.circuitBreaker != nil {
+		m["aws_sdkv2_config"], m["session"] = apiConfigWithErrorRecorder(m["aws_sdkv2_config"].(*aws_sdkv2.Config), m["session"].(*session_sdkv1.Session))
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -309,6 +376,33 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// apiConfigWithMiddleware returns copies of the AWS SDK configurations whose HTTP clients send requests via the specified middlewares.
+// The first middleware is the outermost.
+func (c *AWSClient) apiConfigWithMiddleware(middlewares ...apiCallMiddleware) (*aws_sdkv2.Config, *session_sdkv1.Session) {
+	cfg := c.awsConfig.Copy()
+
+	var httpClient http.Client
+	if v := c.session.Config.HTTPClient; v != nil {
+		httpClient = *v
+	}
+	if httpClient.Transport == nil {
+		httpClient.Transport = http.DefaultTransport
+	}
+
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		cfg.HTTPClient = &middlewareHTTPClient{
+			client:     cfg.HTTPClient,
+			middleware: middlewares[i],
+		}
+		httpClient.Transport = &middlewareRoundTripper{
+			transport:  httpClient.Transport,
+			middleware: middlewares[i],
+		}
+	}
+
+	return &cfg, c.session.Copy(&aws_sdkv1.Config{HTTPClient: &httpClient})
+}
+
 func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName string) string {
 	endpoint := c.endpoints[servicePackageName]
 	if endpoint != "" {
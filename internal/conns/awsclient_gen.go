@@ -15,11 +15,14 @@ import (
 	appconfig_sdkv2 "github.com/aws/aws-sdk-go-v2/service/appconfig"
 	appfabric_sdkv2 "github.com/aws/aws-sdk-go-v2/service/appfabric"
 	appflow_sdkv2 "github.com/aws/aws-sdk-go-v2/service/appflow"
+	applicationsignals_sdkv2 "github.com/aws/aws-sdk-go-v2/service/applicationsignals"
 	apprunner_sdkv2 "github.com/aws/aws-sdk-go-v2/service/apprunner"
+	artifact_sdkv2 "github.com/aws/aws-sdk-go-v2/service/artifact"
 	athena_sdkv2 "github.com/aws/aws-sdk-go-v2/service/athena"
 	auditmanager_sdkv2 "github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	autoscaling_sdkv2 "github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	autoscalingplans_sdkv2 "github.com/aws/aws-sdk-go-v2/service/autoscalingplans"
+	b2bi_sdkv2 "github.com/aws/aws-sdk-go-v2/service/b2bi"
 	batch_sdkv2 "github.com/aws/aws-sdk-go-v2/service/batch"
 	bcmdataexports_sdkv2 "github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
 	bedrock_sdkv2 "github.com/aws/aws-sdk-go-v2/service/bedrock"
@@ -48,6 +51,7 @@ import (
 	codestarconnections_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codestarconnections"
 	codestarnotifications_sdkv2 "github.com/aws/aws-sdk-go-v2/service/codestarnotifications"
 	cognitoidentity_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
+	cognitoidentityprovider_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 	comprehend_sdkv2 "github.com/aws/aws-sdk-go-v2/service/comprehend"
 	computeoptimizer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
 	configservice_sdkv2 "github.com/aws/aws-sdk-go-v2/service/configservice"
@@ -74,6 +78,7 @@ import (
 	elasticloadbalancingv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	emr_sdkv2 "github.com/aws/aws-sdk-go-v2/service/emr"
 	emrserverless_sdkv2 "github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	entityresolution_sdkv2 "github.com/aws/aws-sdk-go-v2/service/entityresolution"
 	eventbridge_sdkv2 "github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	evidently_sdkv2 "github.com/aws/aws-sdk-go-v2/service/evidently"
 	finspace_sdkv2 "github.com/aws/aws-sdk-go-v2/service/finspace"
@@ -81,15 +86,19 @@ import (
 	fis_sdkv2 "github.com/aws/aws-sdk-go-v2/service/fis"
 	fms_sdkv2 "github.com/aws/aws-sdk-go-v2/service/fms"
 	glacier_sdkv2 "github.com/aws/aws-sdk-go-v2/service/glacier"
+	greengrassv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/greengrassv2"
 	groundstation_sdkv2 "github.com/aws/aws-sdk-go-v2/service/groundstation"
+	health_sdkv2 "github.com/aws/aws-sdk-go-v2/service/health"
 	healthlake_sdkv2 "github.com/aws/aws-sdk-go-v2/service/healthlake"
 	iam_sdkv2 "github.com/aws/aws-sdk-go-v2/service/iam"
 	identitystore_sdkv2 "github.com/aws/aws-sdk-go-v2/service/identitystore"
 	inspector2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/inspector2"
 	internetmonitor_sdkv2 "github.com/aws/aws-sdk-go-v2/service/internetmonitor"
+	iotdeviceadvisor_sdkv2 "github.com/aws/aws-sdk-go-v2/service/iotdeviceadvisor"
 	ivschat_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ivschat"
 	kafka_sdkv2 "github.com/aws/aws-sdk-go-v2/service/kafka"
 	kendra_sdkv2 "github.com/aws/aws-sdk-go-v2/service/kendra"
+	kendraranking_sdkv2 "github.com/aws/aws-sdk-go-v2/service/kendraranking"
 	keyspaces_sdkv2 "github.com/aws/aws-sdk-go-v2/service/keyspaces"
 	kinesis_sdkv2 "github.com/aws/aws-sdk-go-v2/service/kinesis"
 	lakeformation_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lakeformation"
@@ -140,6 +149,7 @@ import (
 	sesv2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sesv2"
 	shield_sdkv2 "github.com/aws/aws-sdk-go-v2/service/shield"
 	signer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/signer"
+	snowball_sdkv2 "github.com/aws/aws-sdk-go-v2/service/snowball"
 	sns_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sns"
 	sqs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sqs"
 	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
@@ -149,11 +159,16 @@ import (
 	sso_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sso"
 	ssoadmin_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	support_sdkv2 "github.com/aws/aws-sdk-go-v2/service/support"
+	supportapp_sdkv2 "github.com/aws/aws-sdk-go-v2/service/supportapp"
 	swf_sdkv2 "github.com/aws/aws-sdk-go-v2/service/swf"
 	synthetics_sdkv2 "github.com/aws/aws-sdk-go-v2/service/synthetics"
+	textract_sdkv2 "github.com/aws/aws-sdk-go-v2/service/textract"
+	timestreaminfluxdb_sdkv2 "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
 	timestreamwrite_sdkv2 "github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
 	transcribe_sdkv2 "github.com/aws/aws-sdk-go-v2/service/transcribe"
 	transfer_sdkv2 "github.com/aws/aws-sdk-go-v2/service/transfer"
+	trustedadvisor_sdkv2 "github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
 	verifiedpermissions_sdkv2 "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	vpclattice_sdkv2 "github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	wellarchitected_sdkv2 "github.com/aws/aws-sdk-go-v2/service/wellarchitected"
@@ -326,6 +341,14 @@ func (c *AWSClient) ApplicationInsightsConn(ctx context.Context) *applicationins
 	return errs.Must(conn[*applicationinsights_sdkv1.ApplicationInsights](ctx, c, names.ApplicationInsights, make(map[string]any)))
 }
 
+func (c *AWSClient) ApplicationSignalsClient(ctx context.Context) *applicationsignals_sdkv2.Client {
+	return errs.Must(client[*applicationsignals_sdkv2.Client](ctx, c, names.ApplicationSignals, make(map[string]any)))
+}
+
+func (c *AWSClient) ArtifactClient(ctx context.Context) *artifact_sdkv2.Client {
+	return errs.Must(client[*artifact_sdkv2.Client](ctx, c, names.Artifact, make(map[string]any)))
+}
+
 func (c *AWSClient) AthenaClient(ctx context.Context) *athena_sdkv2.Client {
 	return errs.Must(client[*athena_sdkv2.Client](ctx, c, names.Athena, make(map[string]any)))
 }
@@ -342,6 +365,10 @@ func (c *AWSClient) AutoScalingPlansClient(ctx context.Context) *autoscalingplan
 	return errs.Must(client[*autoscalingplans_sdkv2.Client](ctx, c, names.AutoScalingPlans, make(map[string]any)))
 }
 
+func (c *AWSClient) B2BIClient(ctx context.Context) *b2bi_sdkv2.Client {
+	return errs.Must(client[*b2bi_sdkv2.Client](ctx, c, names.B2BI, make(map[string]any)))
+}
+
 func (c *AWSClient) BCMDataExportsClient(ctx context.Context) *bcmdataexports_sdkv2.Client {
 	return errs.Must(client[*bcmdataexports_sdkv2.Client](ctx, c, names.BCMDataExports, make(map[string]any)))
 }
@@ -474,6 +501,10 @@ func (c *AWSClient) CognitoIDPConn(ctx context.Context) *cognitoidentityprovider
 	return errs.Must(conn[*cognitoidentityprovider_sdkv1.CognitoIdentityProvider](ctx, c, names.CognitoIDP, make(map[string]any)))
 }
 
+func (c *AWSClient) CognitoIDPClient(ctx context.Context) *cognitoidentityprovider_sdkv2.Client {
+	return errs.Must(client[*cognitoidentityprovider_sdkv2.Client](ctx, c, names.CognitoIDP, make(map[string]any)))
+}
+
 func (c *AWSClient) CognitoIdentityClient(ctx context.Context) *cognitoidentity_sdkv2.Client {
 	return errs.Must(client[*cognitoidentity_sdkv2.Client](ctx, c, names.CognitoIdentity, make(map[string]any)))
 }
@@ -662,6 +693,10 @@ func (c *AWSClient) ElasticsearchConn(ctx context.Context) *elasticsearchservice
 	return errs.Must(conn[*elasticsearchservice_sdkv1.ElasticsearchService](ctx, c, names.Elasticsearch, make(map[string]any)))
 }
 
+func (c *AWSClient) EntityResolutionClient(ctx context.Context) *entityresolution_sdkv2.Client {
+	return errs.Must(client[*entityresolution_sdkv2.Client](ctx, c, names.EntityResolution, make(map[string]any)))
+}
+
 func (c *AWSClient) EventsClient(ctx context.Context) *eventbridge_sdkv2.Client {
 	return errs.Must(client[*eventbridge_sdkv2.Client](ctx, c, names.Events, make(map[string]any)))
 }
@@ -714,6 +749,10 @@ func (c *AWSClient) GreengrassConn(ctx context.Context) *greengrass_sdkv1.Greeng
 	return errs.Must(conn[*greengrass_sdkv1.Greengrass](ctx, c, names.Greengrass, make(map[string]any)))
 }
 
+func (c *AWSClient) GreengrassV2Client(ctx context.Context) *greengrassv2_sdkv2.Client {
+	return errs.Must(client[*greengrassv2_sdkv2.Client](ctx, c, names.GreengrassV2, make(map[string]any)))
+}
+
 func (c *AWSClient) GroundStationClient(ctx context.Context) *groundstation_sdkv2.Client {
 	return errs.Must(client[*groundstation_sdkv2.Client](ctx, c, names.GroundStation, make(map[string]any)))
 }
@@ -722,6 +761,10 @@ func (c *AWSClient) GuardDutyConn(ctx context.Context) *guardduty_sdkv1.GuardDut
 	return errs.Must(conn[*guardduty_sdkv1.GuardDuty](ctx, c, names.GuardDuty, make(map[string]any)))
 }
 
+func (c *AWSClient) HealthClient(ctx context.Context) *health_sdkv2.Client {
+	return errs.Must(client[*health_sdkv2.Client](ctx, c, names.Health, make(map[string]any)))
+}
+
 func (c *AWSClient) HealthLakeClient(ctx context.Context) *healthlake_sdkv2.Client {
 	return errs.Must(client[*healthlake_sdkv2.Client](ctx, c, names.HealthLake, make(map[string]any)))
 }
@@ -766,6 +809,10 @@ func (c *AWSClient) IoTAnalyticsConn(ctx context.Context) *iotanalytics_sdkv1.Io
 	return errs.Must(conn[*iotanalytics_sdkv1.IoTAnalytics](ctx, c, names.IoTAnalytics, make(map[string]any)))
 }
 
+func (c *AWSClient) IoTDeviceAdvisorClient(ctx context.Context) *iotdeviceadvisor_sdkv2.Client {
+	return errs.Must(client[*iotdeviceadvisor_sdkv2.Client](ctx, c, names.IoTDeviceAdvisor, make(map[string]any)))
+}
+
 func (c *AWSClient) IoTEventsConn(ctx context.Context) *iotevents_sdkv1.IoTEvents {
 	return errs.Must(conn[*iotevents_sdkv1.IoTEvents](ctx, c, names.IoTEvents, make(map[string]any)))
 }
@@ -786,6 +833,10 @@ func (c *AWSClient) KendraClient(ctx context.Context) *kendra_sdkv2.Client {
 	return errs.Must(client[*kendra_sdkv2.Client](ctx, c, names.Kendra, make(map[string]any)))
 }
 
+func (c *AWSClient) KendraRankingClient(ctx context.Context) *kendraranking_sdkv2.Client {
+	return errs.Must(client[*kendraranking_sdkv2.Client](ctx, c, names.KendraRanking, make(map[string]any)))
+}
+
 func (c *AWSClient) KeyspacesClient(ctx context.Context) *keyspaces_sdkv2.Client {
 	return errs.Must(client[*keyspaces_sdkv2.Client](ctx, c, names.Keyspaces, make(map[string]any)))
 }
@@ -1178,14 +1229,34 @@ func (c *AWSClient) SimpleDBConn(ctx context.Context) *simpledb_sdkv1.SimpleDB {
 	return errs.Must(conn[*simpledb_sdkv1.SimpleDB](ctx, c, names.SimpleDB, make(map[string]any)))
 }
 
+func (c *AWSClient) SnowballClient(ctx context.Context) *snowball_sdkv2.Client {
+	return errs.Must(client[*snowball_sdkv2.Client](ctx, c, names.Snowball, make(map[string]any)))
+}
+
 func (c *AWSClient) StorageGatewayConn(ctx context.Context) *storagegateway_sdkv1.StorageGateway {
 	return errs.Must(conn[*storagegateway_sdkv1.StorageGateway](ctx, c, names.StorageGateway, make(map[string]any)))
 }
 
+func (c *AWSClient) SupportClient(ctx context.Context) *support_sdkv2.Client {
+	return errs.Must(client[*support_sdkv2.Client](ctx, c, names.Support, make(map[string]any)))
+}
+
+func (c *AWSClient) SupportAppClient(ctx context.Context) *supportapp_sdkv2.Client {
+	return errs.Must(client[*supportapp_sdkv2.Client](ctx, c, names.SupportApp, make(map[string]any)))
+}
+
 func (c *AWSClient) SyntheticsClient(ctx context.Context) *synthetics_sdkv2.Client {
 	return errs.Must(client[*synthetics_sdkv2.Client](ctx, c, names.Synthetics, make(map[string]any)))
 }
 
+func (c *AWSClient) TextractClient(ctx context.Context) *textract_sdkv2.Client {
+	return errs.Must(client[*textract_sdkv2.Client](ctx, c, names.Textract, make(map[string]any)))
+}
+
+func (c *AWSClient) TimestreamInfluxDBClient(ctx context.Context) *timestreaminfluxdb_sdkv2.Client {
+	return errs.Must(client[*timestreaminfluxdb_sdkv2.Client](ctx, c, names.TimestreamInfluxDB, make(map[string]any)))
+}
+
 func (c *AWSClient) TimestreamWriteClient(ctx context.Context) *timestreamwrite_sdkv2.Client {
 	return errs.Must(client[*timestreamwrite_sdkv2.Client](ctx, c, names.TimestreamWrite, make(map[string]any)))
 }
@@ -1202,6 +1273,10 @@ func (c *AWSClient) TransferClient(ctx context.Context) *transfer_sdkv2.Client {
 	return errs.Must(client[*transfer_sdkv2.Client](ctx, c, names.Transfer, make(map[string]any)))
 }
 
+func (c *AWSClient) TrustedAdvisorClient(ctx context.Context) *trustedadvisor_sdkv2.Client {
+	return errs.Must(client[*trustedadvisor_sdkv2.Client](ctx, c, names.TrustedAdvisor, make(map[string]any)))
+}
+
 func (c *AWSClient) VPCLatticeClient(ctx context.Context) *vpclattice_sdkv2.Client {
 	return errs.Must(client[*vpclattice_sdkv2.Client](ctx, c, names.VPCLattice, make(map[string]any)))
 }
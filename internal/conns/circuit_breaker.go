@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	awshttp_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	tfawserr_sdkv1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// circuitBreaker stops resource operations on a service once the service's operations have failed
+// a number of times in a row with errors that running further operations will not fix.
+// It is shared by all AWSClients derived from the provider's.
+type circuitBreaker struct {
+	threshold int
+
+	failures map[string]int   // Keyed by service package name.
+	lastErr  map[string]error // Keyed by service package name.
+	lock     sync.Mutex
+}
+
+func newCircuitBreaker(threshold int) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		failures:  make(map[string]int),
+		lastErr:   make(map[string]error),
+	}
+}
+
+// CircuitBreakerError returns an error if operations on the specified service package must not be attempted
+// because the provider's circuit_breaker_threshold has been reached.
+func (c *AWSClient) CircuitBreakerError(servicePackageName string) error {
+	cb := c.circuitBreaker
+	if cb == nil {
+		return nil
+	}
+
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	if failures := cb.failures[servicePackageName]; failures >= cb.threshold {
+		return fmt.Errorf("not attempted: the last %d %s operations failed with AWS server or credential errors (circuit_breaker_threshold), most recently: %w", failures, servicePackageName, cb.lastErr[servicePackageName])
+	}
+
+	return nil
+}
+
+// RecordCircuitBreakerResult records the outcome of an operation on the specified service package.
+// err is the final error of the operation's AWS API calls, if any.
+// Operations that succeed or that fail for other reasons reset the count of consecutive failures.
+func (c *AWSClient) RecordCircuitBreakerResult(servicePackageName string, err error) {
+	cb := c.circuitBreaker
+	if cb == nil {
+		return
+	}
+
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	if !IsCircuitBreakerError(err) {
+		delete(cb.failures, servicePackageName)
+		delete(cb.lastErr, servicePackageName)
+		return
+	}
+
+	cb.failures[servicePackageName]++
+	cb.lastErr[servicePackageName] = err
+}
+
+// circuitBreakerErrorCodes are the AWS API error codes indicating that the provider's credentials are not valid.
+var circuitBreakerErrorCodes = []string{
+	"AuthFailure",
+	"ExpiredToken",
+	"ExpiredTokenException",
+	"InvalidAccessKeyId",
+	"InvalidClientTokenId",
+	"NoCredentialProviders",
+	"SignatureDoesNotMatch",
+	"UnrecognizedClientException",
+}
+
+// IsCircuitBreakerError returns whether the specified error is an AWS SDK for Go v1 or v2 API error
+// with an HTTP 5xx status code or an error code indicating that the provider's credentials are not valid.
+func IsCircuitBreakerError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if tfawserr_sdkv2.ErrCodeEquals(err, circuitBreakerErrorCodes...) || tfawserr_sdkv1.ErrCodeEquals(err, circuitBreakerErrorCodes...) {
+		return true
+	}
+
+	if v, ok := errs.As[*awshttp_sdkv2.ResponseError](err); ok {
+		return v.HTTPStatusCode() >= http.StatusInternalServerError
+	}
+
+	if v, ok := errs.As[awserr.RequestFailure](err); ok {
+		return v.StatusCode() >= http.StatusInternalServerError
+	}
+
+	return false
+}
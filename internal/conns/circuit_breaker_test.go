@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func testResponseError(statusCode int) error {
+	return &smithy.OperationError{
+		ServiceID:     "EC2",
+		OperationName: "DescribeVpcs",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{
+					Response: &http.Response{
+						StatusCode: statusCode,
+					},
+				},
+				Err: errors.New("test"),
+			},
+		},
+	}
+}
+
+func TestIsCircuitBreakerError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "no error",
+		},
+		{
+			name: "other error",
+			err:  errors.New("test"),
+		},
+		{
+			name: "AWS SDK for Go v2 500",
+			err:  testResponseError(http.StatusInternalServerError),
+			want: true,
+		},
+		{
+			name: "AWS SDK for Go v2 503",
+			err:  testResponseError(http.StatusServiceUnavailable),
+			want: true,
+		},
+		{
+			name: "AWS SDK for Go v2 400",
+			err:  testResponseError(http.StatusBadRequest),
+		},
+		{
+			name: "AWS SDK for Go v2 expired token",
+			err:  &smithy.GenericAPIError{Code: "ExpiredTokenException"},
+			want: true,
+		},
+		{
+			name: "AWS SDK for Go v1 503",
+			err:  awserr.NewRequestFailure(awserr.New("ServiceUnavailable", "test", nil), http.StatusServiceUnavailable, "1"),
+			want: true,
+		},
+		{
+			name: "AWS SDK for Go v1 404",
+			err:  awserr.NewRequestFailure(awserr.New("NotFound", "test", nil), http.StatusNotFound, "1"),
+		},
+		{
+			name: "AWS SDK for Go v1 invalid client token",
+			err:  awserr.New("InvalidClientTokenId", "test", nil),
+			want: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsCircuitBreakerError(testCase.err); got != testCase.want {
+				t.Errorf("IsCircuitBreakerError = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAWSClientCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	c := &AWSClient{
+		circuitBreaker: newCircuitBreaker(2),
+	}
+	serverErr := testResponseError(http.StatusServiceUnavailable)
+
+	c.RecordCircuitBreakerResult("ec2", serverErr)
+	if err := c.CircuitBreakerError("ec2"); err != nil {
+		t.Fatalf("circuit breaker open after 1 failure: %s", err)
+	}
+
+	// Other failures reset the count.
+	c.RecordCircuitBreakerResult("ec2", errors.New("test"))
+	c.RecordCircuitBreakerResult("ec2", serverErr)
+	if err := c.CircuitBreakerError("ec2"); err != nil {
+		t.Fatalf("circuit breaker open after reset: %s", err)
+	}
+
+	c.RecordCircuitBreakerResult("ec2", serverErr)
+	err := c.CircuitBreakerError("ec2")
+	if err == nil {
+		t.Fatalf("circuit breaker not open after 2 failures")
+	}
+	if !errors.Is(err, serverErr) {
+		t.Errorf("circuit breaker error %q does not wrap the last failure", err)
+	}
+
+	// Each service has its own circuit breaker, shared by derived clients.
+	if err := c.ForRegion(context.Background(), "eu-west-1").CircuitBreakerError("s3"); err != nil { //lintignore:AWSAT003
+		t.Errorf("s3 circuit breaker open: %s", err)
+	}
+	if err := c.ForRegion(context.Background(), "eu-west-1").CircuitBreakerError("ec2"); err == nil { //lintignore:AWSAT003
+		t.Errorf("ec2 circuit breaker not open for regional client")
+	}
+
+	c.RecordCircuitBreakerResult("ec2", nil)
+	if err := c.CircuitBreakerError("ec2"); err != nil {
+		t.Errorf("circuit breaker open after success: %s", err)
+	}
+
+	if err := (&AWSClient{}).CircuitBreakerError("ec2"); err != nil {
+		t.Errorf("circuit breaker open when not configured: %s", err)
+	}
+}
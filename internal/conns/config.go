@@ -31,8 +31,12 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                   string
+	CircuitBreakerThreshold        int
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeoutsConfig          DefaultTimeoutsConfig
+	DeletionProtectionTagConfig    *tftags.DeletionProtectionConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -42,14 +46,19 @@ type Config struct {
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	MaxConcurrentAPICalls          map[string]int
+	MaxRequestsPerSecond           map[string]int
 	MaxRetries                     int
 	NoProxy                        string
+	OTELTracesEndpoint             string
 	Profile                        string
+	ReadOnly                       bool
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	SecurityChecks                 string
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -58,6 +67,7 @@ type Config struct {
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TerraformVersion               string
+	ThrottlingRetryConfig          ThrottlingRetryConfig
 	Token                          string
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
@@ -206,14 +216,43 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
+	if c.AuditLogPath != "" {
+		client.AuditLog = NewAuditLog(c.AuditLogPath)
+	}
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DefaultTimeoutsConfig = c.DefaultTimeoutsConfig
+	client.DeletionProtectionTagConfig = c.DeletionProtectionTagConfig
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
+	client.ReadOnly = c.ReadOnly
 	client.Region = c.Region
+	client.ThrottlingRetryConfig = c.ThrottlingRetryConfig
+	if c.CircuitBreakerThreshold > 0 {
+		client.circuitBreaker = newCircuitBreaker(c.CircuitBreakerThreshold)
+	}
+	if c.OTELTracesEndpoint != "" {
+		client.Tracer = NewTracer(c.OTELTracesEndpoint)
+	}
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
+	client.apiCallLimiters = make(map[string]*apiCallLimiter, len(c.MaxConcurrentAPICalls))
+	for servicePackageName, maxCalls := range c.MaxConcurrentAPICalls {
+		client.apiCallLimiters[servicePackageName] = newAPICallLimiter(maxCalls)
+	}
+
+	client.apiRateLimiters = make(map[string]*apiRateLimiter, len(c.MaxRequestsPerSecond))
+	for servicePackageName, requestsPerSecond := range c.MaxRequestsPerSecond {
+		// Up to requestsPerSecond requests are sent immediately and then at most requestsPerSecond requests per second.
+		client.apiRateLimiters[servicePackageName] = newAPIRateLimiter(float64(requestsPerSecond), requestsPerSecond)
+	}
+
+	client.apiReadCaches = make(map[string]*apiReadCache, len(apiReadCacheActions))
+	for servicePackageName, actions := range apiReadCacheActions {
+		client.apiReadCaches[servicePackageName] = newAPIReadCache(actions, apiReadCacheTTL)
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
@@ -222,6 +261,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.securityChecks = c.SecurityChecks
 	client.stsRegion = c.STSRegion
 
 	return client, diags
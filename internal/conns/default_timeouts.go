@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"path"
+	"time"
+)
+
+// DefaultTimeouts represents a set of provider configured default resource operation timeouts.
+type DefaultTimeouts struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+	// ResourceTypes are the resource type name patterns, e.g. "aws_db_*", the timeouts apply to.
+	// If empty the timeouts apply to all resource types.
+	ResourceTypes []string
+}
+
+// DefaultTimeoutsConfig represents the provider's default_timeouts configuration blocks.
+type DefaultTimeoutsConfig []DefaultTimeouts
+
+// For returns the default timeouts that apply to the specified resource type.
+// Timeouts from blocks whose resource type patterns match take precedence over those from blocks without patterns.
+// Within each group the first block configuring an operation's timeout wins.
+// Returns nil if no default timeouts apply.
+func (c DefaultTimeoutsConfig) For(typeName string) *DefaultTimeouts {
+	var result DefaultTimeouts
+
+	merge := func(v DefaultTimeouts) {
+		if result.Create == nil {
+			result.Create = v.Create
+		}
+		if result.Read == nil {
+			result.Read = v.Read
+		}
+		if result.Update == nil {
+			result.Update = v.Update
+		}
+		if result.Delete == nil {
+			result.Delete = v.Delete
+		}
+	}
+
+	for _, v := range c {
+		if v.matches(typeName) {
+			merge(v)
+		}
+	}
+	for _, v := range c {
+		if len(v.ResourceTypes) == 0 {
+			merge(v)
+		}
+	}
+
+	if result.Create == nil && result.Read == nil && result.Update == nil && result.Delete == nil {
+		return nil
+	}
+
+	return &result
+}
+
+// matches returns whether any of the resource type patterns match the specified resource type.
+func (dt DefaultTimeouts) matches(typeName string) bool {
+	for _, pattern := range dt.ResourceTypes {
+		if ok, _ := path.Match(pattern, typeName); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestDefaultTimeoutsConfigFor(t *testing.T) {
+	t.Parallel()
+
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	testCases := []struct {
+		name     string
+		config   conns.DefaultTimeoutsConfig
+		typeName string
+		want     *conns.DefaultTimeouts
+	}{
+		{
+			name:     "no config",
+			typeName: "aws_db_instance",
+		},
+		{
+			name: "all resource types",
+			config: conns.DefaultTimeoutsConfig{
+				{
+					Create: duration(60 * time.Minute),
+					Delete: duration(30 * time.Minute),
+				},
+			},
+			typeName: "aws_db_instance",
+			want: &conns.DefaultTimeouts{
+				Create: duration(60 * time.Minute),
+				Delete: duration(30 * time.Minute),
+			},
+		},
+		{
+			name: "no matching pattern",
+			config: conns.DefaultTimeoutsConfig{
+				{
+					Create:        duration(60 * time.Minute),
+					ResourceTypes: []string{"aws_rds_*"},
+				},
+			},
+			typeName: "aws_db_instance",
+		},
+		{
+			name: "pattern takes precedence",
+			config: conns.DefaultTimeoutsConfig{
+				{
+					Create: duration(60 * time.Minute),
+					Update: duration(60 * time.Minute),
+				},
+				{
+					Create:        duration(120 * time.Minute),
+					ResourceTypes: []string{"aws_rds_*", "aws_db_*"},
+				},
+			},
+			typeName: "aws_db_instance",
+			want: &conns.DefaultTimeouts{
+				Create: duration(120 * time.Minute),
+				Update: duration(60 * time.Minute),
+			},
+		},
+		{
+			name: "first match wins",
+			config: conns.DefaultTimeoutsConfig{
+				{
+					Read:          duration(5 * time.Minute),
+					ResourceTypes: []string{"aws_db_instance"},
+				},
+				{
+					Read:          duration(10 * time.Minute),
+					ResourceTypes: []string{"aws_db_*"},
+				},
+			},
+			typeName: "aws_db_instance",
+			want: &conns.DefaultTimeouts{
+				Read: duration(5 * time.Minute),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.For(testCase.typeName)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// ForRegion returns an AWSClient for the specified AWS Region.
+// If the specified region is empty or the default the AWSClient itself is returned.
+// Otherwise a client sharing the provider's credentials and configuration, but making API calls to the specified region, is created on first use and cached.
+func (c *AWSClient) ForRegion(_ context.Context, region string) *AWSClient {
+	if region == "" || region == c.Region {
+		return c
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if v, ok := c.regionalClients[region]; ok {
+		return v
+	}
+
+	dnsSuffix := c.dnsSuffix
+	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region); ok {
+		dnsSuffix = p.DNSSuffix()
+	}
+
+	client := c.clone()
+	client.Region = region
+	client.dnsSuffix = dnsSuffix
+	if client.awsConfig != nil {
+		client.awsConfig.Region = region
+	}
+	if c.session != nil {
+		client.session = c.session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
+	}
+
+	if c.regionalClients == nil {
+		c.regionalClients = make(map[string]*AWSClient)
+	}
+	c.regionalClients[region] = client
+
+	return client
+}
+
+// clone returns a copy of the AWSClient without any API clients.
+// API call limits and cached responses are shared. Cached responses are keyed by endpoint URL and signing credentials.
+// The caller must hold c.lock.
+func (c *AWSClient) clone() *AWSClient {
+	client := &AWSClient{
+		AccountID:                   c.AccountID,
+		AuditLog:                    c.AuditLog,
+		DefaultTagsConfig:           c.DefaultTagsConfig,
+		DefaultTimeoutsConfig:       c.DefaultTimeoutsConfig,
+		DeletionProtectionTagConfig: c.DeletionProtectionTagConfig,
+		IgnoreTagsConfig:            c.IgnoreTagsConfig,
+		Partition:                   c.Partition,
+		ReadOnly:                    c.ReadOnly,
+		Region:                      c.Region,
+		ServicePackages:             c.ServicePackages,
+		ThrottlingRetryConfig:       c.ThrottlingRetryConfig,
+		Tracer:                      c.Tracer,
+
+		apiCallLimiters:           c.apiCallLimiters,
+		apiRateLimiters:           c.apiRateLimiters,
+		apiReadCaches:             c.apiReadCaches,
+		callerARN:                 c.callerARN,
+		circuitBreaker:            c.circuitBreaker,
+		clients:                   make(map[string]any, 0),
+		conns:                     make(map[string]any, 0),
+		dnsSuffix:                 c.dnsSuffix,
+		endpoints:                 c.endpoints,
+		httpClient:                c.httpClient,
+		logger:                    c.logger,
+		session:                   c.session,
+		s3UsePathStyle:            c.s3UsePathStyle,
+		s3USEast1RegionalEndpoint: c.s3USEast1RegionalEndpoint,
+		securityChecks:            c.securityChecks,
+		stsRegion:                 c.stsRegion,
+	}
+	if c.awsConfig != nil {
+		cfg := c.awsConfig.Copy()
+		client.awsConfig = &cfg
+	}
+
+	return client
+}
+
+type awsClientKeyT string
+
+var awsClientKey awsClientKeyT = "AWS_CLIENT"
+
+// NewAWSClientContext returns a Context in which resource operations use the specified AWSClient instead of the provider's.
+func NewAWSClientContext(ctx context.Context, c *AWSClient) context.Context {
+	return context.WithValue(ctx, awsClientKey, c)
+}
+
+// AWSClientFromContext returns any AWSClient placed in Context by NewAWSClientContext.
+func AWSClientFromContext(ctx context.Context) (*AWSClient, bool) {
+	v, ok := ctx.Value(awsClientKey).(*AWSClient)
+
+	return v, ok
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestAWSClientForRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &AWSClient{
+		AccountID: "123456789012",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		awsConfig: &aws_sdkv2.Config{
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	if got := c.ForRegion(ctx, ""); got != c {
+		t.Errorf("ForRegion(\"\") returned a new client")
+	}
+	if got := c.ForRegion(ctx, c.Region); got != c {
+		t.Errorf("ForRegion(%q) returned a new client", c.Region)
+	}
+
+	region := "eu-west-1" //lintignore:AWSAT003
+	got := c.ForRegion(ctx, region)
+
+	if got == c {
+		t.Fatalf("ForRegion(%q) returned the default client", region)
+	}
+	if got.Region != region {
+		t.Errorf("Region = %q, want %q", got.Region, region)
+	}
+	if got.awsConfig.Region != region {
+		t.Errorf("AWS SDK for Go v2 config Region = %q, want %q", got.awsConfig.Region, region)
+	}
+	if c.awsConfig.Region != c.Region {
+		t.Errorf("default AWS SDK for Go v2 config Region changed to %q", c.awsConfig.Region)
+	}
+	if got.AccountID != c.AccountID {
+		t.Errorf("AccountID = %q, want %q", got.AccountID, c.AccountID)
+	}
+	if again := c.ForRegion(ctx, region); again != got {
+		t.Errorf("ForRegion(%q) did not return the cached client", region)
+	}
+
+	ctx = NewAWSClientContext(ctx, got)
+	if v, ok := AWSClientFromContext(ctx); !ok || v != got {
+		t.Errorf("AWSClientFromContext = %v, %t, want %v", v, ok, got)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"math/rand"
+	"slices"
+	"time"
+
+	tfawserr_sdkv1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+)
+
+const (
+	DefaultThrottlingRetryMaxAttempts = 5
+	DefaultThrottlingRetryBaseDelay   = 1 * time.Second
+	DefaultThrottlingRetryMaxDelay    = 30 * time.Second
+)
+
+// ThrottlingRetry represents a provider configured throttling_retry block.
+type ThrottlingRetry struct {
+	// MaxAttempts is the maximum number of times a resource operation is run, including the first attempt.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. Subsequent delays double up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Services are the service package names, e.g. "route53", the settings apply to.
+	// If empty the settings apply to all services.
+	Services []string
+}
+
+// Delay returns the randomized exponential backoff delay before the specified retry (1 being the first retry).
+// The result is between half and all of the capped exponential delay.
+func (r ThrottlingRetry) Delay(retry int) time.Duration {
+	delay := r.MaxDelay
+	if retry < 1 {
+		retry = 1
+	}
+	// Avoid overflow for large retry counts.
+	if shift := retry - 1; shift < 32 {
+		if v := r.BaseDelay << shift; v > 0 && v < delay {
+			delay = v
+		}
+	}
+
+	if half := delay / 2; half > 0 {
+		return half + time.Duration(rand.Int63n(int64(half)+1))
+	}
+
+	return delay
+}
+
+// ThrottlingRetryConfig represents the provider's throttling_retry configuration blocks.
+type ThrottlingRetryConfig []ThrottlingRetry
+
+// For returns the throttling retry settings that apply to the specified service package.
+// The first block listing the service takes precedence over the first block without services.
+// Returns nil if throttled resource operations are not retried.
+func (c ThrottlingRetryConfig) For(servicePackageName string) *ThrottlingRetry {
+	for _, v := range c {
+		if slices.Contains(v.Services, servicePackageName) {
+			return &v
+		}
+	}
+	for _, v := range c {
+		if len(v.Services) == 0 {
+			return &v
+		}
+	}
+
+	return nil
+}
+
+// throttlingErrorCodes are the AWS API error codes indicating that a request was throttled.
+var throttlingErrorCodes = []string{
+	"EC2ThrottledException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestThrottledException",
+	"SlowDown",
+	"ThrottledException",
+	"Throttling",
+	"ThrottlingException",
+	"TooManyRequestsException",
+}
+
+// IsThrottlingError returns whether the specified error is an AWS SDK for Go v1 or v2 API error
+// whose error code indicates that the request was throttled.
+func IsThrottlingError(err error) bool {
+	return tfawserr_sdkv2.ErrCodeEquals(err, throttlingErrorCodes...) || tfawserr_sdkv1.ErrCodeEquals(err, throttlingErrorCodes...)
+}
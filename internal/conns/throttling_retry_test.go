@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestThrottlingRetryConfigFor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		config             conns.ThrottlingRetryConfig
+		servicePackageName string
+		want               *conns.ThrottlingRetry
+	}{
+		{
+			name:               "no config",
+			servicePackageName: "route53",
+		},
+		{
+			name: "all services",
+			config: conns.ThrottlingRetryConfig{
+				{
+					MaxAttempts: 3,
+				},
+			},
+			servicePackageName: "route53",
+			want: &conns.ThrottlingRetry{
+				MaxAttempts: 3,
+			},
+		},
+		{
+			name: "no matching service",
+			config: conns.ThrottlingRetryConfig{
+				{
+					MaxAttempts: 3,
+					Services:    []string{"ssm"},
+				},
+			},
+			servicePackageName: "route53",
+		},
+		{
+			name: "service takes precedence",
+			config: conns.ThrottlingRetryConfig{
+				{
+					MaxAttempts: 3,
+				},
+				{
+					MaxAttempts: 10,
+					Services:    []string{"route53", "ssm"},
+				},
+			},
+			servicePackageName: "route53",
+			want: &conns.ThrottlingRetry{
+				MaxAttempts: 10,
+				Services:    []string{"route53", "ssm"},
+			},
+		},
+		{
+			name: "first match wins",
+			config: conns.ThrottlingRetryConfig{
+				{
+					MaxAttempts: 10,
+					Services:    []string{"route53"},
+				},
+				{
+					MaxAttempts: 20,
+					Services:    []string{"route53"},
+				},
+			},
+			servicePackageName: "route53",
+			want: &conns.ThrottlingRetry{
+				MaxAttempts: 10,
+				Services:    []string{"route53"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.For(testCase.servicePackageName)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestThrottlingRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	config := conns.ThrottlingRetry{
+		BaseDelay: 1 * time.Second,
+		MaxDelay:  10 * time.Second,
+	}
+
+	testCases := []struct {
+		retry int
+		max   time.Duration
+	}{
+		{retry: 1, max: 1 * time.Second},
+		{retry: 2, max: 2 * time.Second},
+		{retry: 3, max: 4 * time.Second},
+		{retry: 4, max: 8 * time.Second},
+		{retry: 5, max: 10 * time.Second},
+		{retry: 100, max: 10 * time.Second},
+	}
+
+	for _, testCase := range testCases {
+		got := config.Delay(testCase.retry)
+
+		if got < testCase.max/2 || got > testCase.max {
+			t.Errorf("Delay(%d) = %s, want between %s and %s", testCase.retry, got, testCase.max/2, testCase.max)
+		}
+	}
+}
+
+func TestIsThrottlingError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "AWS SDK for Go v2 throttling",
+			err:  &smithy.GenericAPIError{Code: "Throttling", Message: "Rate exceeded"},
+			want: true,
+		},
+		{
+			name: "AWS SDK for Go v2 wrapped throttling",
+			err:  fmt.Errorf("reading Route 53 Record: %w", &smithy.GenericAPIError{Code: "ThrottlingException"}),
+			want: true,
+		},
+		{
+			name: "AWS SDK for Go v2 other",
+			err:  &smithy.GenericAPIError{Code: "InvalidInput", Message: "Throttling: Rate exceeded"},
+		},
+		{
+			name: "AWS SDK for Go v1 throttling",
+			err:  awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			want: true,
+		},
+		{
+			name: "AWS SDK for Go v1 other",
+			err:  awserr.New("ValidationException", "Throttling: not really", nil),
+		},
+		{
+			name: "message only",
+			err:  errors.New("Throttling: Rate exceeded"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := conns.IsThrottlingError(testCase.err), testCase.want; got != want {
+				t.Errorf("IsThrottlingError() = %v, want %v", got, want)
+			}
+		})
+	}
+}
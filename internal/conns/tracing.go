@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	tracerExportTimeout = 5 * time.Second
+	tracerMaxBatchSize  = 128
+	tracerQueueSize     = 2048
+	tracerScopeName     = "github.com/hashicorp/terraform-provider-aws"
+	tracerServiceName   = "terraform-provider-aws"
+)
+
+var (
+	tracers     []*Tracer
+	tracersLock sync.Mutex
+)
+
+// Span is a single timed resource or data source operation.
+type Span struct {
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Attributes map[string]string
+	// Error is the error message if the operation failed.
+	Error string
+}
+
+// Tracer exports a span for every resource and data source operation to an OpenTelemetry collector.
+// Spans are queued and sent in batches from a background goroutine using OTLP/HTTP with JSON encoding,
+// so a slow or unreachable collector never delays an operation.
+// All spans exported by a configured provider share a single trace.
+type Tracer struct {
+	endpoint   string
+	httpClient *http.Client
+	queue      chan tracerQueueItem
+	traceID    string
+}
+
+// tracerQueueItem is either a span to export or a request to signal once all previously queued spans have been sent.
+type tracerQueueItem struct {
+	span    Span
+	flushed chan struct{}
+}
+
+func NewTracer(endpoint string) *Tracer {
+	t := &Tracer{
+		endpoint: endpoint,
+		httpClient: &http.Client{
+			Timeout: tracerExportTimeout,
+		},
+		queue:   make(chan tracerQueueItem, tracerQueueSize),
+		traceID: randomHex(16),
+	}
+
+	go t.run()
+
+	tracersLock.Lock()
+	tracers = append(tracers, t)
+	tracersLock.Unlock()
+
+	return t
+}
+
+// TraceID returns the hex-encoded ID of the trace that spans are exported in.
+func (t *Tracer) TraceID() string {
+	return t.traceID
+}
+
+// Export queues the span to be sent to the OpenTelemetry collector. It never blocks.
+// If the queue is full the span is dropped and an error is returned.
+func (t *Tracer) Export(_ context.Context, span Span) error {
+	select {
+	case t.queue <- tracerQueueItem{span: span}:
+		return nil
+	default:
+		return fmt.Errorf("exporting span to %s: queue full, span dropped", t.endpoint)
+	}
+}
+
+// Flush waits until all spans queued before the call have been sent or the Context is done.
+func (t *Tracer) Flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+
+	select {
+	case t.queue <- tracerQueueItem{flushed: flushed}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// FlushTracers waits until the spans queued by all tracers have been sent or the Context is done.
+// It is called before the provider process exits.
+func FlushTracers(ctx context.Context) error {
+	tracersLock.Lock()
+	all := tracers
+	tracersLock.Unlock()
+
+	for _, t := range all {
+		if err := t.Flush(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// run sends queued spans until the process exits.
+func (t *Tracer) run() {
+	for item := range t.queue {
+		var spans []Span
+		var flushed []chan struct{}
+
+		// Batch any spans that are already queued.
+	batch:
+		for {
+			if item.flushed != nil {
+				flushed = append(flushed, item.flushed)
+			} else {
+				spans = append(spans, item.span)
+			}
+
+			if len(spans) == tracerMaxBatchSize {
+				break
+			}
+
+			select {
+			case item = <-t.queue:
+			default:
+				break batch
+			}
+		}
+
+		if len(spans) > 0 {
+			if err := t.send(spans); err != nil {
+				// Tracing must never cause an operation to fail.
+				log.Printf("[WARN] %s", err)
+			}
+		}
+
+		for _, v := range flushed {
+			close(v)
+		}
+	}
+}
+
+// send exports the spans to the OpenTelemetry collector in a single request.
+func (t *Tracer) send(spans []Span) error {
+	b, err := json.Marshal(t.request(spans))
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, t.endpoint, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := t.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("exporting %d spans to %s: %w", len(spans), t.endpoint, err)
+	}
+	defer response.Body.Close()
+
+	// Drain the body so that the connection can be reused.
+	io.Copy(io.Discard, response.Body) //nolint:errcheck // Nothing useful can be done with the error.
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("exporting %d spans to %s: unexpected HTTP status: %s", len(spans), t.endpoint, response.Status)
+	}
+
+	return nil
+}
+
+// request returns the OTLP ExportTraceServiceRequest for the spans.
+func (t *Tracer) request(spans []Span) otlpExportTraceServiceRequest {
+	var otlpSpans []otlpSpan
+
+	for _, span := range spans {
+		s := otlpSpan{
+			TraceID:           t.traceID,
+			SpanID:            randomHex(8),
+			Name:              span.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+			Status: otlpStatus{
+				Code: otlpStatusCodeOK,
+			},
+		}
+
+		keys := make([]string, 0, len(span.Attributes))
+		for k := range span.Attributes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			s.Attributes = append(s.Attributes, newOTLPKeyValue(k, span.Attributes[k]))
+		}
+
+		if span.Error != "" {
+			s.Status = otlpStatus{
+				Code:    otlpStatusCodeError,
+				Message: span.Error,
+			}
+		}
+
+		otlpSpans = append(otlpSpans, s)
+	}
+
+	return otlpExportTraceServiceRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpKeyValue{newOTLPKeyValue("service.name", tracerServiceName)},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpInstrumentationScope{
+					Name: tracerScopeName,
+				},
+				Spans: otlpSpans,
+			}},
+		}},
+	}
+}
+
+// randomHex returns n random bytes, hex-encoded.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b) //nolint:errcheck // crypto/rand.Read never returns an error on supported platforms.
+
+	return hex.EncodeToString(b)
+}
+
+// The following types are the subset of the OTLP JSON encoding used to export spans.
+// See https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+
+type otlpExportTraceServiceRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpInstrumentationScope `json:"scope"`
+	Spans []otlpSpan               `json:"spans"`
+}
+
+type otlpInstrumentationScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+)
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpStatusCodeOK    = 1
+	otlpStatusCodeError = 2
+)
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+func newOTLPKeyValue(key, value string) otlpKeyValue {
+	return otlpKeyValue{
+		Key: key,
+		Value: otlpAnyValue{
+			StringValue: value,
+		},
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTracerExport(t *testing.T) {
+	t.Parallel()
+
+	var got []otlpSpan
+	var lock sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("Content-Type"); v != "application/json" {
+			t.Errorf("unexpected Content-Type: %s", v)
+		}
+
+		var request otlpExportTraceServiceRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		for _, v := range request.ResourceSpans {
+			if got, want := v.Resource.Attributes, []otlpKeyValue{newOTLPKeyValue("service.name", tracerServiceName)}; !cmp.Equal(got, want) {
+				t.Errorf("unexpected resource attributes: %v", got)
+			}
+			for _, v := range v.ScopeSpans {
+				got = append(got, v.Spans...)
+			}
+		}
+	}))
+	defer server.Close()
+
+	tracer := NewTracer(server.URL)
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	spans := []Span{
+		{
+			Name:  "aws_sns_topic create",
+			Start: start,
+			End:   start.Add(2 * time.Second),
+			Attributes: map[string]string{
+				"tf_aws.resource_type": "aws_sns_topic",
+				"tf_aws.operation":     "create",
+			},
+		},
+		{
+			Name:  "aws_sns_topic delete",
+			Start: start.Add(3 * time.Second),
+			End:   start.Add(4 * time.Second),
+			Error: "AccessDenied",
+		},
+	}
+
+	for _, span := range spans {
+		if err := tracer.Export(context.Background(), span); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := tracer.Flush(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if got, want := len(got), len(spans); got != want {
+		t.Fatalf("got %d spans, want %d", got, want)
+	}
+
+	var spanIDs []string
+	for i := range got {
+		if got, want := got[i].TraceID, tracer.TraceID(); got != want {
+			t.Errorf("got trace ID %q, want %q", got, want)
+		}
+		if got, want := len(got[i].SpanID), 16; got != want {
+			t.Errorf("got span ID length %d, want %d", got, want)
+		}
+		spanIDs = append(spanIDs, got[i].SpanID)
+		// Span IDs are random.
+		got[i].SpanID = ""
+	}
+
+	if spanIDs[0] == spanIDs[1] {
+		t.Errorf("span IDs are not unique: %s", spanIDs[0])
+	}
+
+	want := []otlpSpan{
+		{
+			TraceID:           tracer.TraceID(),
+			Name:              "aws_sns_topic create",
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: "1704164645000000000",
+			EndTimeUnixNano:   "1704164647000000000",
+			Attributes: []otlpKeyValue{
+				newOTLPKeyValue("tf_aws.operation", "create"),
+				newOTLPKeyValue("tf_aws.resource_type", "aws_sns_topic"),
+			},
+			Status: otlpStatus{Code: otlpStatusCodeOK},
+		},
+		{
+			TraceID:           tracer.TraceID(),
+			Name:              "aws_sns_topic delete",
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: "1704164648000000000",
+			EndTimeUnixNano:   "1704164649000000000",
+			Status:            otlpStatus{Code: otlpStatusCodeError, Message: "AccessDenied"},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected spans diff (+want, -got): %s", diff)
+	}
+}
+
+func TestTracerExportError(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+	var lock sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests++
+		lock.Unlock()
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tracer := NewTracer(server.URL)
+
+	// Errors sending spans are logged and are not returned by Export.
+	if err := tracer.Export(context.Background(), Span{Name: "aws_sns_topic read"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := tracer.Flush(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if got, want := requests, 1; got != want {
+		t.Errorf("got %d requests, want %d", got, want)
+	}
+}
+
+func TestTracerExportUnresponsiveEndpoint(t *testing.T) {
+	t.Parallel()
+
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	tracer := NewTracer(server.URL)
+
+	// The first batch is being sent, so this fills the queue and then some.
+	var dropped int
+	start := time.Now()
+	for i := 0; i < tracerMaxBatchSize+tracerQueueSize+1; i++ {
+		if err := tracer.Export(context.Background(), Span{Name: "aws_sns_topic read"}); err != nil {
+			dropped++
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed >= tracerExportTimeout {
+		t.Errorf("Export blocked for %s", elapsed)
+	}
+	if dropped == 0 {
+		t.Error("expected spans to be dropped")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := tracer.Flush(ctx); err == nil {
+		t.Error("expected Flush to time out")
+	}
+}
@@ -108,6 +108,8 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	FoundFunc               string
+	FoundTimeout            string
 }
 
 type ServiceDatum struct {
@@ -185,12 +187,26 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and eventual consistency annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Found" {
+			args := common.ParseArgs(m[3])
+
+			if attr, ok := args.Keyword["func"]; ok {
+				d.FoundFunc = attr
+			} else {
+				v.errs = append(v.errs, fmt.Errorf("no func in Found annotation: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["timeout"]; ok {
+				d.FoundTimeout = attr
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -261,7 +277,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Found", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// assumeRoleResourceInterceptor makes a resource's AWS API calls using the credentials of the IAM role set by its `assume_role` argument.
+type assumeRoleResourceInterceptor struct{}
+
+func (r assumeRoleResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Importers only set the resource ID, so imported resources are read with the provider's credentials
+		// until `assume_role` is set in state by the next apply.
+		v, ok := d.Get(names.AttrAssumeRole).([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			return ctx, diags
+		}
+
+		assumeRole := expandAssumeRole(ctx, v[0].(map[string]interface{}))
+		// The role ARN is unknown during planning if it depends on resources not yet created.
+		if assumeRole.RoleARN == "" {
+			return ctx, diags
+		}
+
+		client, err := c.ForAssumeRole(ctx, assumeRole)
+		if err != nil {
+			return ctx, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		ctx = conns.NewAWSClientContext(ctx, client)
+	}
+
+	return ctx, diags
+}
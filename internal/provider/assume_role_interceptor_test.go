@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAssumeRoleResourceInterceptorRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		roleARN       string
+		wantAccountID string
+	}{
+		{
+			name:          "provider credentials",
+			wantAccountID: "123456789012",
+		},
+		{
+			name:          "assumed role",
+			roleARN:       "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+			wantAccountID: "210987654321",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotAccountID string
+			rs := &wrappedResource{
+				bootstrapContext: func(ctx context.Context, meta any) context.Context {
+					return ctx
+				},
+				interceptors: interceptorItems{
+					{
+						when:        Before,
+						why:         AllOps | Diff,
+						interceptor: assumeRoleResourceInterceptor{},
+					},
+				},
+			}
+			r := &schema.Resource{
+				ReadWithoutTimeout: rs.Read(func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+					gotAccountID = meta.(*conns.AWSClient).AccountID
+					return nil
+				}),
+				Schema: map[string]*schema.Schema{
+					"assume_role": assumeRoleSchema(),
+				},
+			}
+
+			d := r.TestResourceData()
+			d.SetId("test")
+			if testCase.roleARN != "" {
+				d.Set("assume_role", []interface{}{map[string]interface{}{"role_arn": testCase.roleARN}})
+			}
+			meta := &conns.AWSClient{
+				AccountID: "123456789012",
+				Partition: "aws",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			}
+
+			diags := r.ReadWithoutTimeout(context.Background(), d, meta)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := gotAccountID, testCase.wantAccountID; got != want {
+				t.Errorf("Read handler account ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// auditLogResourceInterceptor appends an entry to the provider's audit_log_path for every resource create, update and delete.
+type auditLogResourceInterceptor struct {
+	typeName string
+}
+
+func (r auditLogResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.AuditLog == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Record the request IDs of all AWS API calls made by the operation.
+		ctx = conns.NewAPIRequestIDsContext(ctx)
+	case Finally:
+		var action string
+		switch why {
+		case Create:
+			action = "create"
+		case Update:
+			action = "update"
+		case Delete:
+			action = "delete"
+		default:
+			return ctx, diags
+		}
+
+		entry := conns.AuditLogEntry{
+			Timestamp:    time.Now().UTC(),
+			AccountID:    c.AccountID,
+			Region:       c.Region,
+			ResourceType: r.typeName,
+			Action:       action,
+			ID:           d.Id(),
+			Result:       conns.AuditLogResultSuccess,
+			RequestIDs:   conns.APIRequestIDsFromContext(ctx),
+		}
+
+		callerARN, err := c.CallerARN(ctx)
+		if err != nil {
+			tflog.Warn(ctx, "retrieving caller identity for audit log", map[string]any{
+				"error": err.Error(),
+			})
+		}
+		entry.CallerARN = callerARN
+		if diags.HasError() {
+			entry.Result = conns.AuditLogResultFailure
+			entry.Error = auditLogError(diags)
+		}
+
+		if err := c.AuditLog.Append(entry); err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "%s", err)
+		}
+	}
+
+	return ctx, diags
+}
+
+// auditLogError returns the summary of the first error in the specified Diagnostics.
+func auditLogError(diags diag.Diagnostics) string {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return d.Summary
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// circuitBreakerResourceInterceptor fails resource operations on a service without making any AWS API calls
+// once the provider's circuit_breaker_threshold of consecutive operations on the service have failed with AWS server or credential errors.
+type circuitBreakerResourceInterceptor struct{}
+
+func (r circuitBreakerResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if err := c.CircuitBreakerError(inContext.ServicePackageName); err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "%s (%s): %s", resourceNameFromContext(ctx), d.Id(), err)
+		}
+
+		// Record the final error of the operation's AWS API calls.
+		ctx = conns.NewAPIErrorContext(ctx)
+	case After:
+		c.RecordCircuitBreakerResult(inContext.ServicePackageName, nil)
+	case OnError:
+		c.RecordCircuitBreakerResult(inContext.ServicePackageName, conns.APIErrorFromContext(ctx))
+	}
+
+	return ctx, diags
+}
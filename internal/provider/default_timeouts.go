@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// applyDefaultTimeouts applies the provider's configured default_timeouts to resources' declared default timeouts.
+// Only operations for which a resource declares a timeout are affected, as the resource's `timeouts` block schema
+// has already been reported to Terraform. Any timeouts set in a resource's configuration still take precedence.
+func applyDefaultTimeouts(resources map[string]*schema.Resource, declared map[string]schema.ResourceTimeout, config conns.DefaultTimeoutsConfig) {
+	for typeName, timeouts := range declared {
+		r, ok := resources[typeName]
+		if !ok {
+			continue
+		}
+
+		timeouts := timeouts
+
+		if v := config.For(typeName); v != nil {
+			if timeouts.Create != nil && v.Create != nil {
+				timeouts.Create = v.Create
+			}
+			if timeouts.Read != nil && v.Read != nil {
+				timeouts.Read = v.Read
+			}
+			if timeouts.Update != nil && v.Update != nil {
+				timeouts.Update = v.Update
+			}
+			if timeouts.Delete != nil && v.Delete != nil {
+				timeouts.Delete = v.Delete
+			}
+		}
+
+		r.Timeouts = &timeouts
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestApplyDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	declared := map[string]schema.ResourceTimeout{
+		"aws_db_instance": {
+			Create: duration(40 * time.Minute),
+			Update: duration(80 * time.Minute),
+			Delete: duration(60 * time.Minute),
+		},
+		"aws_sqs_queue": {
+			Create: duration(20 * time.Minute),
+		},
+	}
+	resources := map[string]*schema.Resource{
+		"aws_db_instance": {},
+		"aws_sqs_queue":   {},
+	}
+
+	config := conns.DefaultTimeoutsConfig{
+		{
+			Create: duration(30 * time.Minute),
+			Read:   duration(10 * time.Minute),
+		},
+		{
+			Create:        duration(120 * time.Minute),
+			ResourceTypes: []string{"aws_db_*"},
+		},
+	}
+
+	applyDefaultTimeouts(resources, declared, config)
+
+	if diff := cmp.Diff(resources["aws_db_instance"].Timeouts, &schema.ResourceTimeout{
+		Create: duration(120 * time.Minute),
+		Update: duration(80 * time.Minute),
+		Delete: duration(60 * time.Minute),
+	}); diff != "" {
+		t.Errorf("unexpected aws_db_instance timeouts diff (+wanted, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(resources["aws_sqs_queue"].Timeouts, &schema.ResourceTimeout{
+		Create: duration(30 * time.Minute),
+	}); diff != "" {
+		t.Errorf("unexpected aws_sqs_queue timeouts diff (+wanted, -got): %s", diff)
+	}
+
+	// Reconfiguring without default timeouts restores the declared timeouts.
+	applyDefaultTimeouts(resources, declared, nil)
+
+	if diff := cmp.Diff(resources["aws_db_instance"].Timeouts, &schema.ResourceTimeout{
+		Create: duration(40 * time.Minute),
+		Update: duration(80 * time.Minute),
+		Delete: duration(60 * time.Minute),
+	}); diff != "" {
+		t.Errorf("unexpected aws_db_instance timeouts diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// deletionProtectionResourceInterceptor refuses to delete resources carrying the provider's configured deletion protection tag.
+type deletionProtectionResourceInterceptor struct {
+	// tagsAttribute is the attribute in the resource's schema holding the resource's tags.
+	tagsAttribute string
+}
+
+func (r deletionProtectionResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.DeletionProtectionTagConfig == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Delete:
+			tags, ok := d.Get(r.tagsAttribute).(map[string]interface{})
+			if !ok {
+				return ctx, diags
+			}
+
+			if config := c.DeletionProtectionTagConfig; config.Protects(tftags.New(ctx, tags)) {
+				return ctx, sdkdiag.AppendErrorf(diags, "deleting %s (%s): protected by provider deletion_protection_tag (%s)", resourceNameFromContext(ctx), d.Id(), config)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// resourceNameFromContext returns the friendly service and resource name, e.g. "SNS Topic", held in Context.
+func resourceNameFromContext(ctx context.Context) string {
+	serviceName, resourceName := "<service>", "<thing>"
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		if v, err := names.HumanFriendly(inContext.ServicePackageName); err == nil {
+			serviceName = v
+		}
+
+		if v := inContext.ResourceName; v != "" {
+			resourceName = v
+		}
+	}
+
+	return serviceName + " " + resourceName
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestDeletionProtectionResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	protect := deletionProtectionResourceInterceptor{
+		tagsAttribute: "tags_all",
+	}
+
+	testCases := []struct {
+		name    string
+		config  *tftags.DeletionProtectionConfig
+		tags    map[string]interface{}
+		why     why
+		wantErr bool
+	}{
+		{
+			name: "no config",
+			tags: map[string]interface{}{
+				"tf-protected": "true",
+			},
+			why: Delete,
+		},
+		{
+			name: "protected",
+			config: &tftags.DeletionProtectionConfig{
+				Key:   "tf-protected",
+				Value: "true",
+			},
+			tags: map[string]interface{}{
+				"tf-protected": "true",
+			},
+			why:     Delete,
+			wantErr: true,
+		},
+		{
+			name: "protected update",
+			config: &tftags.DeletionProtectionConfig{
+				Key:   "tf-protected",
+				Value: "true",
+			},
+			tags: map[string]interface{}{
+				"tf-protected": "true",
+			},
+			why: Update,
+		},
+		{
+			name: "not protected",
+			config: &tftags.DeletionProtectionConfig{
+				Key:   "tf-protected",
+				Value: "true",
+			},
+			tags: map[string]interface{}{
+				"tf-protected": "false",
+			},
+			why: Delete,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Thing")
+			meta := &conns.AWSClient{
+				DeletionProtectionTagConfig: testCase.config,
+			}
+			d := &valuesResourceData{
+				values: map[string]any{
+					"tags_all": testCase.tags,
+				},
+			}
+
+			var diags diag.Diagnostics
+			_, diags = protect.run(ctx, d, meta, Before, testCase.why, diags)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("diags.HasError() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -24,7 +24,9 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return newSecurityChecksProviderServer(primary)
+		},
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	defaultFoundTimeout = 2 * time.Minute
+)
+
+// foundResourceInterceptor waits for a newly created resource to be visible to the service API,
+// for services that are eventually consistent.
+type foundResourceInterceptor struct {
+	found *types.ServicePackageResourceFound
+}
+
+func (r foundResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		switch why {
+		case Create:
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			timeout := r.found.Timeout
+			if timeout == 0 {
+				timeout = defaultFoundTimeout
+			}
+
+			_, err := tfresource.RetryWhenNotFound(ctx, timeout, func() (interface{}, error) {
+				return nil, r.found.Func(ctx, d, meta)
+			})
+
+			if err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "waiting for %s (%s) create: %s", resourceNameFromContext(ctx), d.Id(), err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// readAfterCreate returns a Create handler that reads the new resource once the specified Create handler has succeeded.
+// Resources that wait to be found after creation are read by the provider, not by their own Create handler,
+// so that the Read runs after the foundResourceInterceptor.
+func readAfterCreate(create schema.CreateContextFunc, read schema.ReadContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := create(ctx, d, meta)
+
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		return append(diags, read(ctx, d, meta)...)
+	}
+}
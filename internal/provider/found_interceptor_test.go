@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestFoundResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		notFound    int
+		wantErr     bool
+		wantReadRun bool
+	}{
+		{
+			name:        "found immediately",
+			wantReadRun: true,
+		},
+		{
+			name:        "found after retry",
+			notFound:    1,
+			wantReadRun: true,
+		},
+		{
+			name:     "never found",
+			notFound: -1,
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			var foundBeforeRead, readRun bool
+			found := &types.ServicePackageResourceFound{
+				Func: func(ctx context.Context, d types.SDKResourceData, meta any) error {
+					calls++
+					if testCase.notFound < 0 || calls <= testCase.notFound {
+						return &retry.NotFoundError{}
+					}
+					return nil
+				},
+				Timeout: 2 * time.Second,
+			}
+			rs := &wrappedResource{
+				bootstrapContext: func(ctx context.Context, meta any) context.Context {
+					return ctx
+				},
+				interceptors: interceptorItems{
+					{
+						when:        After,
+						why:         Create,
+						interceptor: foundResourceInterceptor{found: found},
+					},
+				},
+			}
+			create := rs.Create(func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				d.SetId("test")
+				return nil
+			})
+			read := rs.Read(func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				readRun = true
+				foundBeforeRead = calls > testCase.notFound
+				return nil
+			})
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			}
+
+			diags := readAfterCreate(create, read)(context.Background(), r.TestResourceData(), nil)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("HasError = %v, want %v: %v", got, want, diags)
+			}
+			if got, want := readRun, testCase.wantReadRun; got != want {
+				t.Errorf("Read run = %v, want %v", got, want)
+			}
+			if readRun && !foundBeforeRead {
+				t.Errorf("Read run before resource found")
+			}
+		})
+	}
+}
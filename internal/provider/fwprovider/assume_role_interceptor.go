@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tffwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// assumeRoleResourceInterceptor makes a resource's AWS API calls using the credentials of the IAM role set by its `assume_role` argument.
+type assumeRoleResourceInterceptor struct{}
+
+// schema adds the `assume_role` argument to the resource's schema.
+func (r assumeRoleResourceInterceptor) schema(ctx context.Context, response *resource.SchemaResponse) {
+	if response.Schema.Blocks == nil {
+		response.Schema.Blocks = make(map[string]schema.Block)
+	}
+
+	response.Schema.Blocks[names.AttrAssumeRole] = schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"duration": schema.StringAttribute{
+					CustomType:  tffwtypes.DurationType,
+					Optional:    true,
+					Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				},
+				"external_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "A unique identifier that might be required when you assume a role in another account.",
+				},
+				"policy": schema.StringAttribute{
+					Optional:    true,
+					Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"policy_arns": schema.SetAttribute{
+					ElementType: fwtypes.StringType,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+				},
+				"role_arn": schema.StringAttribute{
+					Optional:    true,
+					Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls.",
+				},
+				"session_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "An identifier for the assumed role session.",
+				},
+				"source_identity": schema.StringAttribute{
+					Optional:    true,
+					Description: "Source identity specified by the principal assuming the role.",
+				},
+				"tags": schema.MapAttribute{
+					ElementType: fwtypes.StringType,
+					Optional:    true,
+					Description: "Assume role session tags.",
+				},
+				"transitive_tag_keys": schema.SetAttribute{
+					ElementType: fwtypes.StringType,
+					Optional:    true,
+					Description: "Assume role session tag keys to pass to any subsequent sessions.",
+				},
+			},
+		},
+	}
+}
+
+func (r assumeRoleResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return ctx, diags
+	}
+
+	return r.before(ctx, request.Plan.GetAttribute, meta, diags)
+}
+
+func (r assumeRoleResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r assumeRoleResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.State.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r assumeRoleResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r assumeRoleResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.State.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// Importers only set the resource ID, so imported resources are read with the provider's credentials
+// until `assume_role` is set in state by the next apply.
+func (r assumeRoleResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r assumeRoleResourceInterceptor) before(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	var list fwtypes.List
+	diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRole), &list)...)
+	if diags.HasError() || list.IsNull() || list.IsUnknown() || len(list.Elements()) == 0 {
+		return ctx, diags
+	}
+
+	var data []assumeRoleModel
+	diags.Append(list.ElementsAs(ctx, &data, false)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	// The role ARN is unknown during planning if it depends on resources not yet created.
+	assumeRole := data[0].expand(ctx)
+	if assumeRole.RoleARN == "" {
+		return ctx, diags
+	}
+
+	client, err := meta.ForAssumeRole(ctx, assumeRole)
+	if err != nil {
+		diags.AddError("assuming IAM Role", err.Error())
+
+		return ctx, diags
+	}
+
+	return conns.NewAWSClientContext(ctx, client), diags
+}
+
+type assumeRoleModel struct {
+	Duration          tffwtypes.Duration `tfsdk:"duration"`
+	ExternalID        fwtypes.String     `tfsdk:"external_id"`
+	Policy            fwtypes.String     `tfsdk:"policy"`
+	PolicyARNs        fwtypes.Set        `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.String     `tfsdk:"role_arn"`
+	SessionName       fwtypes.String     `tfsdk:"session_name"`
+	SourceIdentity    fwtypes.String     `tfsdk:"source_identity"`
+	Tags              fwtypes.Map        `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.Set        `tfsdk:"transitive_tag_keys"`
+}
+
+func (m assumeRoleModel) expand(ctx context.Context) *awsbase.AssumeRole {
+	assumeRole := awsbase.AssumeRole{
+		Duration:          m.Duration.ValueDuration(),
+		ExternalID:        m.ExternalID.ValueString(),
+		Policy:            m.Policy.ValueString(),
+		PolicyARNs:        flex.ExpandFrameworkStringValueSet(ctx, m.PolicyARNs),
+		RoleARN:           m.RoleARN.ValueString(),
+		SessionName:       m.SessionName.ValueString(),
+		SourceIdentity:    m.SourceIdentity.ValueString(),
+		Tags:              flex.ExpandFrameworkStringValueMap(ctx, m.Tags),
+		TransitiveTagKeys: flex.ExpandFrameworkStringValueSet(ctx, m.TransitiveTagKeys),
+	}
+
+	return &assumeRole
+}
@@ -6,11 +6,13 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -46,10 +48,10 @@ func (s dataSourceInterceptors) read() []dataSourceInterceptorReadFunc {
 }
 
 type resourceCRUDRequest interface {
-	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest
+	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest | resource.ImportStateRequest
 }
 type resourceCRUDResponse interface {
-	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse
+	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse | resource.ImportStateResponse
 }
 
 // A resource interceptor is functionality invoked during the resource's CRUD request lifecycle.
@@ -65,10 +67,34 @@ type resourceInterceptor interface {
 	update(context.Context, resource.UpdateRequest, *resource.UpdateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 	// delete is invoke for a Delete call.
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// importState is invoked for an ImportState call.
+	importState(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
+// A resource schema interceptor adds arguments to the resource's schema and plans their values.
+// The Context returned by modifyPlan is used to plan the rest of the resource.
+type resourceSchemaInterceptor interface {
+	// schema is invoked for a Schema call.
+	schema(context.Context, *resource.SchemaResponse)
+	// modifyPlan is invoked for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
 type resourceInterceptors []resourceInterceptor
 
+// schema returns a slice of interceptors that add arguments to the resource's schema.
+func (s resourceInterceptors) schema() []resourceSchemaInterceptor {
+	var interceptors []resourceSchemaInterceptor
+
+	for _, v := range s {
+		if v, ok := v.(resourceSchemaInterceptor); ok {
+			interceptors = append(interceptors, v)
+		}
+	}
+
+	return interceptors
+}
+
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] interceptorFunc[Request, Response]
 
 // create returns a slice of interceptors that run on resource Create.
@@ -99,6 +125,13 @@ func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.Delete
 	})
 }
 
+// importState returns a slice of interceptors that run on resource ImportState.
+func (s resourceInterceptors) importState() []resourceInterceptorFunc[resource.ImportStateRequest, resource.ImportStateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.ImportStateRequest, resource.ImportStateResponse] {
+		return e.importState
+	})
+}
+
 // when represents the point in the CRUD request lifecycle that an interceptor is run.
 // Multiple values can be ORed together.
 type when uint16
@@ -158,6 +191,7 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 		// Before interceptors are run first to last.
 		forward := interceptors
 
+		meta := meta
 		when := Before
 		for _, v := range forward {
 			ctx, diags = v(ctx, request, response, meta, when, diags)
@@ -166,6 +200,11 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 			if diags.HasError() {
 				return diags
 			}
+
+			// Subsequent interceptors use any AWSClient set in Context, e.g. for the resource's region.
+			if v, ok := conns.AWSClientFromContext(ctx); ok {
+				meta = v
+			}
 		}
 
 		// All other interceptors are run last to first.
@@ -226,7 +265,7 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	diags := interceptedDataSourceReadHandler(w.interceptors.read(), throttlingRetryHandler(f, w.meta, resetDataSourceReadResponse), w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
@@ -244,7 +283,46 @@ type tagsDataSourceInterceptor struct {
 }
 
 func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
-	// TODO
+	if r.tags == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		var configTags fwtypes.Map
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrTags), &configTags)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		// Get the data source's configured tags.
+		tagsInContext.TagsIn = option.Some(tftags.New(ctx, configTags))
+	case After:
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
+		tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+		stateTags := flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.Map())
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+	}
+
 	return ctx, diags
 }
 
@@ -255,13 +333,15 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	typeName         string
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, typeName string, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		typeName:         typeName,
 	}
 }
 
@@ -273,45 +353,75 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	for _, v := range w.interceptors.schema() {
+		v.schema(ctx, response)
+	}
+}
+
+// configureInner configures the inner resource with any AWSClient set in Context by an interceptor, e.g. for the resource's region.
+// Each wrapped resource has its own inner resource, so this doesn't affect other operations.
+func (w *wrappedResource) configureInner(ctx context.Context) diag.Diagnostics {
+	v, ok := conns.AWSClientFromContext(ctx)
+	if !ok || v == w.meta {
+		return nil
+	}
+
+	response := resource.ConfigureResponse{}
+	w.inner.Configure(ctx, resource.ConfigureRequest{ProviderData: v}, &response)
+
+	return response.Diagnostics
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if diags := w.configureInner(ctx); diags.HasError() {
+			return diags
+		}
 		w.inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+	diags := interceptedResourceHandler(w.interceptors.create(), throttlingRetryHandler(f, w.meta, resetResourceCreateResponse), w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if diags := w.configureInner(ctx); diags.HasError() {
+			return diags
+		}
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	diags := interceptedResourceHandler(w.interceptors.read(), throttlingRetryHandler(f, w.meta, resetResourceReadResponse), w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if diags := w.configureInner(ctx); diags.HasError() {
+			return diags
+		}
 		w.inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+	diags := interceptedResourceHandler(w.interceptors.update(), throttlingRetryHandler(f, w.meta, resetResourceUpdateResponse), w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if diags := w.configureInner(ctx); diags.HasError() {
+			return diags
+		}
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+	diags := interceptedResourceHandler(w.interceptors.delete(), throttlingRetryHandler(f, w.meta, resetResourceDeleteResponse), w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
@@ -321,12 +431,45 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Configure(ctx, request, response)
+
+	if w.meta != nil {
+		applyDefaultTimeouts(w.inner, w.meta.DefaultTimeoutsConfig.For(w.typeName))
+	}
+}
+
+// applyDefaultTimeouts applies any provider configured default_timeouts to a resource embedding framework.WithTimeouts.
+// Any timeouts set in the resource's configuration still take precedence.
+func applyDefaultTimeouts(r resource.Resource, timeouts *conns.DefaultTimeouts) {
+	if timeouts == nil {
+		return
+	}
+
+	if v, ok := r.(interface{ SetDefaultCreateTimeout(time.Duration) }); ok && timeouts.Create != nil {
+		v.SetDefaultCreateTimeout(*timeouts.Create)
+	}
+	if v, ok := r.(interface{ SetDefaultReadTimeout(time.Duration) }); ok && timeouts.Read != nil {
+		v.SetDefaultReadTimeout(*timeouts.Read)
+	}
+	if v, ok := r.(interface{ SetDefaultUpdateTimeout(time.Duration) }); ok && timeouts.Update != nil {
+		v.SetDefaultUpdateTimeout(*timeouts.Update)
+	}
+	if v, ok := r.(interface{ SetDefaultDeleteTimeout(time.Duration) }); ok && timeouts.Delete != nil {
+		v.SetDefaultDeleteTimeout(*timeouts.Delete)
+	}
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
+		f := func(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) diag.Diagnostics {
+			if diags := w.configureInner(ctx); diags.HasError() {
+				return diags
+			}
+			v.ImportState(ctx, request, response)
+			return response.Diagnostics
+		}
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		diags := interceptedResourceHandler(w.interceptors.importState(), throttlingRetryHandler(f, w.meta, newResetResourceImportStateResponse(response)), w.meta)(ctx, request, response)
+		response.Diagnostics = diags
 
 		return
 	}
@@ -338,8 +481,26 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	meta := w.meta
+	for _, v := range w.interceptors.schema() {
+		ctx, response.Diagnostics = v.modifyPlan(ctx, request, response, meta, response.Diagnostics)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if v, ok := conns.AWSClientFromContext(ctx); ok {
+			meta = v
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		if diags := w.configureInner(ctx); diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		}
 		v.ModifyPlan(ctx, request, response)
 	}
 }
@@ -653,3 +814,349 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// importState is a no-op as importers only set the resource's identifier.
+// Tags are set by the Read that follows import.
+func (r tagsResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// deletionProtectionResourceInterceptor refuses to delete resources carrying the provider's configured deletion protection tag.
+type deletionProtectionResourceInterceptor struct{}
+
+func (r deletionProtectionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionProtectionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionProtectionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionProtectionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || meta.DeletionProtectionTagConfig == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+	if err != nil {
+		serviceName = "<service>"
+	}
+
+	resourceName := inContext.ResourceName
+	if resourceName == "" {
+		resourceName = "<thing>"
+	}
+
+	switch when {
+	case Before:
+		var stateTagsAll fwtypes.Map
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if config := meta.DeletionProtectionTagConfig; config.Protects(tftags.New(ctx, stateTagsAll)) {
+			var id fwtypes.String
+			// Not all resources have an "id" attribute.
+			request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)
+
+			diags.AddError(
+				fmt.Sprintf("deleting %s %s (%s)", serviceName, resourceName, id.ValueString()),
+				fmt.Sprintf("protected by provider deletion_protection_tag (%s)", config),
+			)
+
+			return ctx, diags
+		}
+	}
+
+	return ctx, diags
+}
+
+func (r deletionProtectionResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// circuitBreakerResourceInterceptor fails resource operations on a service without making any AWS API calls
+// once the provider's circuit_breaker_threshold of consecutive operations on the service have failed with AWS server or credential errors.
+type circuitBreakerResourceInterceptor struct{}
+
+func (r circuitBreakerResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, "creating", diags)
+}
+
+func (r circuitBreakerResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, "reading", diags)
+}
+
+func (r circuitBreakerResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, "updating", diags)
+}
+
+func (r circuitBreakerResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, "deleting", diags)
+}
+
+func (r circuitBreakerResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r circuitBreakerResourceInterceptor) run(ctx context.Context, meta *conns.AWSClient, when when, action string, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if err := meta.CircuitBreakerError(inContext.ServicePackageName); err != nil {
+			diags.AddError(fmt.Sprintf("%s %s", action, resourceNameFromContext(ctx)), err.Error())
+			return ctx, diags
+		}
+
+		// Record the final error of the operation's AWS API calls.
+		ctx = conns.NewAPIErrorContext(ctx)
+	case After:
+		meta.RecordCircuitBreakerResult(inContext.ServicePackageName, nil)
+	case OnError:
+		meta.RecordCircuitBreakerResult(inContext.ServicePackageName, conns.APIErrorFromContext(ctx))
+	}
+
+	return ctx, diags
+}
+
+// readOnlyResourceInterceptor refuses to create, update or delete resources if the provider is configured as read_only.
+type readOnlyResourceInterceptor struct{}
+
+func (r readOnlyResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || !meta.ReadOnly || when != Before {
+		return ctx, diags
+	}
+
+	diags.AddError(fmt.Sprintf("creating %s", resourceNameFromContext(ctx)), "provider is configured with read_only")
+
+	return ctx, diags
+}
+
+func (r readOnlyResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r readOnlyResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || !meta.ReadOnly || when != Before {
+		return ctx, diags
+	}
+
+	var id fwtypes.String
+	// Not all resources have an "id" attribute.
+	request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)
+
+	diags.AddError(fmt.Sprintf("updating %s (%s)", resourceNameFromContext(ctx), id.ValueString()), "provider is configured with read_only")
+
+	return ctx, diags
+}
+
+func (r readOnlyResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || !meta.ReadOnly || when != Before {
+		return ctx, diags
+	}
+
+	var id fwtypes.String
+	// Not all resources have an "id" attribute.
+	request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)
+
+	diags.AddError(fmt.Sprintf("deleting %s (%s)", resourceNameFromContext(ctx), id.ValueString()), "provider is configured with read_only")
+
+	return ctx, diags
+}
+
+func (r readOnlyResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// auditLogResourceInterceptor appends an entry to the provider's audit_log_path for every resource create, update and delete.
+type auditLogResourceInterceptor struct {
+	typeName string
+}
+
+func (r auditLogResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, response.State, meta, when, "create", diags)
+}
+
+func (r auditLogResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r auditLogResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, response.State, meta, when, "update", diags)
+}
+
+func (r auditLogResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, request.State, meta, when, "delete", diags)
+}
+
+func (r auditLogResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r auditLogResourceInterceptor) run(ctx context.Context, state tfsdk.State, meta *conns.AWSClient, when when, action string, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || meta.AuditLog == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Record the request IDs of all AWS API calls made by the operation.
+		ctx = conns.NewAPIRequestIDsContext(ctx)
+	case Finally:
+		var id fwtypes.String
+		// Not all resources have an "id" attribute.
+		state.GetAttribute(ctx, path.Root(names.AttrID), &id)
+
+		entry := conns.AuditLogEntry{
+			Timestamp:    time.Now().UTC(),
+			AccountID:    meta.AccountID,
+			Region:       meta.Region,
+			ResourceType: r.typeName,
+			Action:       action,
+			ID:           id.ValueString(),
+			Result:       conns.AuditLogResultSuccess,
+			RequestIDs:   conns.APIRequestIDsFromContext(ctx),
+		}
+
+		callerARN, err := meta.CallerARN(ctx)
+		if err != nil {
+			tflog.Warn(ctx, "retrieving caller identity for audit log", map[string]any{
+				"error": err.Error(),
+			})
+		}
+		entry.CallerARN = callerARN
+
+		if v := diags.Errors(); len(v) > 0 {
+			entry.Result = conns.AuditLogResultFailure
+			entry.Error = v[0].Summary()
+		}
+
+		if err := meta.AuditLog.Append(entry); err != nil {
+			diags.AddWarning("appending audit log entry", err.Error())
+		}
+	}
+
+	return ctx, diags
+}
+
+type tracingSpanStartKeyType int
+
+var tracingSpanStartKey tracingSpanStartKeyType
+
+// tracingInterceptor exports an OpenTelemetry span for every resource or data source operation if the provider's otel_traces_endpoint is set.
+// It must be the first interceptor so that spans cover all other interceptors.
+type tracingInterceptor struct {
+	typeName string
+}
+
+func (r tracingInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, response.State, meta, when, "create", diags)
+}
+
+func (r tracingInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, response.State, meta, when, "read", diags)
+}
+
+func (r tracingInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, response.State, meta, when, "update", diags)
+}
+
+func (r tracingInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, request.State, meta, when, "delete", diags)
+}
+
+func (r tracingInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, response.State, meta, when, "import", diags)
+}
+
+// tracingDataSourceInterceptor adapts tracingInterceptor to data sources.
+type tracingDataSourceInterceptor struct {
+	tracingInterceptor
+}
+
+func (r tracingDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, response.State, meta, when, "read", diags)
+}
+
+func (r tracingInterceptor) run(ctx context.Context, state tfsdk.State, meta *conns.AWSClient, when when, operation string, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || meta.Tracer == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx = context.WithValue(ctx, tracingSpanStartKey, time.Now())
+	case Finally:
+		start, ok := ctx.Value(tracingSpanStartKey).(time.Time)
+		if !ok {
+			return ctx, diags
+		}
+
+		var id fwtypes.String
+		// Not all resources have an "id" attribute.
+		state.GetAttribute(ctx, path.Root(names.AttrID), &id)
+
+		span := conns.Span{
+			Name:  fmt.Sprintf("%s %s", r.typeName, operation),
+			Start: start,
+			End:   time.Now(),
+			Attributes: map[string]string{
+				"tf_aws.id":            id.ValueString(),
+				"tf_aws.operation":     operation,
+				"tf_aws.resource_type": r.typeName,
+			},
+		}
+		if inContext, ok := conns.FromContext(ctx); ok {
+			span.Attributes["tf_aws.resource_name"] = inContext.ResourceName
+			span.Attributes["tf_aws.service_package"] = inContext.ServicePackageName
+		}
+		if v := diags.Errors(); len(v) > 0 {
+			span.Error = v[0].Summary()
+		}
+
+		// Tracing must never cause an operation to fail.
+		if err := meta.Tracer.Export(ctx, span); err != nil {
+			tflog.Warn(ctx, "exporting OpenTelemetry span", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return ctx, diags
+}
+
+// resourceNameFromContext returns the friendly service and resource name, e.g. "SNS Topic", held in Context.
+func resourceNameFromContext(ctx context.Context) string {
+	serviceName, resourceName := "<service>", "<thing>"
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		if v, err := names.HumanFriendly(inContext.ServicePackageName); err == nil {
+			serviceName = v
+		}
+
+		if v := inContext.ResourceName; v != "" {
+			resourceName = v
+		}
+	}
+
+	return serviceName + " " + resourceName
+}
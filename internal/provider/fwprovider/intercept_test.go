@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type testImportResource struct {
+	importErr bool
+}
+
+func (r testImportResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+}
+
+func (r testImportResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+}
+
+func (r testImportResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+}
+
+func (r testImportResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+}
+
+func (r testImportResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+}
+
+func (r testImportResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
+
+func (r testImportResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+}
+
+func (r testImportResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if r.importErr {
+		response.Diagnostics.AddError("importing", "import error")
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+// testImportInterceptor records the points at which it is run on import.
+type testImportInterceptor struct {
+	when *[]when
+}
+
+func (r testImportInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r testImportInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r testImportInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r testImportInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r testImportInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	*r.when = append(*r.when, when)
+	return ctx, diags
+}
+
+func TestWrappedResourceImportState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		importErr bool
+		wantErr   bool
+		wantWhen  []when
+	}{
+		{
+			name:     "success",
+			wantWhen: []when{Before, After, Finally},
+		},
+		{
+			name:      "import error",
+			importErr: true,
+			wantErr:   true,
+			wantWhen:  []when{Before, OnError, Finally},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var gotWhen []when
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				return ctx
+			}
+			r := newWrappedResource(bootstrapContext, "aws_test", testImportResource{importErr: testCase.importErr}, resourceInterceptors{testImportInterceptor{when: &gotWhen}})
+
+			s := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			}
+			request := resource.ImportStateRequest{ID: "test-id"}
+			response := resource.ImportStateResponse{
+				State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+
+			r.(resource.ResourceWithImportState).ImportState(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.wantErr; got != want {
+				t.Errorf("error = %v, want error %v", response.Diagnostics, want)
+			}
+			if !testCase.wantErr {
+				var id fwtypes.String
+				response.State.GetAttribute(ctx, path.Root("id"), &id)
+				if got, want := id.ValueString(), "test-id"; got != want {
+					t.Errorf("id = %q, want %q", got, want)
+				}
+			}
+			if got, want := len(gotWhen), len(testCase.wantWhen); got != want {
+				t.Fatalf("interceptor run %v times, want %v", got, want)
+			}
+			for i, want := range testCase.wantWhen {
+				if got := gotWhen[i]; got != want {
+					t.Errorf("interceptor run %d when = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
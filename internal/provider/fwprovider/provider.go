@@ -56,6 +56,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"audit_log_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON line is appended for every resource create, update and delete. Used by compliance pipelines that cannot rely on AWS CloudTrail.",
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of consecutive resource operations on a service failing with AWS server (HTTP 5xx) or credential errors after which the provider fails the remaining operations on that service without calling AWS.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -84,6 +92,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"max_concurrent_api_calls": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Maximum number of concurrent API calls per service, keyed by service name, e.g. `route53`.",
+			},
+			"max_requests_per_second": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Maximum number of API requests per second per service, keyed by service name, e.g. `ec2`.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -92,10 +110,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"otel_traces_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: "OpenTelemetry collector OTLP/HTTP traces endpoint, e.g. `http://localhost:4318/v1/traces`. If set, a span is exported for each resource and data source operation.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Refuse to create, update or delete resources. Used to run plans for drift detection and auditing with read-only credentials.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -118,6 +144,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"security_checks": schema.StringAttribute{
+				Optional:    true,
+				Description: "Security posture checks to run against planned resource changes. Valid values are `warn` (emit warning diagnostics) and `enforce` (fail the plan).",
+			},
 			"shared_config_files": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -267,6 +297,52 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration blocks with default operation timeouts for resources that support timeouts.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for Create operations.",
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for Delete operations.",
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for Read operations.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type name patterns, e.g. `aws_db_*`, the timeouts apply to. If omitted, the timeouts apply to all resources.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default timeout for Update operations.",
+						},
+					},
+				},
+			},
+			"deletion_protection_tag": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to prevent deletion of resources carrying a tag.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "Tag key identifying resources that must not be deleted.",
+						},
+						"value": schema.StringAttribute{
+							Optional:    true,
+							Description: "Tag value identifying resources that must not be deleted. If omitted, any value matches.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -288,6 +364,30 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"throttling_retry": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to retry resource operations that fail because AWS throttled requests.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"base_delay": schema.StringAttribute{
+							Optional:    true,
+							Description: "Delay before the first retry. Subsequent delays double up to `max_delay`. Defaults to `1s`.",
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of times a resource operation is run, including the first attempt. Defaults to `5`.",
+						},
+						"max_delay": schema.StringAttribute{
+							Optional:    true,
+							Description: "Maximum delay between retries. Defaults to `30s`.",
+						},
+						"services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Service names, e.g. `route53`, the settings apply to. If omitted, the settings apply to all services.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -341,7 +441,10 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 				return ctx
 			}
-			interceptors := dataSourceInterceptors{}
+			interceptors := dataSourceInterceptors{
+				// All data source reads are traced if the provider's otel_traces_endpoint is set.
+				tracingDataSourceInterceptor{tracingInterceptor{typeName: typeName}},
+			}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
@@ -412,14 +515,39 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{
+				// All resource operations are traced if the provider's otel_traces_endpoint is set.
+				// Tracing is first so that spans cover all other interceptors.
+				tracingInterceptor{typeName: typeName},
+				// Resource operations on a service stop once the provider's circuit_breaker_threshold is reached.
+				circuitBreakerResourceInterceptor{},
+				// All resources are protected from changes if the provider is configured as read_only.
+				readOnlyResourceInterceptor{},
+				// All resource changes are recorded if the provider's audit_log_path is set.
+				auditLogResourceInterceptor{typeName: typeName},
+			}
+
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			// Resources can be managed in an AWS Region other than the provider's.
+			// Global services' resources aren't located in a Region.
+			// The region interceptor must run before any interceptor that makes AWS API calls.
+			if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok && !names.IsGlobalService(servicePackageName) {
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
+
+			// Resources can be managed using the credentials of an IAM role other than the provider's.
+			// The assume role interceptor runs after the region interceptor so that the role is assumed in the resource's region.
+			if _, ok := schemaResponse.Schema.Blocks[names.AttrAssumeRole]; !ok {
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrAssumeRole]; !ok {
+					interceptors = append(interceptors, assumeRoleResourceInterceptor{})
+				}
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -440,10 +568,18 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				}
 
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
+				interceptors = append(interceptors, deletionProtectionResourceInterceptor{})
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				// Each instance has its own inner resource so that it can be configured with the AWSClient for the resource's region or role.
+				// The factory has already succeeded once, so an error here isn't expected.
+				inner := inner
+				if v, err := v.Factory(ctx); err == nil {
+					inner = v
+				}
+
+				return newWrappedResource(bootstrapContext, typeName, inner, interceptors)
 			})
 		}
 	}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionResourceInterceptor makes a resource's AWS API calls in the AWS Region set by its `region` argument.
+type regionResourceInterceptor struct{}
+
+// schema adds the `region` argument to the resource's schema.
+func (r regionResourceInterceptor) schema(ctx context.Context, response *resource.SchemaResponse) {
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]schema.Attribute)
+	}
+
+	response.Schema.Attributes[names.AttrRegion] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`), "must be a valid AWS Region name"),
+		},
+	}
+}
+
+// modifyPlan plans the resource's region.
+// If `region` isn't configured a new resource is created in the provider's region.
+// Existing resources stay in the region recorded in state, so changing the provider's region doesn't replace them.
+// Only changing a configured `region` replaces the resource.
+func (r regionResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() || meta == nil {
+		return ctx, diags
+	}
+
+	var configRegion, stateRegion fwtypes.String
+	diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+	if !request.State.Raw.IsNull() {
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+	}
+
+	var region fwtypes.String
+	switch {
+	case configRegion.IsNull() && request.State.Raw.IsNull():
+		region = fwtypes.StringValue(meta.Region)
+	case configRegion.IsNull():
+		region = stateRegion
+	default:
+		region = configRegion
+		if !request.State.Raw.IsNull() && !configRegion.Equal(stateRegion) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	return r.before(ctx, meta, region), diags
+}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.beforeFrom(ctx, request.Plan.Raw.IsNull(), request.Plan.GetAttribute, meta, diags)
+	case After:
+		return r.after(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.beforeFrom(ctx, request.State.Raw.IsNull(), request.State.GetAttribute, meta, diags)
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		return r.after(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.beforeFrom(ctx, request.Plan.Raw.IsNull(), request.Plan.GetAttribute, meta, diags)
+	case After:
+		return r.after(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.beforeFrom(ctx, request.State.Raw.IsNull(), request.State.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// Importers only set the resource ID, so imported resources are in the provider's region.
+func (r regionResourceInterceptor) importState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		return r.after(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) beforeFrom(ctx context.Context, isNull bool, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if isNull {
+		return ctx, diags
+	}
+
+	var region fwtypes.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	return r.before(ctx, meta, region), diags
+}
+
+func (r regionResourceInterceptor) before(ctx context.Context, meta *conns.AWSClient, region fwtypes.String) context.Context {
+	if meta == nil {
+		return ctx
+	}
+
+	if v := region.ValueString(); v != "" && v != meta.Region {
+		ctx = conns.NewAWSClientContext(ctx, meta.ForRegion(ctx, v))
+	}
+
+	return ctx
+}
+
+func (r regionResourceInterceptor) after(ctx context.Context, state *tfsdk.State, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	// meta is the AWSClient for the resource's region.
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region)...)
+
+	return ctx, diags
+}
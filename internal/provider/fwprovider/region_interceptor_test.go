@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testRegionResource records the region of the AWSClient it is configured with when Create is called.
+type testRegionResource struct {
+	testImportResource
+	meta          *conns.AWSClient
+	createdRegion *string
+}
+
+func (r *testRegionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *testRegionResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+}
+
+func (r *testRegionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	*r.createdRegion = r.meta.Region
+	response.State.Raw = request.Plan.Raw
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), "test-id")...)
+}
+
+func testRegionSchema(ctx context.Context) schema.Schema {
+	response := resource.SchemaResponse{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				names.AttrID: schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+	regionResourceInterceptor{}.schema(ctx, &response)
+
+	return response.Schema
+}
+
+func testRegionValue(ctx context.Context, s schema.Schema, id, region any) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		names.AttrID:     tftypes.NewValue(tftypes.String, id),
+		names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+	})
+}
+
+func TestRegionResourceInterceptorModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		configRegion    any
+		stateRegion     any
+		create          bool
+		wantRegion      string
+		wantRequiresNew bool
+	}{
+		{
+			name:       "create in provider region",
+			create:     true,
+			wantRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			name:         "create in configured region",
+			configRegion: "eu-west-1", //lintignore:AWSAT003
+			create:       true,
+			wantRegion:   "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:        "provider region changed",
+			stateRegion: "us-east-1", //lintignore:AWSAT003
+			wantRegion:  "us-east-1", //lintignore:AWSAT003
+		},
+		{
+			name:            "configured region changed",
+			configRegion:    "eu-west-1", //lintignore:AWSAT003
+			stateRegion:     "us-east-1", //lintignore:AWSAT003
+			wantRegion:      "eu-west-1", //lintignore:AWSAT003
+			wantRequiresNew: true,
+		},
+		{
+			name:         "configured region unchanged",
+			configRegion: "eu-west-1", //lintignore:AWSAT003
+			stateRegion:  "eu-west-1", //lintignore:AWSAT003
+			wantRegion:   "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			meta := &conns.AWSClient{Region: "us-west-2"} //lintignore:AWSAT003
+			s := testRegionSchema(ctx)
+
+			var id any = "test-id"
+			state := tfsdk.State{Schema: s, Raw: testRegionValue(ctx, s, id, testCase.stateRegion)}
+			if testCase.create {
+				id = tftypes.UnknownValue
+				state.Raw = tftypes.NewValue(s.Type().TerraformType(ctx), nil)
+			}
+			request := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: testRegionValue(ctx, s, nil, testCase.configRegion)},
+				Plan:   tfsdk.Plan{Schema: s, Raw: testRegionValue(ctx, s, id, tftypes.UnknownValue)},
+				State:  state,
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			ctx, diags := regionResourceInterceptor{}.modifyPlan(ctx, request, &response, meta, diag.Diagnostics{})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			var region fwtypes.String
+			response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)
+			if got, want := region.ValueString(), testCase.wantRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+			if got, want := len(response.RequiresReplace) > 0, testCase.wantRequiresNew; got != want {
+				t.Errorf("requires replace = %t, want %t", got, want)
+			}
+
+			client := meta
+			if v, ok := conns.AWSClientFromContext(ctx); ok {
+				client = v
+			}
+			if got, want := client.Region, testCase.wantRegion; got != want {
+				t.Errorf("client region = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceCreateRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	meta := &conns.AWSClient{Region: "us-west-2"} //lintignore:AWSAT003
+	bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+		return ctx
+	}
+	var createdRegion string
+	r := newWrappedResource(bootstrapContext, "aws_test", &testRegionResource{createdRegion: &createdRegion}, resourceInterceptors{regionResourceInterceptor{}})
+
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &resource.ConfigureResponse{})
+
+	schemaResponse := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	s := schemaResponse.Schema
+
+	if _, ok := s.Attributes[names.AttrRegion]; !ok {
+		t.Fatalf("no %q attribute in schema", names.AttrRegion)
+	}
+
+	request := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: testRegionValue(ctx, s, nil, "eu-west-1")},                //lintignore:AWSAT003
+		Plan:   tfsdk.Plan{Schema: s, Raw: testRegionValue(ctx, s, tftypes.UnknownValue, "eu-west-1")}, //lintignore:AWSAT003
+	}
+	response := resource.CreateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+
+	r.Create(ctx, request, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+	if got, want := createdRegion, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("created in region %q, want %q", got, want)
+	}
+
+	var region fwtypes.String
+	response.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)
+	if got, want := region.ValueString(), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("region = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// throttlingRetryResetFunc prepares a response for another attempt at an operation.
+// It returns false if the operation must not be run again.
+type throttlingRetryResetFunc[Request, Response any] func(Request, *Response) bool
+
+// throttlingRetryHandler returns a handler that runs the specified CRUD handler again, with randomized exponential backoff,
+// while it fails because AWS throttled requests and the provider's throttling_retry configuration allows.
+// Retries happen inside the interceptor chain so that Before and After interceptors run once per operation.
+func throttlingRetryHandler[Request, Response any](f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient, reset throttlingRetryResetFunc[Request, Response]) func(context.Context, Request, *Response) diag.Diagnostics {
+	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
+		attempt := func() (diag.Diagnostics, bool) {
+			// The AWS SDK error types are lost once errors are converted to Diagnostics,
+			// so the final error of each AWS API call is recorded in the Context.
+			apiErrorCtx := conns.NewAPIErrorContext(ctx)
+			diags := f(apiErrorCtx, request, response)
+			err := conns.APIErrorFromContext(apiErrorCtx)
+			// Interceptors, e.g. the circuit breaker, see the error of the last attempt.
+			conns.RecordAPIError(ctx, err)
+
+			return diags, diags.HasError() && conns.IsThrottlingError(err)
+		}
+
+		diags, throttled := attempt()
+
+		if meta == nil {
+			return diags
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return diags
+		}
+
+		config := meta.ThrottlingRetryConfig.For(inContext.ServicePackageName)
+		if config == nil {
+			return diags
+		}
+
+		for retry := 1; retry < config.MaxAttempts && throttled; retry++ {
+			if !reset(request, response) {
+				break
+			}
+
+			delay := config.Delay(retry)
+			tflog.Info(ctx, "Retrying throttled operation", map[string]any{
+				"attempt": retry + 1,
+				"delay":   delay.String(),
+			})
+
+			select {
+			case <-ctx.Done():
+				return diags
+			case <-time.After(delay):
+			}
+
+			diags, throttled = attempt()
+		}
+
+		return diags
+	}
+}
+
+func resetDataSourceReadResponse(request datasource.ReadRequest, response *datasource.ReadResponse) bool {
+	response.Diagnostics = nil
+	response.State = tfsdk.State{Schema: request.Config.Schema, Raw: request.Config.Raw.Copy()}
+
+	return true
+}
+
+func resetResourceCreateResponse(request resource.CreateRequest, response *resource.CreateResponse) bool {
+	// Never create a resource again once it has been (even partially) created.
+	if !response.State.Raw.IsNull() {
+		return false
+	}
+
+	response.Diagnostics = nil
+
+	return true
+}
+
+func resetResourceReadResponse(request resource.ReadRequest, response *resource.ReadResponse) bool {
+	response.Diagnostics = nil
+	response.State = tfsdk.State{Schema: request.State.Schema, Raw: request.State.Raw.Copy()}
+
+	return true
+}
+
+func resetResourceUpdateResponse(request resource.UpdateRequest, response *resource.UpdateResponse) bool {
+	response.Diagnostics = nil
+	response.State = tfsdk.State{Schema: request.State.Schema, Raw: request.State.Raw.Copy()}
+
+	return true
+}
+
+func resetResourceDeleteResponse(request resource.DeleteRequest, response *resource.DeleteResponse) bool {
+	response.Diagnostics = nil
+	response.State = tfsdk.State{Schema: request.State.Schema, Raw: request.State.Raw.Copy()}
+
+	return true
+}
+
+// newResetResourceImportStateResponse returns a function that restores the response's initial, empty, state.
+func newResetResourceImportStateResponse(response *resource.ImportStateResponse) throttlingRetryResetFunc[resource.ImportStateRequest, resource.ImportStateResponse] {
+	state := tfsdk.State{Schema: response.State.Schema, Raw: response.State.Raw.Copy()}
+
+	return func(request resource.ImportStateRequest, response *resource.ImportStateResponse) bool {
+		response.Diagnostics = nil
+		response.State = tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}
+
+		return true
+	}
+}
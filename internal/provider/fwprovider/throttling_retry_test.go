@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestThrottlingRetryHandler(t *testing.T) {
+	t.Parallel()
+
+	throttlingError := &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+
+	testCases := []struct {
+		name         string
+		config       conns.ThrottlingRetryConfig
+		created      bool
+		errors       []error
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			name:         "not configured",
+			errors:       []error{throttlingError},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "success",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			wantAttempts: 1,
+		},
+		{
+			name:         "not throttled",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			errors:       []error{&smithy.GenericAPIError{Code: "ValidationException", Message: "Throttling: Rate exceeded"}},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "throttling message without API error",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			errors:       []error{errors.New("Throttling: Rate exceeded")},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "throttled then success",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			errors:       []error{throttlingError, throttlingError},
+			wantAttempts: 3,
+		},
+		{
+			name:         "attempts exhausted",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 2}},
+			errors:       []error{throttlingError, throttlingError, throttlingError},
+			wantAttempts: 2,
+			wantErr:      true,
+		},
+		{
+			name:         "created",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			created:      true,
+			errors:       []error{throttlingError},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			for i := range testCase.config {
+				testCase.config[i].BaseDelay = 1 * time.Millisecond
+				testCase.config[i].MaxDelay = 1 * time.Millisecond
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "route53", "Record")
+			meta := &conns.AWSClient{
+				ThrottlingRetryConfig: testCase.config,
+			}
+			request := resource.CreateRequest{}
+			response := resource.CreateResponse{
+				State: tfsdk.State{Raw: tftypes.NewValue(objectType, nil)},
+			}
+
+			var attempts int
+			f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				attempts++
+				if testCase.created {
+					response.State.Raw = tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "Z1234"),
+					})
+				}
+
+				if attempts <= len(testCase.errors) {
+					err := testCase.errors[attempts-1]
+					conns.RecordAPIError(ctx, err)
+					diags.AddError("creating Route 53 Record", err.Error())
+				}
+
+				return diags
+			}
+
+			diags := throttlingRetryHandler(f, meta, resetResourceCreateResponse)(ctx, request, &response)
+
+			if got, want := attempts, testCase.wantAttempts; got != want {
+				t.Errorf("attempts = %v, want %v", got, want)
+			}
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("diags.HasError() = %v, want %v", got, want)
+			}
+		})
+	}
+}
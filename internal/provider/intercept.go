@@ -5,16 +5,16 @@ package provider
 
 import (
 	"context"
+	"slices"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -23,16 +23,7 @@ import (
 )
 
 // schemaResourceData is an interface that implements functions from schema.ResourceData
-type schemaResourceData interface {
-	Get(key string) any
-	GetChange(key string) (any, any)
-	GetRawConfig() cty.Value
-	GetRawPlan() cty.Value
-	GetRawState() cty.Value
-	HasChange(key string) bool
-	Id() string
-	Set(string, any) error
-}
+type schemaResourceData = types.SDKResourceData
 
 // An interceptor is functionality invoked during the CRUD request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
@@ -57,81 +48,116 @@ type interceptorItem struct {
 
 // when represents the point in the CRUD request lifecycle that an interceptor is run.
 // Multiple values can be ORed together.
-type when uint16
+type when = types.InterceptorWhen
 
 const (
-	Before  when = 1 << iota // Interceptor is invoked before call to method in schema
-	After                    // Interceptor is invoked after successful call to method in schema
-	OnError                  // Interceptor is invoked after unsuccessful call to method in schema
-	Finally                  // Interceptor is invoked after After or OnError
+	Before  = types.InterceptorBefore  // Interceptor is invoked before call to method in schema
+	After   = types.InterceptorAfter   // Interceptor is invoked after successful call to method in schema
+	OnError = types.InterceptorOnError // Interceptor is invoked after unsuccessful call to method in schema
+	Finally = types.InterceptorFinally // Interceptor is invoked after After or OnError
 )
 
 // why represents the CRUD operation(s) that an interceptor is run.
 // Multiple values can be ORed together.
-type why uint16
+type why = types.InterceptorWhy
 
 const (
-	Create why = 1 << iota // Interceptor is invoked for a Create call
-	Read                   // Interceptor is invoked for a Read call
-	Update                 // Interceptor is invoked for an Update call
-	Delete                 // Interceptor is invoked for a Delete call
-
-	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
+	Create = types.InterceptorCreate // Interceptor is invoked for a Create call
+	Read   = types.InterceptorRead   // Interceptor is invoked for a Read call
+	Update = types.InterceptorUpdate // Interceptor is invoked for an Update call
+	Delete = types.InterceptorDelete // Interceptor is invoked for a Delete call
+	Import = types.InterceptorImport // Interceptor is invoked for an Import call
+	Diff   = types.InterceptorDiff   // Interceptor is invoked for a CustomizeDiff call
+
+	AllOps = types.InterceptorAllOps // Interceptor is invoked for all CRUD calls
 )
 
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
 func (s interceptorItems) why(why why) interceptorItems {
-	return slices.Filter(s, func(e interceptorItem) bool {
+	return tfslices.Filter(s, func(e interceptorItem) bool {
 		return e.why&why != 0
 	})
 }
 
+// servicePackageInterceptors returns the interceptors contributed by a service package that are run for the specified resource type.
+func servicePackageInterceptors(spInterceptors []*types.ServicePackageSDKInterceptor, typeName string) interceptorItems {
+	var interceptors interceptorItems
+
+	for _, v := range spInterceptors {
+		if len(v.TypeNames) > 0 && !slices.Contains(v.TypeNames, typeName) {
+			continue
+		}
+
+		interceptors = append(interceptors, interceptorItem{
+			when:        v.When,
+			why:         v.Why,
+			interceptor: interceptorFunc(v.Interceptor),
+		})
+	}
+
+	return interceptors
+}
+
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
 func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
-		// Before interceptors are run first to last.
-		forward := interceptors.why(why)
 
-		when := Before
-		for _, v := range forward {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+		return runInterceptors(ctx, interceptors, d, meta, why, func(ctx context.Context, meta any) diag.Diagnostics {
+			return throttlingRetryHandler(f, why)(ctx, d, meta)
+		})
+	}
+}
 
-				// Short circuit if any Before interceptor errors.
-				if diags.HasError() {
-					return diags
-				}
+// runInterceptors invokes the specified handler, running any interceptors for the specified operation.
+// If a Before interceptor places an AWSClient in Context, e.g. for a different AWS Region, that client is passed to
+// the handler and all subsequent interceptors instead of meta.
+func runInterceptors(ctx context.Context, interceptors interceptorItems, d schemaResourceData, meta any, why why, f func(context.Context, any) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// Before interceptors are run first to last.
+	forward := interceptors.why(why)
+
+	when := Before
+	for _, v := range forward {
+		if v.when&when != 0 {
+			ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+
+			// Short circuit if any Before interceptor errors.
+			if diags.HasError() {
+				return diags
 			}
-		}
 
-		// All other interceptors are run last to first.
-		reverse := slices.Reverse(forward)
-		diags = f(ctx, d, meta)
-
-		if diags.HasError() {
-			when = OnError
-		} else {
-			when = After
-		}
-		for _, v := range reverse {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+			if c, ok := conns.AWSClientFromContext(ctx); ok {
+				meta = c
 			}
 		}
+	}
 
-		when = Finally
-		for _, v := range reverse {
-			if v.when&when != 0 {
-				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
-			}
+	// All other interceptors are run last to first.
+	reverse := tfslices.Reverse(forward)
+	diags = f(ctx, meta)
+
+	if diags.HasError() {
+		when = OnError
+	} else {
+		when = After
+	}
+	for _, v := range reverse {
+		if v.when&when != 0 {
+			ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 		}
+	}
 
-		return diags
+	when = Finally
+	for _, v := range reverse {
+		if v.when&when != 0 {
+			ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+		}
 	}
+
+	return diags
 }
 
 // contextFunc augments Context.
@@ -173,20 +199,66 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx = r.bootstrapContext(ctx, meta)
+		var results []*schema.ResourceData
+
+		// Adapt the importer to a CRUD handler so that interceptors run on import.
+		var importer schema.ReadContextFunc = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			var diags diag.Diagnostics
+			var err error
 
-		return f(ctx, d, meta)
+			results, err = f(ctx, d, meta)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			return diags
+		}
+
+		diags := interceptedHandler(r.bootstrapContext, r.interceptors, importer, Import)(ctx, d, meta)
+
+		if diags.HasError() {
+			return nil, sdkdiag.DiagnosticsError(diags)
+		}
+
+		return results, nil
 	}
 }
 
+// CustomizeDiff returns a CustomizeDiff handler that runs any plan-time interceptors.
+// f may be nil if the resource has no CustomizeDiff handler of its own.
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
-		return f(ctx, d, meta)
+		diags := runInterceptors(ctx, r.interceptors, resourceDiff{d}, meta, Diff, func(ctx context.Context, meta any) diag.Diagnostics {
+			var diags diag.Diagnostics
+
+			if f == nil {
+				return diags
+			}
+
+			if err := f(ctx, d, meta); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			return diags
+		})
+
+		return sdkdiag.DiagnosticsError(diags)
 	}
 }
 
+// resourceDiff adapts schema.ResourceDiff to schemaResourceData so that interceptors can run at plan time.
+type resourceDiff struct {
+	*schema.ResourceDiff
+}
+
+// Set sets the planned value of a computed attribute.
+func (d resourceDiff) Set(key string, value any) error {
+	return d.SetNew(key, value)
+}
+
 func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
 		ctx = r.bootstrapContext(ctx, meta)
@@ -297,9 +369,16 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 			}
 		}
 	case After:
-		// Set tags and tags_all in state after CRU.
+		// Set tags and tags_all in state after CRU and import.
 		// C & U handlers are assumed to tail call the R handler.
 		switch why {
+		case Import:
+			// Most importers only set the resource ID. Tags can only be read here if the identifier attribute is known.
+			if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute == "" || (identifierAttribute != names.AttrID && d.Get(identifierAttribute).(string) == "") {
+				return ctx, diags
+			}
+
+			fallthrough
 		case Read:
 			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
 			if d.Id() == "" {
@@ -5,11 +5,15 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 func TestInterceptorsWhy(t *testing.T) {
@@ -93,3 +97,248 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestWrappedResourceState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		importErr   error
+		interceptor interceptorFunc
+		wantErr     bool
+		wantWhen    []when
+	}{
+		{
+			name:     "success",
+			wantWhen: []when{Before, After, Finally},
+		},
+		{
+			name:      "import error",
+			importErr: errors.New("import error"),
+			wantErr:   true,
+			wantWhen:  []when{Before, OnError, Finally},
+		},
+		{
+			name: "interceptor error",
+			interceptor: func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+				if when == After {
+					return ctx, sdkdiag.AppendErrorf(diags, "listing tags")
+				}
+				return ctx, diags
+			},
+			wantErr:  true,
+			wantWhen: []when{Before, After, Finally},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotWhen []when
+			interceptors := interceptorItems{
+				{
+					when: Before | After | OnError | Finally,
+					why:  Import,
+					interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+						gotWhen = append(gotWhen, when)
+						return ctx, diags
+					}),
+				},
+				{
+					when: Before | After | Finally,
+					why:  AllOps,
+					interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+						t.Errorf("CRUD interceptor run on import")
+						return ctx, diags
+					}),
+				},
+			}
+			if testCase.interceptor != nil {
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Import,
+					interceptor: testCase.interceptor,
+				})
+			}
+
+			r := &wrappedResource{
+				bootstrapContext: func(ctx context.Context, meta any) context.Context {
+					return ctx
+				},
+				interceptors: interceptors,
+			}
+			importer := func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				if testCase.importErr != nil {
+					return nil, testCase.importErr
+				}
+				return []*schema.ResourceData{d}, nil
+			}
+
+			d := (&schema.Resource{}).TestResourceData()
+			results, err := r.State(importer)(context.Background(), d, 42)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("err = %v, want error %v", err, want)
+			}
+			if !testCase.wantErr && len(results) != 1 {
+				t.Errorf("length of results = %v, want 1", len(results))
+			}
+			if got, want := len(gotWhen), len(testCase.wantWhen); got != want {
+				t.Fatalf("interceptor run %v times, want %v", got, want)
+			}
+			for i, want := range testCase.wantWhen {
+				if got := gotWhen[i]; got != want {
+					t.Errorf("interceptor run %d when = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWrappedResourceCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		customizeDiff schema.CustomizeDiffFunc
+		config        map[string]any
+		wantErr       bool
+		wantComputed  string
+	}{
+		{
+			name:         "no CustomizeDiff",
+			config:       map[string]any{"name": "test"},
+			wantComputed: "test-computed",
+		},
+		{
+			name: "CustomizeDiff",
+			customizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+				return d.SetNew("computed", "customized")
+			},
+			config:       map[string]any{"name": "test"},
+			wantComputed: "customized",
+		},
+		{
+			name: "CustomizeDiff error",
+			customizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+				return errors.New("customize error")
+			},
+			config:  map[string]any{"name": "test"},
+			wantErr: true,
+		},
+		{
+			name:    "Before interceptor error",
+			config:  map[string]any{"name": "forbidden"},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptors := interceptorItems{
+				{
+					when: Before | After,
+					why:  Diff,
+					interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+						switch when {
+						case Before:
+							if d.Get("name").(string) == "forbidden" {
+								return ctx, sdkdiag.AppendErrorf(diags, "name is forbidden")
+							}
+						case After:
+							if d.Get("computed").(string) == "" {
+								if err := d.Set("computed", d.Get("name").(string)+"-computed"); err != nil {
+									return ctx, sdkdiag.AppendFromErr(diags, err)
+								}
+							}
+						}
+						return ctx, diags
+					}),
+				},
+			}
+
+			rs := &wrappedResource{
+				bootstrapContext: func(ctx context.Context, meta any) context.Context {
+					return ctx
+				},
+				interceptors: interceptors,
+			}
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"computed": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+				CustomizeDiff: rs.CustomizeDiff(testCase.customizeDiff),
+			}
+
+			diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(testCase.config), 42)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %v", err, want)
+			}
+			if testCase.wantErr {
+				return
+			}
+			if got, want := diff.Attributes["computed"].New, testCase.wantComputed; got != want {
+				t.Errorf("computed = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestServicePackageInterceptors(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	newInterceptor := func(name string) types.SDKInterceptorFunc {
+		return func(ctx context.Context, d types.SDKResourceData, meta any, when types.InterceptorWhen, why types.InterceptorWhy, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			calls = append(calls, name)
+			return ctx, diags
+		}
+	}
+	spInterceptors := []*types.ServicePackageSDKInterceptor{
+		{
+			Interceptor: newInterceptor("all"),
+			When:        types.InterceptorBefore,
+			Why:         types.InterceptorAllOps,
+		},
+		{
+			Interceptor: newInterceptor("instance"),
+			TypeNames:   []string{"aws_db_instance"},
+			When:        types.InterceptorBefore,
+			Why:         types.InterceptorUpdate,
+		},
+	}
+
+	if got, want := len(servicePackageInterceptors(spInterceptors, "aws_db_cluster")), 1; got != want {
+		t.Errorf("length of interceptors for aws_db_cluster = %v, want %v", got, want)
+	}
+
+	interceptors := servicePackageInterceptors(spInterceptors, "aws_db_instance")
+	if got, want := len(interceptors), 2; got != want {
+		t.Fatalf("length of interceptors for aws_db_instance = %v, want %v", got, want)
+	}
+
+	var update schema.UpdateContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return nil
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	interceptedHandler(bootstrapContext, interceptors, update, Update)(context.Background(), nil, 42)
+	if got, want := calls, []string{"all", "instance"}; !slices.Equal(got, want) {
+		t.Errorf("interceptors called = %v, want %v", got, want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -51,6 +53,19 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON line is appended for every resource create, update and delete. " +
+					"Used by compliance pipelines that cannot rely on AWS CloudTrail.",
+			},
+			"circuit_breaker_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description: "Number of consecutive resource operations on a service failing with AWS server (HTTP 5xx) or credential errors " +
+					"after which the provider fails the remaining operations on that service without calling AWS.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -74,6 +89,65 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with default operation timeouts for resources that support timeouts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Default timeout for Create operations.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Default timeout for Delete operations.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"read": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Default timeout for Read operations.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validResourceTypePattern},
+							Description: "Resource type name patterns, e.g. `aws_db_*`, the timeouts apply to. If omitted, the timeouts apply to all resources.",
+						},
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Default timeout for Update operations.",
+							ValidateFunc: verify.ValidDuration,
+						},
+					},
+				},
+			},
+			"deletion_protection_tag": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to prevent deletion of resources carrying a tag.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Tag key identifying resources that must not be deleted.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Tag value identifying resources that must not be deleted. If omitted, any value matches.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -133,6 +207,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"max_concurrent_api_calls": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Maximum number of concurrent API calls per service, keyed by service name, e.g. `route53`.",
+			},
+			"max_requests_per_second": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Maximum number of API requests per second per service, keyed by service name, e.g. `ec2`.",
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -146,12 +232,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"otel_traces_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "OpenTelemetry collector OTLP/HTTP traces endpoint, e.g. `http://localhost:4318/v1/traces`. " +
+					"If set, a span is exported for each resource and data source operation.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Refuse to create, update or delete resources. " +
+					"Used to run plans for drift detection and auditing with read-only credentials.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +283,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"security_checks": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Security posture checks to run against planned resource changes. Valid values are `warn` (emit warning diagnostics) and `enforce` (fail the plan).",
+				ValidateFunc: validation.StringInSlice(securityChecksMode_Values(), false),
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -228,6 +332,39 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"throttling_retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with settings to retry resource operations that fail because AWS throttled requests.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_delay": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Delay before the first retry. Subsequent delays double up to `max_delay`. Defaults to `1s`.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Maximum number of times a resource operation is run, including the first attempt. Defaults to `5`.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_delay": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Maximum delay between retries. Defaults to `30s`.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"services": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Service names, e.g. `route53`, the settings apply to. If omitted, the settings apply to all services.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -258,8 +395,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
+	// Resources' declared default timeouts, before any provider configured default_timeouts are applied.
+	declaredTimeouts := make(map[string]schema.ResourceTimeout)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+		meta, diags := configure(ctx, provider, d)
+
+		if meta != nil {
+			applyDefaultTimeouts(provider.ResourcesMap, declaredTimeouts, meta.DefaultTimeoutsConfig)
+		}
+
+		return meta, diags
 	}
 
 	var errs []error
@@ -296,7 +442,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				// All data source reads are traced if the provider's otel_traces_endpoint is set.
+				{
+					when: Before | Finally,
+					why:  Read,
+					interceptor: tracingInterceptor{
+						typeName: typeName,
+					},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -334,9 +489,19 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			provider.DataSourcesMap[typeName] = r
 		}
 
+		// Service packages can contribute interceptors to the Plugin SDK resources that they implement.
+		var spInterceptors []*types.ServicePackageSDKInterceptor
+		if v, ok := sp.(interface {
+			SDKInterceptors(context.Context) []*types.ServicePackageSDKInterceptor
+		}); ok {
+			spInterceptors = v.SDKInterceptors(ctx)
+		}
+		spTypeNames := make(map[string]bool)
+
 		for _, v := range sp.SDKResources(ctx) {
 			v := v
 			typeName := v.TypeName
+			spTypeNames[typeName] = true
 
 			if _, ok := provider.ResourcesMap[typeName]; ok {
 				errs = append(errs, fmt.Errorf("duplicate resource: %s", typeName))
@@ -373,7 +538,78 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				// All resource operations are traced if the provider's otel_traces_endpoint is set.
+				// Tracing is first so that spans cover all other interceptors.
+				{
+					when: Before | Finally,
+					why:  AllOps | Import,
+					interceptor: tracingInterceptor{
+						typeName: typeName,
+					},
+				},
+				// Resource operations on a service stop once the provider's circuit_breaker_threshold is reached.
+				{
+					when:        Before | After | OnError,
+					why:         AllOps,
+					interceptor: circuitBreakerResourceInterceptor{},
+				},
+				// All resources are protected from changes if the provider is configured as read_only.
+				{
+					when:        Before,
+					why:         Create | Update | Delete,
+					interceptor: readOnlyResourceInterceptor{},
+				},
+				// All resource changes are recorded if the provider's audit_log_path is set.
+				{
+					when: Before | Finally,
+					why:  Create | Update | Delete,
+					interceptor: auditLogResourceInterceptor{
+						typeName: typeName,
+					},
+				},
+			}
+
+			// Resources can be managed in an AWS Region other than the provider's.
+			// Global services' resources aren't located in a Region.
+			// The region interceptor must run before any interceptor that makes AWS API calls.
+			if _, ok := r.SchemaMap()[names.AttrRegion]; !ok && !names.IsGlobalService(servicePackageName) {
+				if f := r.SchemaFunc; f != nil {
+					r.SchemaFunc = func() map[string]*schema.Schema {
+						s := f()
+						s[names.AttrRegion] = regionSchema()
+						return s
+					}
+				} else {
+					r.Schema[names.AttrRegion] = regionSchema()
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps | Import | Diff,
+					interceptor: regionResourceInterceptor{},
+				})
+			}
+
+			// Resources can be managed using the credentials of an IAM role other than the provider's.
+			// The assume role interceptor runs after the region interceptor so that the role is assumed in the resource's region.
+			if _, ok := r.SchemaMap()[names.AttrAssumeRole]; !ok {
+				if f := r.SchemaFunc; f != nil {
+					r.SchemaFunc = func() map[string]*schema.Schema {
+						s := f()
+						s[names.AttrAssumeRole] = assumeRoleSchema()
+						return s
+					}
+				} else {
+					r.Schema[names.AttrAssumeRole] = assumeRoleSchema()
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before,
+					why:         AllOps | Diff,
+					interceptor: assumeRoleResourceInterceptor{},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -401,7 +637,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				interceptors = append(interceptors, interceptorItem{
 					when: Before | After | Finally,
-					why:  Create | Read | Update,
+					why:  Create | Read | Update | Import,
 					interceptor: tagsResourceInterceptor{
 						tags:       v.Tags,
 						updateFunc: tagsUpdateFunc,
@@ -410,6 +646,36 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Resources with tags can be protected from deletion by the provider's deletion_protection_tag.
+			if schema := r.SchemaMap(); schema[names.AttrTagsAll] != nil || schema[names.AttrTags] != nil {
+				tagsAttribute := names.AttrTagsAll
+				if schema[tagsAttribute] == nil {
+					tagsAttribute = names.AttrTags
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when: Before,
+					why:  Delete,
+					interceptor: deletionProtectionResourceInterceptor{
+						tagsAttribute: tagsAttribute,
+					},
+				})
+			}
+
+			// Resources in eventually consistent services can wait to be found after creation.
+			if v := v.Found; v != nil {
+				interceptors = append(interceptors, interceptorItem{
+					when: After,
+					why:  Create,
+					interceptor: foundResourceInterceptor{
+						found: v,
+					},
+				})
+			}
+
+			// Service package interceptors are run closest to the resource's own methods.
+			interceptors = append(interceptors, servicePackageInterceptors(spInterceptors, typeName)...)
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -427,12 +693,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			if v := r.DeleteWithoutTimeout; v != nil {
 				r.DeleteWithoutTimeout = rs.Delete(v)
 			}
+			if v.Found != nil && r.CreateWithoutTimeout != nil && r.ReadWithoutTimeout != nil {
+				r.CreateWithoutTimeout = readAfterCreate(r.CreateWithoutTimeout, r.ReadWithoutTimeout)
+			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
-			if v := r.CustomizeDiff; v != nil {
+			if v := r.CustomizeDiff; v != nil || len(interceptors.why(Diff)) > 0 {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
 			for _, stateUpgrader := range r.StateUpgraders {
@@ -441,8 +710,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				}
 			}
 
+			if v := r.Timeouts; v != nil {
+				declaredTimeouts[typeName] = *v
+			}
+
 			provider.ResourcesMap[typeName] = r
 		}
+
+		for _, v := range spInterceptors {
+			for _, typeName := range v.TypeNames {
+				if !spTypeNames[typeName] {
+					errs = append(errs, fmt.Errorf("interceptor for unknown resource: %s", typeName))
+				}
+			}
+		}
 	}
 
 	if err := errors.Join(errs...); err != nil {
@@ -477,16 +758,21 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogPath:                   d.Get("audit_log_path").(string),
+		CircuitBreakerThreshold:        d.Get("circuit_breaker_threshold").(int),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		OTELTracesEndpoint:             d.Get("otel_traces_endpoint").(string),
 		Profile:                        d.Get("profile").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
+		SecurityChecks:                 d.Get("security_checks").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
@@ -536,6 +822,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 {
+		config.DefaultTimeoutsConfig = expandDefaultTimeouts(ctx, v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("deletion_protection_tag"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DeletionProtectionTagConfig = expandDeletionProtectionTag(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -567,6 +861,24 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("max_concurrent_api_calls"); ok && len(v.(map[string]interface{})) > 0 {
+		maxConcurrentAPICalls, dx := expandMaxConcurrentAPICalls(ctx, v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.MaxConcurrentAPICalls = maxConcurrentAPICalls
+	}
+
+	if v, ok := d.GetOk("max_requests_per_second"); ok && len(v.(map[string]interface{})) > 0 {
+		maxRequestsPerSecond, dx := expandMaxRequestsPerSecond(ctx, v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.MaxRequestsPerSecond = maxRequestsPerSecond
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -587,6 +899,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 	}
 
+	if v, ok := d.GetOk("throttling_retry"); ok && len(v.([]interface{})) > 0 {
+		throttlingRetryConfig, dx := expandThrottlingRetry(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ThrottlingRetryConfig = throttlingRetryConfig
+	}
+
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
 		meta = v
@@ -852,6 +1173,186 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandDefaultTimeouts(_ context.Context, tfList []interface{}) conns.DefaultTimeoutsConfig {
+	var defaultTimeoutsConfig conns.DefaultTimeoutsConfig
+
+	duration := func(tfMap map[string]interface{}, key string) *time.Duration {
+		if v, ok := tfMap[key].(string); ok && v != "" {
+			if duration, err := time.ParseDuration(v); err == nil {
+				return &duration
+			}
+		}
+
+		return nil
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		defaultTimeouts := conns.DefaultTimeouts{
+			Create: duration(tfMap, "create"),
+			Delete: duration(tfMap, "delete"),
+			Read:   duration(tfMap, "read"),
+			Update: duration(tfMap, "update"),
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			defaultTimeouts.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		defaultTimeoutsConfig = append(defaultTimeoutsConfig, defaultTimeouts)
+	}
+
+	return defaultTimeoutsConfig
+}
+
+func expandMaxConcurrentAPICalls(_ context.Context, tfMap map[string]interface{}) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	maxConcurrentAPICallsPath := cty.GetAttrPath("max_concurrent_api_calls")
+	servicePackageNames := names.ProviderPackages()
+	maxConcurrentAPICalls := make(map[string]int)
+
+	for k, v := range tfMap {
+		if !slices.Contains(servicePackageNames, k) {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				maxConcurrentAPICallsPath.IndexString(k),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unknown service %q.", k),
+			))
+			continue
+		}
+
+		if v := v.(int); v < 1 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				maxConcurrentAPICallsPath.IndexString(k),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Maximum API calls for %q must be at least 1, got %d.", k, v),
+			))
+			continue
+		}
+
+		maxConcurrentAPICalls[k] = v.(int)
+	}
+
+	return maxConcurrentAPICalls, diags
+}
+
+func expandMaxRequestsPerSecond(_ context.Context, tfMap map[string]interface{}) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	maxRequestsPerSecondPath := cty.GetAttrPath("max_requests_per_second")
+	servicePackageNames := names.ProviderPackages()
+	maxRequestsPerSecond := make(map[string]int)
+
+	for k, v := range tfMap {
+		if !slices.Contains(servicePackageNames, k) {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				maxRequestsPerSecondPath.IndexString(k),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unknown service %q.", k),
+			))
+			continue
+		}
+
+		if v := v.(int); v < 1 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				maxRequestsPerSecondPath.IndexString(k),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Maximum requests per second for %q must be at least 1, got %d.", k, v),
+			))
+			continue
+		}
+
+		maxRequestsPerSecond[k] = v.(int)
+	}
+
+	return maxRequestsPerSecond, diags
+}
+
+func expandThrottlingRetry(_ context.Context, tfList []interface{}) (conns.ThrottlingRetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var throttlingRetryConfig conns.ThrottlingRetryConfig
+
+	servicePackageNames := names.ProviderPackages()
+
+	duration := func(tfMap map[string]interface{}, key string, defaultValue time.Duration) time.Duration {
+		if v, ok := tfMap[key].(string); ok && v != "" {
+			if duration, err := time.ParseDuration(v); err == nil {
+				return duration
+			}
+		}
+
+		return defaultValue
+	}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		throttlingRetry := conns.ThrottlingRetry{
+			BaseDelay:   duration(tfMap, "base_delay", conns.DefaultThrottlingRetryBaseDelay),
+			MaxAttempts: conns.DefaultThrottlingRetryMaxAttempts,
+			MaxDelay:    duration(tfMap, "max_delay", conns.DefaultThrottlingRetryMaxDelay),
+		}
+
+		if v, ok := tfMap["max_attempts"].(int); ok && v > 0 {
+			throttlingRetry.MaxAttempts = v
+		}
+
+		if throttlingRetry.BaseDelay > throttlingRetry.MaxDelay {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				cty.GetAttrPath("throttling_retry").IndexInt(i).GetAttr("base_delay"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Base delay (%s) must not be greater than maximum delay (%s).", throttlingRetry.BaseDelay, throttlingRetry.MaxDelay),
+			))
+			continue
+		}
+
+		if v, ok := tfMap["services"].(*schema.Set); ok && v.Len() > 0 {
+			for _, v := range flex.ExpandStringValueSet(v) {
+				if !slices.Contains(servicePackageNames, v) {
+					diags = append(diags, errs.NewAttributeErrorDiagnostic(
+						cty.GetAttrPath("throttling_retry").IndexInt(i).GetAttr("services"),
+						"Invalid Attribute Value",
+						fmt.Sprintf("Unknown service %q.", v),
+					))
+					continue
+				}
+
+				throttlingRetry.Services = append(throttlingRetry.Services, v)
+			}
+		}
+
+		throttlingRetryConfig = append(throttlingRetryConfig, throttlingRetry)
+	}
+
+	return throttlingRetryConfig, diags
+}
+
+func expandDeletionProtectionTag(_ context.Context, tfMap map[string]interface{}) *tftags.DeletionProtectionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	deletionProtectionConfig := &tftags.DeletionProtectionConfig{}
+
+	if v, ok := tfMap["key"].(string); ok {
+		deletionProtectionConfig.Key = v
+	}
+
+	if v, ok := tfMap["value"].(string); ok {
+		deletionProtectionConfig.Value = v
+	}
+
+	return deletionProtectionConfig
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
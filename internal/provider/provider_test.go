@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -59,6 +60,92 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandMaxConcurrentAPICalls(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, diags := expandMaxConcurrentAPICalls(ctx, map[string]interface{}{
+		"route53": 5,
+		"ssm":     10,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(results, map[string]int{"route53": 5, "ssm": 10}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	expectedDiags := diag.Diagnostics{
+		errs.NewAttributeErrorDiagnostic(
+			cty.GetAttrPath("max_concurrent_api_calls").IndexString("route53"),
+			"Invalid Attribute Value",
+			`Maximum API calls for "route53" must be at least 1, got 0.`,
+		),
+		errs.NewAttributeErrorDiagnostic(
+			cty.GetAttrPath("max_concurrent_api_calls").IndexString("unknown"),
+			"Invalid Attribute Value",
+			`Unknown service "unknown".`,
+		),
+	}
+
+	_, diags = expandMaxConcurrentAPICalls(ctx, map[string]interface{}{
+		"route53": 0,
+	})
+	_, dx := expandMaxConcurrentAPICalls(ctx, map[string]interface{}{
+		"unknown": 1,
+	})
+	diags = append(diags, dx...)
+
+	if diff := cmp.Diff(diags, expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
+func TestExpandMaxRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, diags := expandMaxRequestsPerSecond(ctx, map[string]interface{}{
+		"ec2": 20,
+		"ssm": 10,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(results, map[string]int{"ec2": 20, "ssm": 10}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	expectedDiags := diag.Diagnostics{
+		errs.NewAttributeErrorDiagnostic(
+			cty.GetAttrPath("max_requests_per_second").IndexString("route53"),
+			"Invalid Attribute Value",
+			`Maximum requests per second for "route53" must be at least 1, got 0.`,
+		),
+		errs.NewAttributeErrorDiagnostic(
+			cty.GetAttrPath("max_requests_per_second").IndexString("unknown"),
+			"Invalid Attribute Value",
+			`Unknown service "unknown".`,
+		),
+	}
+
+	_, diags = expandMaxRequestsPerSecond(ctx, map[string]interface{}{
+		"route53": 0,
+	})
+	_, dx := expandMaxRequestsPerSecond(ctx, map[string]interface{}{
+		"unknown": 1,
+	})
+	diags = append(diags, dx...)
+
+	if diff := cmp.Diff(diags, expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
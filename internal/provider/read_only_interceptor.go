@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// readOnlyResourceInterceptor refuses to create, update or delete resources if the provider is configured as read_only.
+type readOnlyResourceInterceptor struct{}
+
+func (r readOnlyResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || !c.ReadOnly {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Create:
+			return ctx, sdkdiag.AppendErrorf(diags, "creating %s: provider is configured with read_only", resourceNameFromContext(ctx))
+		case Update:
+			return ctx, sdkdiag.AppendErrorf(diags, "updating %s (%s): provider is configured with read_only", resourceNameFromContext(ctx), d.Id())
+		case Delete:
+			return ctx, sdkdiag.AppendErrorf(diags, "deleting %s (%s): provider is configured with read_only", resourceNameFromContext(ctx), d.Id())
+		}
+	}
+
+	return ctx, diags
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestReadOnlyResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	readOnly := readOnlyResourceInterceptor{}
+
+	testCases := []struct {
+		name     string
+		readOnly bool
+		why      why
+		wantErr  bool
+	}{
+		{
+			name: "not read-only create",
+			why:  Create,
+		},
+		{
+			name: "not read-only delete",
+			why:  Delete,
+		},
+		{
+			name:     "read-only create",
+			readOnly: true,
+			why:      Create,
+			wantErr:  true,
+		},
+		{
+			name:     "read-only read",
+			readOnly: true,
+			why:      Read,
+		},
+		{
+			name:     "read-only update",
+			readOnly: true,
+			why:      Update,
+			wantErr:  true,
+		},
+		{
+			name:     "read-only delete",
+			readOnly: true,
+			why:      Delete,
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Thing")
+			meta := &conns.AWSClient{
+				ReadOnly: testCase.readOnly,
+			}
+			d := &resourceData{}
+
+			var diags diag.Diagnostics
+			_, diags = readOnly.run(ctx, d, meta, Before, testCase.why, diags)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("diags.HasError() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionSchema returns the schema of the `region` argument added to resources that don't declare their own.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+// regionResourceInterceptor makes a resource's AWS API calls in the AWS Region set by its `region` argument.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Diff:
+			// If `region` isn't configured a new resource is created in the provider's region.
+			// Existing resources stay in the region recorded in state, so changing the provider's region doesn't replace them.
+			// Only changing a configured `region` replaces the resource.
+			if d.Id() != "" {
+				return ctx, diags
+			}
+			if v := d.GetRawConfig(); v.IsKnown() && !v.IsNull() && v.GetAttr(names.AttrRegion).IsNull() {
+				if err := d.Set(names.AttrRegion, c.Region); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		default:
+			// Importers only set the resource ID, so imported resources are in the provider's region.
+			if region := d.Get(names.AttrRegion).(string); region != "" && region != c.Region {
+				ctx = conns.NewAWSClientContext(ctx, c.ForRegion(ctx, region))
+			}
+		}
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update, Import:
+			// meta is the AWSClient for the resource's region.
+			if err := d.Set(names.AttrRegion, c.Region); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
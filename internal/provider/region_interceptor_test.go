@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testRegionResource(read schema.ReadContextFunc) *schema.Resource {
+	rs := &wrappedResource{
+		bootstrapContext: func(ctx context.Context, meta any) context.Context {
+			return ctx
+		},
+		interceptors: interceptorItems{
+			{
+				when:        Before | After,
+				why:         AllOps | Import | Diff,
+				interceptor: regionResourceInterceptor{},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: rs.Read(read),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region": regionSchema(),
+		},
+		CustomizeDiff: rs.CustomizeDiff(nil),
+	}
+}
+
+func TestRegionResourceInterceptorRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		region     string
+		wantRegion string
+	}{
+		{
+			name:       "provider region",
+			wantRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			name:       "same region",
+			region:     "us-west-2", //lintignore:AWSAT003
+			wantRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			name:       "other region",
+			region:     "eu-west-1", //lintignore:AWSAT003
+			wantRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotRegion string
+			r := testRegionResource(func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				gotRegion = meta.(*conns.AWSClient).Region
+				return nil
+			})
+
+			d := r.TestResourceData()
+			d.SetId("test")
+			d.Set("region", testCase.region)
+			meta := &conns.AWSClient{
+				Region: "us-west-2", //lintignore:AWSAT003
+			}
+
+			diags := r.ReadWithoutTimeout(context.Background(), d, meta)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := gotRegion, testCase.wantRegion; got != want {
+				t.Errorf("Read handler region = %q, want %q", got, want)
+			}
+			if got, want := d.Get("region").(string), testCase.wantRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRegionResourceInterceptorDiff(t *testing.T) {
+	t.Parallel()
+
+	meta := &conns.AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := []struct {
+		name            string
+		state           map[string]string
+		configRegion    string
+		wantRegion      string
+		wantRequiresNew bool
+	}{
+		{
+			name:       "create in provider region",
+			wantRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			name:         "create in other region",
+			configRegion: "eu-west-1", //lintignore:AWSAT003
+			wantRegion:   "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:  "provider region changed",
+			state: map[string]string{"id": "test", "name": "test", "region": "us-east-1"}, //lintignore:AWSAT003
+		},
+		{
+			name:            "configured region changed",
+			state:           map[string]string{"id": "test", "name": "test", "region": "us-east-1"}, //lintignore:AWSAT003
+			configRegion:    "eu-west-1",                                                            //lintignore:AWSAT003
+			wantRegion:      "eu-west-1",                                                            //lintignore:AWSAT003
+			wantRequiresNew: true,
+		},
+		{
+			name:         "configured region unchanged",
+			state:        map[string]string{"id": "test", "name": "test", "region": "eu-west-1"}, //lintignore:AWSAT003
+			configRegion: "eu-west-1",                                                            //lintignore:AWSAT003
+		},
+		{
+			name:  "state without region",
+			state: map[string]string{"id": "test", "name": "test"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testRegionResource(func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				return nil
+			})
+
+			region := cty.NullVal(cty.String)
+			if testCase.configRegion != "" {
+				region = cty.StringVal(testCase.configRegion)
+			}
+			config := cty.ObjectVal(map[string]cty.Value{
+				"id":     cty.NullVal(cty.String),
+				"name":   cty.StringVal("test"),
+				"region": region,
+			})
+			// Terraform sends the SDK the configuration with the prior state.
+			state := &terraform.InstanceState{
+				ID:         testCase.state["id"],
+				Attributes: testCase.state,
+				RawConfig:  config,
+			}
+
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(config, r.CoreConfigSchema()), meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.wantRegion == "" {
+				if diff != nil && diff.Attributes["region"] != nil {
+					t.Errorf("unexpected region diff: %#v", diff.Attributes["region"])
+				}
+				return
+			}
+
+			if diff == nil || diff.Attributes["region"] == nil {
+				t.Fatalf("no region diff")
+			}
+			v := diff.Attributes["region"]
+			if got, want := v.New, testCase.wantRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+			if got, want := testCase.state != nil && diff.RequiresNew(), testCase.wantRequiresNew; got != want {
+				t.Errorf("RequiresNew = %v, want %v", got, want)
+			}
+		})
+	}
+}
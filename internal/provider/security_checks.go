@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	securityChecksModeWarn    = "warn"
+	securityChecksModeEnforce = "enforce"
+)
+
+func securityChecksMode_Values() []string {
+	return []string{
+		securityChecksModeWarn,
+		securityChecksModeEnforce,
+	}
+}
+
+// securityRuleData is the subset of schema.ResourceData and schema.ResourceDiff functions used by security rules.
+type securityRuleData interface {
+	Get(key string) any
+	GetRawConfig() cty.Value
+}
+
+// A securityRule is a lightweight security posture check evaluated against a resource's planned values.
+type securityRule struct {
+	// id uniquely identifies the rule, e.g. "AWS001".
+	id string
+	// description describes the finding reported when the rule fails.
+	description string
+	// fails returns whether the resource's planned values fail the rule.
+	fails func(securityRuleData, *conns.AWSClient) bool
+}
+
+// securityRules is the registry of security rules, keyed by resource type name.
+var securityRules = make(map[string][]securityRule)
+
+// registerSecurityRule registers a security rule for the specified resource types.
+func registerSecurityRule(rule securityRule, typeNames ...string) {
+	for _, typeName := range typeNames {
+		securityRules[typeName] = append(securityRules[typeName], rule)
+	}
+}
+
+// securityRuleFindings returns the rules that the resource's planned values fail.
+func securityRuleFindings(d securityRuleData, c *conns.AWSClient, rules []securityRule) []securityRule {
+	var findings []securityRule
+
+	for _, rule := range rules {
+		if rule.fails(d, c) {
+			findings = append(findings, rule)
+		}
+	}
+
+	return findings
+}
+
+// securityChecksProviderServer wraps the Plugin SDK provider server and evaluates registered security rules
+// against planned resource changes if the provider's security_checks mode is set.
+// Plugin SDK CustomizeDiff functions cannot return warnings, so the rules are evaluated on the protocol
+// PlanResourceChange response, where findings are reported as warning ("warn") or error ("enforce") diagnostics.
+type securityChecksProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func newSecurityChecksProviderServer(provider *schema.Provider) tfprotov5.ProviderServer {
+	return securityChecksProviderServer{
+		ProviderServer: provider.GRPCProvider(),
+		provider:       provider,
+	}
+}
+
+func (s securityChecksProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || response.PlannedState == nil {
+		return response, err
+	}
+
+	rules := securityRules[request.TypeName]
+	if len(rules) == 0 {
+		return response, nil
+	}
+
+	c, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok {
+		return response, nil
+	}
+
+	var severity tfprotov5.DiagnosticSeverity
+	switch c.SecurityChecks(ctx) {
+	case securityChecksModeWarn:
+		severity = tfprotov5.DiagnosticSeverityWarning
+	case securityChecksModeEnforce:
+		severity = tfprotov5.DiagnosticSeverityError
+	default:
+		return response, nil
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	d, err := newPlannedResourceData(r, request.Config, response.PlannedState)
+
+	if err != nil {
+		tflog.Warn(ctx, "skipping security checks", map[string]any{
+			"error":         err.Error(),
+			"resource_type": request.TypeName,
+		})
+		return response, nil
+	}
+
+	// Nothing to check if the resource is being destroyed.
+	if d == nil {
+		return response, nil
+	}
+
+	for _, rule := range securityRuleFindings(d, c, rules) {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("%s: security check %s", request.TypeName, rule.id),
+			Detail:   rule.description,
+		})
+	}
+
+	return response, nil
+}
+
+// plannedResourceData implements securityRuleData for a resource's planned values and configuration.
+type plannedResourceData struct {
+	*schema.ResourceData
+	config cty.Value
+}
+
+func (d plannedResourceData) GetRawConfig() cty.Value {
+	return d.config
+}
+
+// newPlannedResourceData returns the security rule data for the specified resource configuration and planned state.
+// A nil value is returned if the planned state is null.
+func newPlannedResourceData(r *schema.Resource, config, plannedState *tfprotov5.DynamicValue) (securityRuleData, error) {
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	planned, err := msgpack.Unmarshal(plannedState.MsgPack, ty)
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding planned state: %w", err)
+	}
+
+	if planned.IsNull() {
+		return nil, nil
+	}
+
+	raw := cty.NullVal(ty)
+	if config != nil {
+		raw, err = msgpack.Unmarshal(config.MsgPack, ty)
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding configuration: %w", err)
+		}
+	}
+
+	state, err := r.ShimInstanceStateFromValue(planned)
+
+	if err != nil {
+		return nil, fmt.Errorf("converting planned state: %w", err)
+	}
+
+	return plannedResourceData{
+		ResourceData: r.Data(state),
+		config:       raw,
+	}, nil
+}
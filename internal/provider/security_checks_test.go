@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNewPlannedResourceData(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	dynamicValue := func(t *testing.T, v cty.Value) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		b, err := msgpack.Marshal(v, ty)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"encrypted": cty.False,
+		"id":        cty.NullVal(cty.String),
+		"size":      cty.NullVal(cty.Number),
+	})
+	planned := cty.ObjectVal(map[string]cty.Value{
+		"encrypted": cty.False,
+		"id":        cty.UnknownVal(cty.String),
+		"size":      cty.NumberIntVal(8),
+	})
+
+	d, err := newPlannedResourceData(r, dynamicValue(t, config), dynamicValue(t, planned))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := d.Get("size"), 8; got != want {
+		t.Errorf("size = %v, want %v", got, want)
+	}
+
+	if got, want := configBoolIsFalse("encrypted")(d, nil), true; got != want {
+		t.Errorf("configBoolIsFalse() = %t, want %t", got, want)
+	}
+
+	d, err = newPlannedResourceData(r, dynamicValue(t, config), dynamicValue(t, cty.NullVal(ty)))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if d != nil {
+		t.Errorf("expected no data for a null planned state")
+	}
+}
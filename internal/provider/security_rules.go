@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func init() {
+	registerSecurityRule(securityRule{
+		id:          "AWS001",
+		description: "S3 bucket ACL grants public access",
+		fails:       s3BucketACLIsPublic,
+	}, "aws_s3_bucket_acl")
+
+	registerSecurityRule(securityRule{
+		id:          "AWS002",
+		description: "security group allows SSH (port 22) ingress from anywhere (0.0.0.0/0 or ::/0)",
+		fails:       securityGroupAllowsPublicSSH,
+	}, "aws_security_group")
+
+	registerSecurityRule(securityRule{
+		id:          "AWS002",
+		description: "security group rule allows SSH (port 22) ingress from anywhere (0.0.0.0/0 or ::/0)",
+		fails:       securityGroupRuleAllowsPublicSSH,
+	}, "aws_security_group_rule")
+
+	registerSecurityRule(securityRule{
+		id:          "AWS003",
+		description: "EBS volume is not encrypted",
+		fails:       configBoolIsFalse("encrypted"),
+	}, "aws_ebs_volume")
+
+	registerSecurityRule(securityRule{
+		id:          "AWS004",
+		description: "RDS storage is not encrypted",
+		fails:       configBoolIsNotTrue("storage_encrypted"),
+	}, "aws_db_instance", "aws_rds_cluster")
+
+	registerSecurityRule(securityRule{
+		id:          "AWS005",
+		description: "IAM role trust policy allows any AWS principal without conditions or trusts another account without an sts:ExternalId condition",
+		fails:       iamRoleTrustPolicyIsBroad,
+	}, "aws_iam_role")
+}
+
+// configBoolIsFalse returns a rule function that fails if the specified top-level attribute is configured as false.
+func configBoolIsFalse(attr string) func(securityRuleData, *conns.AWSClient) bool {
+	return func(d securityRuleData, _ *conns.AWSClient) bool {
+		v, ok := configAttr(d, attr)
+		return ok && v.IsKnown() && !v.IsNull() && v.False()
+	}
+}
+
+// configBoolIsNotTrue returns a rule function that fails if the specified top-level attribute is not configured as true.
+func configBoolIsNotTrue(attr string) func(securityRuleData, *conns.AWSClient) bool {
+	return func(d securityRuleData, _ *conns.AWSClient) bool {
+		v, ok := configAttr(d, attr)
+		return ok && v.IsKnown() && (v.IsNull() || v.False())
+	}
+}
+
+// configAttr returns the value of the specified top-level boolean attribute in configuration.
+func configAttr(d securityRuleData, attr string) (cty.Value, bool) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(attr) {
+		return cty.NilVal, false
+	}
+
+	v := config.GetAttr(attr)
+	if !v.Type().Equals(cty.Bool) {
+		return cty.NilVal, false
+	}
+
+	return v, true
+}
+
+func s3BucketACLIsPublic(d securityRuleData, _ *conns.AWSClient) bool {
+	switch d.Get("acl").(string) {
+	case "public-read", "public-read-write":
+		return true
+	}
+
+	tfList, ok := d.Get("access_control_policy.0.grant").(*schema.Set)
+	if !ok {
+		return false
+	}
+
+	for _, tfMapRaw := range tfList.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		grantees, ok := tfMap["grantee"].([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, granteeRaw := range grantees {
+			grantee, ok := granteeRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			switch grantee["uri"] {
+			case "http://acs.amazonaws.com/groups/global/AllUsers", "http://acs.amazonaws.com/groups/global/AuthenticatedUsers":
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func securityGroupAllowsPublicSSH(d securityRuleData, _ *conns.AWSClient) bool {
+	tfList, ok := d.Get("ingress").(*schema.Set)
+	if !ok {
+		return false
+	}
+
+	for _, tfMapRaw := range tfList.List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok && ruleAllowsPublicSSH(tfMap) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func securityGroupRuleAllowsPublicSSH(d securityRuleData, _ *conns.AWSClient) bool {
+	if d.Get("type").(string) != "ingress" {
+		return false
+	}
+
+	return ruleAllowsPublicSSH(map[string]interface{}{
+		"cidr_blocks":      d.Get("cidr_blocks"),
+		"from_port":        d.Get("from_port"),
+		"ipv6_cidr_blocks": d.Get("ipv6_cidr_blocks"),
+		"protocol":         d.Get("protocol"),
+		"to_port":          d.Get("to_port"),
+	})
+}
+
+func iamRoleTrustPolicyIsBroad(d securityRuleData, c *conns.AWSClient) bool {
+	policy, ok := d.Get("assume_role_policy").(string)
+
+	return ok && tfiam.AssumeRolePolicyHasBroadPrincipals(policy, c.AccountID)
+}
+
+// ruleAllowsPublicSSH returns whether a security group ingress rule allows TCP port 22 from any address.
+func ruleAllowsPublicSSH(tfMap map[string]interface{}) bool {
+	const sshPort = 22
+
+	protocol, _ := tfMap["protocol"].(string)
+	fromPort, _ := tfMap["from_port"].(int)
+	toPort, _ := tfMap["to_port"].(int)
+
+	switch strings.ToLower(protocol) {
+	case "-1", "all":
+	case "tcp", "6":
+		if fromPort > sshPort || toPort < sshPort {
+			return false
+		}
+	default:
+		return false
+	}
+
+	for _, k := range []string{"cidr_blocks", "ipv6_cidr_blocks"} {
+		var cidrs []interface{}
+
+		switch v := tfMap[k].(type) {
+		case []interface{}:
+			cidrs = v
+		case *schema.Set:
+			cidrs = v.List()
+		}
+
+		for _, cidr := range cidrs {
+			switch cidr {
+			case "0.0.0.0/0", "::/0":
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type securityRuleTestData struct {
+	config cty.Value
+	values map[string]any
+}
+
+func (d securityRuleTestData) Get(key string) any {
+	return d.values[key]
+}
+
+func (d securityRuleTestData) GetRawConfig() cty.Value {
+	return d.config
+}
+
+func TestRuleAllowsPublicSSH(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rule     map[string]interface{}
+		expected bool
+	}{
+		"ssh from anywhere": {
+			rule:     map[string]interface{}{"protocol": "tcp", "from_port": 22, "to_port": 22, "cidr_blocks": []interface{}{"0.0.0.0/0"}},
+			expected: true,
+		},
+		"port range from anywhere IPv6": {
+			rule:     map[string]interface{}{"protocol": "6", "from_port": 0, "to_port": 1024, "ipv6_cidr_blocks": []interface{}{"::/0"}},
+			expected: true,
+		},
+		"all traffic from anywhere": {
+			rule:     map[string]interface{}{"protocol": "-1", "from_port": 0, "to_port": 0, "cidr_blocks": []interface{}{"0.0.0.0/0"}},
+			expected: true,
+		},
+		"ssh from private network": {
+			rule:     map[string]interface{}{"protocol": "tcp", "from_port": 22, "to_port": 22, "cidr_blocks": []interface{}{"10.0.0.0/8"}},
+			expected: false,
+		},
+		"https from anywhere": {
+			rule:     map[string]interface{}{"protocol": "tcp", "from_port": 443, "to_port": 443, "cidr_blocks": []interface{}{"0.0.0.0/0"}},
+			expected: false,
+		},
+		"udp from anywhere": {
+			rule:     map[string]interface{}{"protocol": "udp", "from_port": 22, "to_port": 22, "cidr_blocks": []interface{}{"0.0.0.0/0"}},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ruleAllowsPublicSSH(testCase.rule), testCase.expected; got != want {
+				t.Errorf("ruleAllowsPublicSSH() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigBoolRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         cty.Value
+		expectFalse   bool
+		expectNotTrue bool
+	}{
+		"true": {
+			value: cty.True,
+		},
+		"false": {
+			value:         cty.False,
+			expectFalse:   true,
+			expectNotTrue: true,
+		},
+		"null": {
+			value:         cty.NullVal(cty.Bool),
+			expectNotTrue: true,
+		},
+		"unknown": {
+			value: cty.UnknownVal(cty.Bool),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := securityRuleTestData{
+				config: cty.ObjectVal(map[string]cty.Value{
+					"encrypted": testCase.value,
+				}),
+			}
+
+			if got, want := configBoolIsFalse("encrypted")(d, nil), testCase.expectFalse; got != want {
+				t.Errorf("configBoolIsFalse() = %t, want %t", got, want)
+			}
+
+			if got, want := configBoolIsNotTrue("encrypted")(d, nil), testCase.expectNotTrue; got != want {
+				t.Errorf("configBoolIsNotTrue() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestSecurityRuleFindings(t *testing.T) {
+	t.Parallel()
+
+	d := securityRuleTestData{
+		values: map[string]any{
+			"acl": "public-read",
+		},
+	}
+
+	findings := securityRuleFindings(d, &conns.AWSClient{}, securityRules["aws_s3_bucket_acl"])
+
+	if got, want := len(findings), 1; got != want {
+		t.Fatalf("number of findings = %d, want %d", got, want)
+	}
+
+	if got, want := findings[0].id, "AWS001"; got != want {
+		t.Errorf("finding = %s, want %s", got, want)
+	}
+}
+
+func TestIAMRoleTrustPolicyIsBroad(t *testing.T) {
+	t.Parallel()
+
+	c := &conns.AWSClient{
+		AccountID: "123456789012",
+	}
+
+	testCases := map[string]struct {
+		policy   string
+		expected bool
+	}{
+		"same account": {
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+		},
+		"cross-account": {
+			policy:   `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		"wildcard principal": {
+			policy:   `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		"unknown": {
+			policy: "74D93920-ED26-11E3-AC10-0800200C9A66",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := securityRuleTestData{
+				values: map[string]any{
+					"assume_role_policy": testCase.policy,
+				},
+			}
+
+			if got, want := iamRoleTrustPolicyIsBroad(d, c), testCase.expected; got != want {
+				t.Errorf("iamRoleTrustPolicyIsBroad() = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -107,6 +107,55 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestTagsResourceInterceptorImport(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		identifierAttribute string
+		wantDiags           int
+	}{
+		{
+			name: "no identifier attribute",
+		},
+		{
+			name:                "identifier attribute",
+			identifierAttribute: "id",
+			wantDiags:           1, // mockService.ListTags returns an error.
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			tags := tagsResourceInterceptor{
+				tags: &types.ServicePackageResourceTags{
+					IdentifierAttribute: testCase.identifierAttribute,
+				},
+				updateFunc: tagsUpdateFunc,
+				readFunc:   tagsReadFunc,
+			}
+
+			conn := &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"Test": &mockService{},
+				},
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig, conn.IgnoreTagsConfig)
+			d := &resourceData{}
+
+			_, diags := tags.run(ctx, d, conn, After, Import, nil)
+			if got, want := len(diags), testCase.wantDiags; got != want {
+				t.Errorf("length of diags = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
@@ -148,3 +197,14 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+// valuesResourceData returns attribute values from a map.
+type valuesResourceData struct {
+	resourceData
+
+	values map[string]any
+}
+
+func (d *valuesResourceData) Get(key string) any {
+	return d.values[key]
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// throttlingRetryHandler returns a handler that runs the specified CRUD handler again, with randomized exponential backoff,
+// while it fails because AWS throttled requests and the provider's throttling_retry configuration allows.
+// Retries happen inside the interceptor chain so that Before and After interceptors run once per operation.
+func throttlingRetryHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](f F, why why) F {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		attempt := func() (diag.Diagnostics, bool) {
+			// The AWS SDK error types are lost once errors are converted to Diagnostics,
+			// so the final error of each AWS API call is recorded in the Context.
+			apiErrorCtx := conns.NewAPIErrorContext(ctx)
+			diags := f(apiErrorCtx, d, meta)
+			err := conns.APIErrorFromContext(apiErrorCtx)
+			// Interceptors, e.g. the circuit breaker, see the error of the last attempt.
+			conns.RecordAPIError(ctx, err)
+
+			return diags, diags.HasError() && conns.IsThrottlingError(err)
+		}
+
+		diags, throttled := attempt()
+
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			return diags
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return diags
+		}
+
+		config := c.ThrottlingRetryConfig.For(inContext.ServicePackageName)
+		if config == nil {
+			return diags
+		}
+
+		for retry := 1; retry < config.MaxAttempts && throttled; retry++ {
+			// Never create a resource again once it has been (even partially) created.
+			if why == Create && d.Id() != "" {
+				break
+			}
+
+			delay := config.Delay(retry)
+			tflog.Info(ctx, "Retrying throttled operation", map[string]any{
+				"attempt": retry + 1,
+				"delay":   delay.String(),
+			})
+
+			select {
+			case <-ctx.Done():
+				return diags
+			case <-time.After(delay):
+			}
+
+			diags, throttled = attempt()
+		}
+
+		return diags
+	}
+}
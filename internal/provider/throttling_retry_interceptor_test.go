@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestThrottlingRetryHandler(t *testing.T) {
+	t.Parallel()
+
+	throttlingError := &smithy.GenericAPIError{Code: "Throttling", Message: "Rate exceeded"}
+
+	testCases := []struct {
+		name         string
+		config       conns.ThrottlingRetryConfig
+		why          why
+		id           string
+		errors       []error
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			name:         "not configured",
+			why:          Read,
+			errors:       []error{throttlingError},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "other service",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3, Services: []string{"ssm"}}},
+			why:          Read,
+			errors:       []error{throttlingError},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "success",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			why:          Update,
+			wantAttempts: 1,
+		},
+		{
+			name:         "not throttled",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			why:          Update,
+			errors:       []error{&smithy.GenericAPIError{Code: "InvalidInput", Message: "Throttling: Rate exceeded"}},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "throttling message without API error",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			why:          Update,
+			errors:       []error{errors.New("Throttling: Rate exceeded")},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "throttled then success",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			why:          Delete,
+			errors:       []error{throttlingError, awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil)},
+			wantAttempts: 3,
+		},
+		{
+			name:         "attempts exhausted",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 2}},
+			why:          Read,
+			errors:       []error{throttlingError, throttlingError, throttlingError},
+			wantAttempts: 2,
+			wantErr:      true,
+		},
+		{
+			name:         "create not started",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3, Services: []string{"route53"}}},
+			why:          Create,
+			errors:       []error{throttlingError},
+			wantAttempts: 2,
+		},
+		{
+			name:         "create started",
+			config:       conns.ThrottlingRetryConfig{{MaxAttempts: 3}},
+			why:          Create,
+			id:           "Z1234",
+			errors:       []error{throttlingError},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			for i := range testCase.config {
+				testCase.config[i].BaseDelay = 1 * time.Millisecond
+				testCase.config[i].MaxDelay = 1 * time.Millisecond
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "route53", "Record")
+			meta := &conns.AWSClient{
+				ThrottlingRetryConfig: testCase.config,
+			}
+			d := (&schema.Resource{}).TestResourceData()
+
+			var attempts int
+			var f schema.UpdateContextFunc = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				var diags diag.Diagnostics
+
+				attempts++
+				d.SetId(testCase.id)
+
+				if attempts <= len(testCase.errors) {
+					err := testCase.errors[attempts-1]
+					conns.RecordAPIError(ctx, err)
+
+					return sdkdiag.AppendErrorf(diags, "updating Route 53 Record: %s", err)
+				}
+
+				return diags
+			}
+
+			diags := throttlingRetryHandler(f, testCase.why)(ctx, d, meta)
+
+			if got, want := attempts, testCase.wantAttempts; got != want {
+				t.Errorf("attempts = %v, want %v", got, want)
+			}
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("diags.HasError() = %v, want %v", got, want)
+			}
+		})
+	}
+}
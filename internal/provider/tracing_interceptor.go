@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type tracingSpanStartKeyType int
+
+var tracingSpanStartKey tracingSpanStartKeyType
+
+// tracingInterceptor exports an OpenTelemetry span for every resource or data source operation if the provider's otel_traces_endpoint is set.
+// It must be the first interceptor so that spans cover all other interceptors.
+type tracingInterceptor struct {
+	typeName string
+}
+
+func (r tracingInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.Tracer == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx = context.WithValue(ctx, tracingSpanStartKey, time.Now())
+	case Finally:
+		start, ok := ctx.Value(tracingSpanStartKey).(time.Time)
+		if !ok {
+			return ctx, diags
+		}
+
+		operation := tracingOperation(why)
+		span := conns.Span{
+			Name:  fmt.Sprintf("%s %s", r.typeName, operation),
+			Start: start,
+			End:   time.Now(),
+			Attributes: map[string]string{
+				"tf_aws.id":            d.Id(),
+				"tf_aws.operation":     operation,
+				"tf_aws.resource_type": r.typeName,
+			},
+		}
+		if inContext, ok := conns.FromContext(ctx); ok {
+			span.Attributes["tf_aws.resource_name"] = inContext.ResourceName
+			span.Attributes["tf_aws.service_package"] = inContext.ServicePackageName
+		}
+		if diags.HasError() {
+			span.Error = auditLogError(diags)
+		}
+
+		// Tracing must never cause an operation to fail.
+		if err := c.Tracer.Export(ctx, span); err != nil {
+			tflog.Warn(ctx, "exporting OpenTelemetry span", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return ctx, diags
+}
+
+// tracingOperation returns the span operation name for the specified handler.
+func tracingOperation(why why) string {
+	switch why {
+	case Create:
+		return "create"
+	case Read:
+		return "read"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	case Import:
+		return "import"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestTracingInterceptor(t *testing.T) {
+	t.Parallel()
+
+	type span struct {
+		Name       string `json:"name"`
+		Attributes []struct {
+			Key   string `json:"key"`
+			Value struct {
+				StringValue string `json:"stringValue"`
+			} `json:"value"`
+		} `json:"attributes"`
+		Status struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"status"`
+	}
+	type request struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []span `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+
+	testCases := []struct {
+		name        string
+		why         why
+		diags       diag.Diagnostics
+		wantName    string
+		wantStatus  int
+		wantMessage string
+	}{
+		{
+			name:       "create",
+			why:        Create,
+			wantName:   "aws_test_thing create",
+			wantStatus: 1,
+		},
+		{
+			name:        "delete error",
+			why:         Delete,
+			diags:       diag.Errorf("deleting Thing: AccessDenied"),
+			wantName:    "aws_test_thing delete",
+			wantStatus:  2,
+			wantMessage: "deleting Thing: AccessDenied",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []span
+			var lock sync.Mutex
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var request request
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				lock.Lock()
+				defer lock.Unlock()
+
+				for _, v := range request.ResourceSpans {
+					for _, v := range v.ScopeSpans {
+						got = append(got, v.Spans...)
+					}
+				}
+			}))
+			defer server.Close()
+
+			interceptor := tracingInterceptor{
+				typeName: "aws_test_thing",
+			}
+			ctx := conns.NewResourceContext(context.Background(), "test", "Thing")
+			meta := &conns.AWSClient{
+				Tracer: conns.NewTracer(server.URL),
+			}
+			d := &resourceData{}
+
+			ctx, diags := interceptor.run(ctx, d, meta, Before, testCase.why, nil)
+			if err := meta.Tracer.Flush(ctx); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			lock.Lock()
+			exported := len(got)
+			lock.Unlock()
+			if exported != 0 {
+				t.Fatalf("span exported before operation completed")
+			}
+
+			diags = append(diags, testCase.diags...)
+			_, diags = interceptor.run(ctx, d, meta, Finally, testCase.why, diags)
+
+			if err := meta.Tracer.Flush(ctx); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			lock.Lock()
+			defer lock.Unlock()
+
+			if got, want := diags.HasError(), testCase.diags.HasError(); got != want {
+				t.Errorf("diags.HasError() = %v, want %v", got, want)
+			}
+			if got, want := len(got), 1; got != want {
+				t.Fatalf("got %d spans, want %d", got, want)
+			}
+			if got, want := got[0].Name, testCase.wantName; got != want {
+				t.Errorf("span name = %q, want %q", got, want)
+			}
+			if got, want := got[0].Status.Code, testCase.wantStatus; got != want {
+				t.Errorf("span status code = %d, want %d", got, want)
+			}
+			if got, want := got[0].Status.Message, testCase.wantMessage; got != want {
+				t.Errorf("span status message = %q, want %q", got, want)
+			}
+
+			attributes := make(map[string]string)
+			for _, v := range got[0].Attributes {
+				attributes[v.Key] = v.Value.StringValue
+			}
+			for k, want := range map[string]string{
+				"tf_aws.id":              "id",
+				"tf_aws.resource_name":   "Thing",
+				"tf_aws.resource_type":   "aws_test_thing",
+				"tf_aws.service_package": "test",
+			} {
+				if got := attributes[k]; got != want {
+					t.Errorf("span attribute %s = %q, want %q", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestTracingInterceptorUnresponsiveEndpoint(t *testing.T) {
+	t.Parallel()
+
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	interceptor := tracingInterceptor{
+		typeName: "aws_test_thing",
+	}
+	ctx := conns.NewResourceContext(context.Background(), "test", "Thing")
+	meta := &conns.AWSClient{
+		Tracer: conns.NewTracer(server.URL),
+	}
+	d := &resourceData{}
+
+	for i := 0; i < 3; i++ {
+		start := time.Now()
+
+		ctx, diags := interceptor.run(ctx, d, meta, Before, Read, nil)
+		_, diags = interceptor.run(ctx, d, meta, Finally, Read, diags)
+
+		if elapsed := time.Since(start); elapsed >= time.Second {
+			t.Errorf("operation blocked for %s", elapsed)
+		}
+		if diags.HasError() {
+			t.Errorf("unexpected error: %v", diags)
+		}
+	}
+}
+
+func TestTracingInterceptorNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	interceptor := tracingInterceptor{
+		typeName: "aws_test_thing",
+	}
+	ctx := conns.NewResourceContext(context.Background(), "test", "Thing")
+	meta := &conns.AWSClient{}
+	d := &resourceData{}
+
+	ctx, diags := interceptor.run(ctx, d, meta, Before, Read, nil)
+	_, diags = interceptor.run(ctx, d, meta, Finally, Read, diags)
+
+	if diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+}
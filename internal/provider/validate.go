@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"path"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -37,3 +38,12 @@ var validAssumeRoleSourceIdentity = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
 )
+
+// validResourceTypePattern validates a string is a valid resource type name pattern.
+func validResourceTypePattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid resource type pattern: %w", k, err))
+	}
+
+	return
+}
@@ -73,3 +73,31 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidResourceTypePattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val     interface{}
+		wantErr bool
+	}{
+		{
+			val: "aws_db_instance",
+		},
+		{
+			val: "aws_db_*",
+		},
+		{
+			val:     "aws_db_[",
+			wantErr: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validResourceTypePattern(tc.val, "test_property")
+
+		if got := len(errs) > 0; got != tc.wantErr {
+			t.Fatalf("expected test case %d to produce errors %t, got %v", i, tc.wantErr, errs)
+		}
+	}
+}
@@ -87,7 +87,17 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		timeout = d.Timeout(schema.TimeoutUpdate)
 	}
 
-	if v := d.Get("enabled").(bool); v {
+	output, err := findRegionOptStatus(ctx, conn, accountID, region)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Account Region (%s): %s", id, err)
+	}
+
+	switch enabled, status := d.Get("enabled").(bool), output.RegionOptStatus; {
+	case enabled && (status == types.RegionOptStatusEnabled || status == types.RegionOptStatusEnabledByDefault), !enabled && status == types.RegionOptStatusDisabled:
+		// Regions that are enabled by default can't be enabled or disabled,
+		// and there's nothing to do for a region already in the requested state.
+	case enabled:
 		input := &account.EnableRegionInput{
 			RegionName: aws.String(region),
 		}
@@ -95,16 +105,16 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.AccountId = aws.String(accountID)
 		}
 
-		_, err := conn.EnableRegion(ctx, input)
+		_, err = conn.EnableRegion(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "enabling Account Region (%s): %s", id, err)
 		}
 
 		if _, err := waitRegionEnabled(ctx, conn, accountID, region, timeout); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) enable: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) enable: %s", id, err)
 		}
-	} else {
+	default:
 		input := &account.DisableRegionInput{
 			RegionName: aws.String(region),
 		}
@@ -112,14 +122,14 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.AccountId = aws.String(accountID)
 		}
 
-		_, err := conn.DisableRegion(ctx, input)
+		_, err = conn.DisableRegion(ctx, input)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "enabling Account Region (%s): %s", id, err)
+			return sdkdiag.AppendErrorf(diags, "disabling Account Region (%s): %s", id, err)
 		}
 
 		if _, err := waitRegionDisabled(ctx, conn, accountID, region, timeout); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) disable: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) disable: %s", id, err)
 		}
 	}
 
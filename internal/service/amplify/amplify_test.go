@@ -52,6 +52,9 @@ func TestAccAmplify_serial(t *testing.T) {
 			"disappears": testAccWebhook_disappears,
 			"update":     testAccWebhook_update,
 		},
+		"WebhooksDataSource": {
+			"basic": testAccWebhooksDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 5*time.Second)
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_amplify_domain_association", name="Domain Association")
@@ -47,6 +48,30 @@ func resourceDomainAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"certificate_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_verification_dns_record": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom_certificate_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.CertificateType](),
+						},
+					},
+				},
+			},
 			"certificate_verification_dns_record": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -111,6 +136,10 @@ func resourceDomainAssociationCreate(ctx context.Context, d *schema.ResourceData
 		SubDomainSettings:   expandSubDomainSettings(d.Get("sub_domain").(*schema.Set).List()),
 	}
 
+	if v, ok := d.GetOk("certificate_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CertificateSettings = expandCertificateSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.CreateDomainAssociation(ctx, input)
 
 	if err != nil {
@@ -155,6 +184,9 @@ func resourceDomainAssociationRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("app_id", appID)
 	d.Set("arn", domainAssociation.DomainAssociationArn)
+	if err := d.Set("certificate_settings", flattenCertificate(domainAssociation.Certificate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificate_settings: %s", err)
+	}
 	d.Set("certificate_verification_dns_record", domainAssociation.CertificateVerificationDNSRecord)
 	d.Set("domain_name", domainAssociation.DomainName)
 	d.Set("enable_auto_sub_domain", domainAssociation.EnableAutoSubDomain)
@@ -174,12 +206,18 @@ func resourceDomainAssociationUpdate(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChanges("enable_auto_sub_domain", "sub_domain") {
+	if d.HasChanges("certificate_settings", "enable_auto_sub_domain", "sub_domain") {
 		input := &amplify.UpdateDomainAssociationInput{
 			AppId:      aws.String(appID),
 			DomainName: aws.String(domainName),
 		}
 
+		if d.HasChange("certificate_settings") {
+			if v, ok := d.GetOk("certificate_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CertificateSettings = expandCertificateSettings(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("enable_auto_sub_domain") {
 			input.EnableAutoSubDomain = aws.Bool(d.Get("enable_auto_sub_domain").(bool))
 		}
@@ -277,7 +315,7 @@ func waitDomainAssociationCreated(ctx context.Context, conn *amplify.Client, app
 		timeout = 5 * time.Minute
 	)
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.DomainStatusCreating, types.DomainStatusInProgress, types.DomainStatusRequestingCertificate),
+		Pending: enum.Slice(types.DomainStatusCreating, types.DomainStatusInProgress, types.DomainStatusRequestingCertificate, types.DomainStatusImportingCustomCertificate),
 		Target:  enum.Slice(types.DomainStatusPendingVerification, types.DomainStatusPendingDeployment, types.DomainStatusAvailable),
 		Refresh: statusDomainAssociation(ctx, conn, appID, domainName),
 		Timeout: timeout,
@@ -301,7 +339,7 @@ func waitDomainAssociationVerified(ctx context.Context, conn *amplify.Client, ap
 		timeout = 15 * time.Minute
 	)
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.DomainStatusUpdating, types.DomainStatusInProgress, types.DomainStatusPendingVerification),
+		Pending: enum.Slice(types.DomainStatusUpdating, types.DomainStatusInProgress, types.DomainStatusPendingVerification, types.DomainStatusImportingCustomCertificate),
 		Target:  enum.Slice(types.DomainStatusPendingDeployment, types.DomainStatusAvailable),
 		Refresh: statusDomainAssociation(ctx, conn, appID, domainName),
 		Timeout: timeout,
@@ -339,6 +377,44 @@ func domainAssociationParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPID%[2]sDOMAINNAME", id, domainAssociationResourceIDSeparator)
 }
 
+func expandCertificateSettings(tfMap map[string]interface{}) *types.CertificateSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CertificateSettings{}
+
+	if v, ok := tfMap["custom_certificate_arn"].(string); ok && v != "" {
+		apiObject.CustomCertificateArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = types.CertificateType(v)
+	}
+
+	return apiObject
+}
+
+func flattenCertificate(apiObject *types.Certificate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type": string(apiObject.Type),
+	}
+
+	if v := apiObject.CertificateVerificationDNSRecord; v != nil {
+		tfMap["certificate_verification_dns_record"] = aws.ToString(v)
+	}
+
+	if v := apiObject.CustomCertificateArn; v != nil {
+		tfMap["custom_certificate_arn"] = aws.ToString(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandSubDomainSetting(tfMap map[string]interface{}) *types.SubDomainSetting {
 	if tfMap == nil {
 		return nil
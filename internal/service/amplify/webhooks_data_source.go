@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package amplify
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_amplify_webhooks", name="Webhooks")
+func dataSourceWebhooks() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceWebhooksRead,
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"webhooks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"branch_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWebhooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AmplifyClient(ctx)
+
+	appID := d.Get("app_id").(string)
+	webhooks, err := findWebhooksByAppID(ctx, conn, appID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amplify App (%s) Webhooks: %s", appID, err)
+	}
+
+	d.SetId(appID)
+	if err := d.Set("webhooks", flattenWebhooks(webhooks)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting webhooks: %s", err)
+	}
+
+	return diags
+}
+
+func findWebhooksByAppID(ctx context.Context, conn *amplify.Client, appID string) ([]types.Webhook, error) {
+	input := &amplify.ListWebhooksInput{
+		AppId: aws.String(appID),
+	}
+	var output []types.Webhook
+
+	for {
+		page, err := conn.ListWebhooks(ctx, input)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Webhooks...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func flattenWebhooks(apiObjects []types.Webhook) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":         aws.ToString(apiObject.WebhookArn),
+			"branch_name": aws.ToString(apiObject.BranchName),
+			"description": aws.ToString(apiObject.Description),
+			"id":          aws.ToString(apiObject.WebhookId),
+			"url":         aws.ToString(apiObject.WebhookUrl),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package amplify_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccWebhooksDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_amplify_webhooks.test"
+	resourceName := "aws_amplify_webhook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhooksDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "app_id", "aws_amplify_app.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhooks.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhooks.0.branch_name", resourceName, "branch_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhooks.0.description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhooks.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhooks.0.url", resourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhooksDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWebhookConfig_basic(rName), `
+data "aws_amplify_webhooks" "test" {
+  app_id = aws_amplify_webhook.test.app_id
+}
+`)
+}
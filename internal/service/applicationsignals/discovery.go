@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_applicationsignals_discovery", name="Discovery")
+func resourceDiscovery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDiscoveryCreate,
+		ReadWithoutTimeout:   resourceDiscoveryRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+
+func resourceDiscoveryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	// StartDiscovery is idempotent. It creates the service-linked role that Application Signals
+	// uses to discover services, which isn't removed when discovery is "deleted".
+	_, err := conn.StartDiscovery(ctx, &applicationsignals.StartDiscoveryInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Application Signals Discovery: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return append(diags, resourceDiscoveryRead(ctx, d, meta)...)
+}
+
+func resourceDiscoveryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// There is no API to describe discovery status.
+
+	return diags
+}
@@ -0,0 +1,971 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+func resourceServiceLevelObjective() *schema.Resource {
+	metricDataQuerySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"account_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidAccountID,
+					},
+					"expression": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 2048),
+					},
+					"id": {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: validation.All(
+							validation.StringLenBetween(1, 255),
+							validation.StringMatch(regexache.MustCompile(`^[a-z][0-9A-Za-z_]*$`), "must start with a lowercase letter and contain only letters, numbers and underscores"),
+						),
+					},
+					"label": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"metric_stat": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"metric": {
+									Type:     schema.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"dimension": {
+												Type:     schema.TypeSet,
+												Optional: true,
+												MaxItems: 30,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"name": {
+															Type:         schema.TypeString,
+															Required:     true,
+															ValidateFunc: validation.StringLenBetween(1, 255),
+														},
+														"value": {
+															Type:         schema.TypeString,
+															Required:     true,
+															ValidateFunc: validation.StringLenBetween(1, 1024),
+														},
+													},
+												},
+											},
+											"metric_name": {
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringLenBetween(1, 255),
+											},
+											"namespace": {
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringLenBetween(1, 255),
+											},
+										},
+									},
+								},
+								"period": {
+									Type:         schema.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+								"stat": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"unit": {
+									Type:             schema.TypeString,
+									Optional:         true,
+									ValidateDiagFunc: enum.Validate[awstypes.StandardUnit](),
+								},
+							},
+						},
+					},
+					"period": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"return_data": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	durationSchema := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"duration_unit": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DurationUnit](),
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceLevelObjectiveCreate,
+		ReadWithoutTimeout:   resourceServiceLevelObjectiveRead,
+		UpdateWithoutTimeout: resourceServiceLevelObjectiveUpdate,
+		DeleteWithoutTimeout: resourceServiceLevelObjectiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"burn_rate_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"look_back_window_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10080),
+						},
+					},
+				},
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"evaluation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"goal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attainment_goal": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+						"interval": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"calendar_interval": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: func() map[string]*schema.Schema {
+												s := durationSchema()
+												s["start_time"] = &schema.Schema{
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												}
+												return s
+											}(),
+										},
+										ExactlyOneOf: []string{"goal.0.interval.0.calendar_interval", "goal.0.interval.0.rolling_interval"},
+									},
+									"rolling_interval": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: durationSchema(),
+										},
+										ExactlyOneOf: []string{"goal.0.interval.0.calendar_interval", "goal.0.interval.0.rolling_interval"},
+									},
+								},
+							},
+						},
+						"warning_threshold": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+					},
+				},
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_.-]*$`), "must start with a letter or number and contain only letters, numbers, periods, hyphens and underscores"),
+				),
+			},
+			"request_based_sli_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ServiceLevelIndicatorComparisonOperator](),
+						},
+						"metric_threshold": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Computed: true,
+						},
+						"request_based_sli_metric_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.ServiceLevelIndicatorMetricType](),
+									},
+									"monitored_request_count_metric": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bad_count_metric": func() *schema.Schema {
+													s := metricDataQuerySchema()
+													s.ExactlyOneOf = []string{
+														"request_based_sli_config.0.request_based_sli_metric_config.0.monitored_request_count_metric.0.bad_count_metric",
+														"request_based_sli_config.0.request_based_sli_metric_config.0.monitored_request_count_metric.0.good_count_metric",
+													}
+													return s
+												}(),
+												"good_count_metric": func() *schema.Schema {
+													s := metricDataQuerySchema()
+													s.ExactlyOneOf = []string{
+														"request_based_sli_config.0.request_based_sli_metric_config.0.monitored_request_count_metric.0.bad_count_metric",
+														"request_based_sli_config.0.request_based_sli_metric_config.0.monitored_request_count_metric.0.good_count_metric",
+													}
+													return s
+												}(),
+											},
+										},
+									},
+									"operation_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"total_request_count_metric": metricDataQuerySchema(),
+								},
+							},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"request_based_sli_config", "sli_config"},
+			},
+			"sli_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ServiceLevelIndicatorComparisonOperator](),
+						},
+						"metric_threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"sli_metric_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_data_query": metricDataQuerySchema(),
+									"metric_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.ServiceLevelIndicatorMetricType](),
+									},
+									"operation_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"period_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 900),
+									},
+									"statistic": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 20),
+									},
+								},
+							},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"request_based_sli_config", "sli_config"},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceServiceLevelObjectiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &applicationsignals.CreateServiceLevelObjectiveInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("burn_rate_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.BurnRateConfigurations = expandBurnRateConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("goal"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Goal = expandGoal(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("request_based_sli_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RequestBasedSliConfig = expandRequestBasedServiceLevelIndicatorConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sli_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SliConfig = expandServiceLevelIndicatorConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateServiceLevelObjective(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Application Signals Service Level Objective (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceServiceLevelObjectiveRead(ctx, d, meta)...)
+}
+
+func resourceServiceLevelObjectiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	slo, err := findServiceLevelObjectiveByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Application Signals Service Level Objective (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", slo.Arn)
+	if err := d.Set("burn_rate_configuration", flattenBurnRateConfigurations(slo.BurnRateConfigurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting burn_rate_configuration: %s", err)
+	}
+	if slo.CreatedTime != nil {
+		d.Set("created_time", aws.ToTime(slo.CreatedTime).Format(time.RFC3339))
+	}
+	d.Set("description", slo.Description)
+	d.Set("evaluation_type", slo.EvaluationType)
+	if err := d.Set("goal", flattenGoal(slo.Goal)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting goal: %s", err)
+	}
+	if slo.LastUpdatedTime != nil {
+		d.Set("last_updated_time", aws.ToTime(slo.LastUpdatedTime).Format(time.RFC3339))
+	}
+	d.Set("name", slo.Name)
+	if err := d.Set("request_based_sli_config", flattenRequestBasedServiceLevelIndicator(slo.RequestBasedSli)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting request_based_sli_config: %s", err)
+	}
+	// The API doesn't return the period and statistic used to generate the SLI's metric data queries.
+	if err := d.Set("sli_config", flattenServiceLevelIndicator(slo.Sli, d.Get("sli_config").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sli_config: %s", err)
+	}
+
+	return diags
+}
+
+func resourceServiceLevelObjectiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &applicationsignals.UpdateServiceLevelObjectiveInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("burn_rate_configuration") {
+			input.BurnRateConfigurations = expandBurnRateConfigurations(d.Get("burn_rate_configuration").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("goal") {
+			if v, ok := d.GetOk("goal"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Goal = expandGoal(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("request_based_sli_config") {
+			if v, ok := d.GetOk("request_based_sli_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.RequestBasedSliConfig = expandRequestBasedServiceLevelIndicatorConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("sli_config") {
+			if v, ok := d.GetOk("sli_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SliConfig = expandServiceLevelIndicatorConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		_, err := conn.UpdateServiceLevelObjective(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Application Signals Service Level Objective (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceServiceLevelObjectiveRead(ctx, d, meta)...)
+}
+
+func resourceServiceLevelObjectiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Application Signals Service Level Objective: %s", d.Id())
+	_, err := conn.DeleteServiceLevelObjective(ctx, &applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	input := &applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLevelObjective(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Slo, nil
+}
+
+func expandBurnRateConfigurations(tfList []interface{}) []awstypes.BurnRateConfiguration {
+	apiObjects := make([]awstypes.BurnRateConfiguration, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.BurnRateConfiguration{
+			LookBackWindowMinutes: aws.Int32(int32(tfMap["look_back_window_minutes"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandGoal(tfMap map[string]interface{}) *awstypes.Goal {
+	apiObject := &awstypes.Goal{}
+
+	if v, ok := tfMap["attainment_goal"].(float64); ok && v != 0 {
+		apiObject.AttainmentGoal = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Interval = expandInterval(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["warning_threshold"].(float64); ok && v != 0 {
+		apiObject.WarningThreshold = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandInterval(tfMap map[string]interface{}) awstypes.Interval {
+	if v, ok := tfMap["calendar_interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject := awstypes.CalendarInterval{
+			Duration:     aws.Int32(int32(tfMap["duration"].(int))),
+			DurationUnit: awstypes.DurationUnit(tfMap["duration_unit"].(string)),
+		}
+
+		if v, ok := tfMap["start_time"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartTime = aws.Time(v)
+		}
+
+		return &awstypes.IntervalMemberCalendarInterval{Value: apiObject}
+	}
+
+	if v, ok := tfMap["rolling_interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &awstypes.IntervalMemberRollingInterval{Value: awstypes.RollingInterval{
+			Duration:     aws.Int32(int32(tfMap["duration"].(int))),
+			DurationUnit: awstypes.DurationUnit(tfMap["duration_unit"].(string)),
+		}}
+	}
+
+	return nil
+}
+
+func expandServiceLevelIndicatorConfig(tfMap map[string]interface{}) *awstypes.ServiceLevelIndicatorConfig {
+	apiObject := &awstypes.ServiceLevelIndicatorConfig{
+		ComparisonOperator: awstypes.ServiceLevelIndicatorComparisonOperator(tfMap["comparison_operator"].(string)),
+		MetricThreshold:    aws.Float64(tfMap["metric_threshold"].(float64)),
+	}
+
+	if v, ok := tfMap["sli_metric_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		metricConfig := &awstypes.ServiceLevelIndicatorMetricConfig{}
+
+		if v, ok := tfMap["key_attributes"].(map[string]interface{}); ok && len(v) > 0 {
+			metricConfig.KeyAttributes = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap["metric_data_query"].([]interface{}); ok && len(v) > 0 {
+			metricConfig.MetricDataQueries = expandMetricDataQueries(v)
+		}
+
+		if v, ok := tfMap["metric_type"].(string); ok && v != "" {
+			metricConfig.MetricType = awstypes.ServiceLevelIndicatorMetricType(v)
+		}
+
+		if v, ok := tfMap["operation_name"].(string); ok && v != "" {
+			metricConfig.OperationName = aws.String(v)
+		}
+
+		if v, ok := tfMap["period_seconds"].(int); ok && v != 0 {
+			metricConfig.PeriodSeconds = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["statistic"].(string); ok && v != "" {
+			metricConfig.Statistic = aws.String(v)
+		}
+
+		apiObject.SliMetricConfig = metricConfig
+	}
+
+	return apiObject
+}
+
+func expandRequestBasedServiceLevelIndicatorConfig(tfMap map[string]interface{}) *awstypes.RequestBasedServiceLevelIndicatorConfig {
+	apiObject := &awstypes.RequestBasedServiceLevelIndicatorConfig{}
+
+	if v, ok := tfMap["comparison_operator"].(string); ok && v != "" {
+		apiObject.ComparisonOperator = awstypes.ServiceLevelIndicatorComparisonOperator(v)
+	}
+
+	if v, ok := tfMap["metric_threshold"].(float64); ok && v != 0 {
+		apiObject.MetricThreshold = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["request_based_sli_metric_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		metricConfig := &awstypes.RequestBasedServiceLevelIndicatorMetricConfig{}
+
+		if v, ok := tfMap["key_attributes"].(map[string]interface{}); ok && len(v) > 0 {
+			metricConfig.KeyAttributes = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap["metric_type"].(string); ok && v != "" {
+			metricConfig.MetricType = awstypes.ServiceLevelIndicatorMetricType(v)
+		}
+
+		if v, ok := tfMap["monitored_request_count_metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["bad_count_metric"].([]interface{}); ok && len(v) > 0 {
+				metricConfig.MonitoredRequestCountMetric = &awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric{Value: expandMetricDataQueries(v)}
+			} else if v, ok := tfMap["good_count_metric"].([]interface{}); ok && len(v) > 0 {
+				metricConfig.MonitoredRequestCountMetric = &awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric{Value: expandMetricDataQueries(v)}
+			}
+		}
+
+		if v, ok := tfMap["operation_name"].(string); ok && v != "" {
+			metricConfig.OperationName = aws.String(v)
+		}
+
+		if v, ok := tfMap["total_request_count_metric"].([]interface{}); ok && len(v) > 0 {
+			metricConfig.TotalRequestCountMetric = expandMetricDataQueries(v)
+		}
+
+		apiObject.RequestBasedSliMetricConfig = metricConfig
+	}
+
+	return apiObject
+}
+
+func expandMetricDataQueries(tfList []interface{}) []awstypes.MetricDataQuery {
+	var apiObjects []awstypes.MetricDataQuery
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.MetricDataQuery{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["account_id"].(string); ok && v != "" {
+			apiObject.AccountId = aws.String(v)
+		}
+
+		if v, ok := tfMap["expression"].(string); ok && v != "" {
+			apiObject.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			apiObject.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.MetricStat = expandMetricStat(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["period"].(int); ok && v != 0 {
+			apiObject.Period = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["return_data"].(bool); ok {
+			apiObject.ReturnData = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMetricStat(tfMap map[string]interface{}) *awstypes.MetricStat {
+	apiObject := &awstypes.MetricStat{
+		Period: aws.Int32(int32(tfMap["period"].(int))),
+		Stat:   aws.String(tfMap["stat"].(string)),
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		metric := &awstypes.Metric{}
+
+		if v, ok := tfMap["dimension"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				metric.Dimensions = append(metric.Dimensions, awstypes.Dimension{
+					Name:  aws.String(tfMap["name"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["metric_name"].(string); ok && v != "" {
+			metric.MetricName = aws.String(v)
+		}
+
+		if v, ok := tfMap["namespace"].(string); ok && v != "" {
+			metric.Namespace = aws.String(v)
+		}
+
+		apiObject.Metric = metric
+	}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = awstypes.StandardUnit(v)
+	}
+
+	return apiObject
+}
+
+func flattenBurnRateConfigurations(apiObjects []awstypes.BurnRateConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"look_back_window_minutes": aws.ToInt32(apiObject.LookBackWindowMinutes),
+		})
+	}
+
+	return tfList
+}
+
+func flattenGoal(apiObject *awstypes.Goal) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"attainment_goal":   aws.ToFloat64(apiObject.AttainmentGoal),
+		"warning_threshold": aws.ToFloat64(apiObject.WarningThreshold),
+	}
+
+	switch v := apiObject.Interval.(type) {
+	case *awstypes.IntervalMemberCalendarInterval:
+		calendarInterval := map[string]interface{}{
+			"duration":      aws.ToInt32(v.Value.Duration),
+			"duration_unit": string(v.Value.DurationUnit),
+		}
+
+		if v := v.Value.StartTime; v != nil {
+			calendarInterval["start_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfMap["interval"] = []interface{}{map[string]interface{}{
+			"calendar_interval": []interface{}{calendarInterval},
+		}}
+	case *awstypes.IntervalMemberRollingInterval:
+		tfMap["interval"] = []interface{}{map[string]interface{}{
+			"rolling_interval": []interface{}{map[string]interface{}{
+				"duration":      aws.ToInt32(v.Value.Duration),
+				"duration_unit": string(v.Value.DurationUnit),
+			}},
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServiceLevelIndicator(apiObject *awstypes.ServiceLevelIndicator, tfListOld []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"comparison_operator": string(apiObject.ComparisonOperator),
+		"metric_threshold":    aws.ToFloat64(apiObject.MetricThreshold),
+	}
+
+	if v := apiObject.SliMetric; v != nil {
+		metricConfig := map[string]interface{}{
+			"key_attributes": v.KeyAttributes,
+			"metric_type":    string(v.MetricType),
+			"operation_name": aws.ToString(v.OperationName),
+		}
+
+		// Metric data queries are generated by the service for SLIs on a service operation.
+		if len(v.KeyAttributes) == 0 {
+			metricConfig["metric_data_query"] = flattenMetricDataQueries(v.MetricDataQueries)
+		}
+
+		if len(tfListOld) > 0 && tfListOld[0] != nil {
+			if v, ok := tfListOld[0].(map[string]interface{})["sli_metric_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMapOld := v[0].(map[string]interface{})
+				metricConfig["period_seconds"] = tfMapOld["period_seconds"]
+				metricConfig["statistic"] = tfMapOld["statistic"]
+			}
+		}
+
+		tfMap["sli_metric_config"] = []interface{}{metricConfig}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRequestBasedServiceLevelIndicator(apiObject *awstypes.RequestBasedServiceLevelIndicator) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"comparison_operator": string(apiObject.ComparisonOperator),
+		"metric_threshold":    aws.ToFloat64(apiObject.MetricThreshold),
+	}
+
+	if v := apiObject.RequestBasedSliMetric; v != nil {
+		metricConfig := map[string]interface{}{
+			"key_attributes": v.KeyAttributes,
+			"metric_type":    string(v.MetricType),
+			"operation_name": aws.ToString(v.OperationName),
+		}
+
+		// Metric data queries are generated by the service for SLIs on a service operation.
+		if len(v.KeyAttributes) == 0 {
+			switch v := v.MonitoredRequestCountMetric.(type) {
+			case *awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric:
+				metricConfig["monitored_request_count_metric"] = []interface{}{map[string]interface{}{
+					"bad_count_metric": flattenMetricDataQueries(v.Value),
+				}}
+			case *awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric:
+				metricConfig["monitored_request_count_metric"] = []interface{}{map[string]interface{}{
+					"good_count_metric": flattenMetricDataQueries(v.Value),
+				}}
+			}
+
+			metricConfig["total_request_count_metric"] = flattenMetricDataQueries(v.TotalRequestCountMetric)
+		}
+
+		tfMap["request_based_sli_metric_config"] = []interface{}{metricConfig}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMetricDataQueries(apiObjects []awstypes.MetricDataQuery) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"account_id":  aws.ToString(apiObject.AccountId),
+			"expression":  aws.ToString(apiObject.Expression),
+			"id":          aws.ToString(apiObject.Id),
+			"label":       aws.ToString(apiObject.Label),
+			"period":      aws.ToInt32(apiObject.Period),
+			"return_data": aws.ToBool(apiObject.ReturnData),
+		}
+
+		if v := apiObject.MetricStat; v != nil {
+			metricStat := map[string]interface{}{
+				"period": aws.ToInt32(v.Period),
+				"stat":   aws.ToString(v.Stat),
+				"unit":   string(v.Unit),
+			}
+
+			if v := v.Metric; v != nil {
+				var dimensions []interface{}
+
+				for _, v := range v.Dimensions {
+					dimensions = append(dimensions, map[string]interface{}{
+						"name":  aws.ToString(v.Name),
+						"value": aws.ToString(v.Value),
+					})
+				}
+
+				metricStat["metric"] = []interface{}{map[string]interface{}{
+					"dimension":   dimensions,
+					"metric_name": aws.ToString(v.MetricName),
+					"namespace":   aws.ToString(v.Namespace),
+				}}
+			}
+
+			tfMap["metric_stat"] = []interface{}{metricStat}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_applicationsignals_service_level_objective", &resource.Sweeper{
+		Name: "aws_applicationsignals_service_level_objective",
+		F:    sweepServiceLevelObjectives,
+	})
+}
+
+func sweepServiceLevelObjectives(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	input := &applicationsignals.ListServiceLevelObjectivesInput{}
+	conn := client.ApplicationSignalsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := applicationsignals.NewListServiceLevelObjectivesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Application Signals Service Level Objective sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Application Signals Service Level Objectives (%s): %w", region, err)
+		}
+
+		for _, v := range page.SloSummaries {
+			r := resourceServiceLevelObjective()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Application Signals Service Level Objectives (%s): %w", region, err)
+	}
+
+	return nil
+}
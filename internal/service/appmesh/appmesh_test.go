@@ -114,6 +114,9 @@ func TestAccAppMesh_serial(t *testing.T) {
 			"dataSourceVirtualNode":   testAccVirtualServiceDataSource_virtualNode,
 			"dataSourceVirtualRouter": testAccVirtualServiceDataSource_virtualRouter,
 		},
+		"VPCLatticeMigration": {
+			"dataSourceBasic": testAccVPCLatticeMigrationDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
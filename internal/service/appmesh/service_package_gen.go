@@ -53,6 +53,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceVirtualService,
 			TypeName: "aws_appmesh_virtual_service",
 		},
+		{
+			Factory:  DataSourceVPCLatticeMigration,
+			TypeName: "aws_appmesh_vpc_lattice_migration",
+		},
 	}
 }
 
@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appmesh
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// VPC Lattice names must be lowercase alphanumerics separated by single hyphens.
+var vpcLatticeNameInvalidCharsRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+const (
+	vpcLatticeServiceNetworkNameMaxLen = 63
+	vpcLatticeServiceNameMaxLen        = 40
+	vpcLatticeTargetGroupNameMaxLen    = 128
+)
+
+// @SDKDataSource("aws_appmesh_vpc_lattice_migration")
+func DataSourceVPCLatticeMigration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCLatticeMigrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"mesh_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mesh_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"service": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_group": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"protocol_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"route_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"virtual_node_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"weight": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"virtual_service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_network_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCLatticeMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppMeshConn(ctx)
+
+	meshName := d.Get("mesh_name").(string)
+	mesh, err := FindMeshByTwoPartKey(ctx, conn, meshName, d.Get("mesh_owner").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading App Mesh Service Mesh (%s): %s", meshName, err)
+	}
+
+	meshOwner := aws.StringValue(mesh.Metadata.MeshOwner)
+	virtualServiceNames, err := findVirtualServiceNames(ctx, conn, meshName, meshOwner)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing App Mesh Virtual Services (%s): %s", meshName, err)
+	}
+
+	m := &vpcLatticeMigrationMapper{
+		conn:         conn,
+		meshName:     meshName,
+		meshOwner:    meshOwner,
+		virtualNodes: make(map[string]*appmesh.VirtualNodeData),
+	}
+	var services []interface{}
+
+	for _, virtualServiceName := range virtualServiceNames {
+		service, err := m.mapVirtualService(ctx, virtualServiceName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "mapping App Mesh Virtual Service (%s) to VPC Lattice: %s", virtualServiceName, err)
+		}
+
+		services = append(services, service)
+	}
+
+	d.SetId(meshName)
+	d.Set("mesh_name", meshName)
+	d.Set("mesh_owner", meshOwner)
+	if err := d.Set("service", services); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting service: %s", err)
+	}
+	d.Set("service_network_name", vpcLatticeName(vpcLatticeServiceNetworkNameMaxLen, meshName))
+
+	return diags
+}
+
+type vpcLatticeMigrationMapper struct {
+	conn         *appmesh.AppMesh
+	meshName     string
+	meshOwner    string
+	virtualNodes map[string]*appmesh.VirtualNodeData
+}
+
+func (m *vpcLatticeMigrationMapper) mapVirtualService(ctx context.Context, name string) (map[string]interface{}, error) {
+	vs, err := FindVirtualServiceByThreePartKey(ctx, m.conn, m.meshName, m.meshOwner, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tfMap := map[string]interface{}{
+		"service_name":         vpcLatticeName(vpcLatticeServiceNameMaxLen, name),
+		"virtual_service_name": name,
+	}
+
+	if vs.Spec == nil || vs.Spec.Provider == nil {
+		return tfMap, nil
+	}
+
+	var targetGroups []interface{}
+
+	switch provider := vs.Spec.Provider; {
+	case provider.VirtualNode != nil:
+		virtualNodeName := aws.StringValue(provider.VirtualNode.VirtualNodeName)
+		tfMap["provider_name"] = virtualNodeName
+		tfMap["provider_type"] = "virtual_node"
+
+		targetGroup, err := m.mapWeightedTarget(ctx, "", &appmesh.WeightedTarget{
+			VirtualNode: aws.String(virtualNodeName),
+			Weight:      aws.Int64(100),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		targetGroups = append(targetGroups, targetGroup)
+	case provider.VirtualRouter != nil:
+		virtualRouterName := aws.StringValue(provider.VirtualRouter.VirtualRouterName)
+		tfMap["provider_name"] = virtualRouterName
+		tfMap["provider_type"] = "virtual_router"
+
+		routeNames, err := findRouteNames(ctx, m.conn, m.meshName, m.meshOwner, virtualRouterName)
+
+		if err != nil {
+			return nil, fmt.Errorf("listing App Mesh Routes (%s): %w", virtualRouterName, err)
+		}
+
+		for _, routeName := range routeNames {
+			route, err := FindRouteByFourPartKey(ctx, m.conn, m.meshName, m.meshOwner, virtualRouterName, routeName)
+
+			if err != nil {
+				return nil, fmt.Errorf("reading App Mesh Route (%s): %w", routeName, err)
+			}
+
+			for _, v := range routeWeightedTargets(route.Spec) {
+				targetGroup, err := m.mapWeightedTarget(ctx, routeName, v)
+
+				if err != nil {
+					return nil, err
+				}
+
+				targetGroups = append(targetGroups, targetGroup)
+			}
+		}
+	}
+
+	tfMap["target_group"] = targetGroups
+
+	return tfMap, nil
+}
+
+func (m *vpcLatticeMigrationMapper) mapWeightedTarget(ctx context.Context, routeName string, apiObject *appmesh.WeightedTarget) (map[string]interface{}, error) {
+	virtualNodeName := aws.StringValue(apiObject.VirtualNode)
+	vn, ok := m.virtualNodes[virtualNodeName]
+
+	if !ok {
+		var err error
+		vn, err = FindVirtualNodeByThreePartKey(ctx, m.conn, m.meshName, m.meshOwner, virtualNodeName)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading App Mesh Virtual Node (%s): %w", virtualNodeName, err)
+		}
+
+		m.virtualNodes[virtualNodeName] = vn
+	}
+
+	tfMap := map[string]interface{}{
+		"name":              vpcLatticeName(vpcLatticeTargetGroupNameMaxLen, m.meshName, virtualNodeName),
+		"route_name":        routeName,
+		"virtual_node_name": virtualNodeName,
+		"weight":            aws.Int64Value(apiObject.Weight),
+	}
+
+	// Use the listener the target port refers to, defaulting to the virtual node's first listener.
+	var portMapping *appmesh.PortMapping
+	if vn.Spec != nil {
+		for _, v := range vn.Spec.Listeners {
+			if v == nil || v.PortMapping == nil {
+				continue
+			}
+
+			if portMapping == nil || aws.Int64Value(v.PortMapping.Port) == aws.Int64Value(apiObject.Port) {
+				portMapping = v.PortMapping
+			}
+		}
+	}
+
+	if port := aws.Int64Value(apiObject.Port); port != 0 {
+		tfMap["port"] = port
+	} else if portMapping != nil {
+		tfMap["port"] = aws.Int64Value(portMapping.Port)
+	}
+
+	if portMapping != nil {
+		tfMap["protocol"], tfMap["protocol_version"] = vpcLatticeProtocol(aws.StringValue(portMapping.Protocol))
+	}
+
+	return tfMap, nil
+}
+
+func findVirtualServiceNames(ctx context.Context, conn *appmesh.AppMesh, meshName, meshOwner string) ([]string, error) {
+	input := &appmesh.ListVirtualServicesInput{
+		MeshName: aws.String(meshName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+	var output []string
+
+	err := conn.ListVirtualServicesPagesWithContext(ctx, input, func(page *appmesh.ListVirtualServicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VirtualServices {
+			if v != nil {
+				output = append(output, aws.StringValue(v.VirtualServiceName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findRouteNames(ctx context.Context, conn *appmesh.AppMesh, meshName, meshOwner, virtualRouterName string) ([]string, error) {
+	input := &appmesh.ListRoutesInput{
+		MeshName:          aws.String(meshName),
+		VirtualRouterName: aws.String(virtualRouterName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+	var output []string
+
+	err := conn.ListRoutesPagesWithContext(ctx, input, func(page *appmesh.ListRoutesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Routes {
+			if v != nil {
+				output = append(output, aws.StringValue(v.RouteName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func routeWeightedTargets(apiObject *appmesh.RouteSpec) []*appmesh.WeightedTarget {
+	if apiObject == nil {
+		return nil
+	}
+
+	switch {
+	case apiObject.GrpcRoute != nil && apiObject.GrpcRoute.Action != nil:
+		return apiObject.GrpcRoute.Action.WeightedTargets
+	case apiObject.Http2Route != nil && apiObject.Http2Route.Action != nil:
+		return apiObject.Http2Route.Action.WeightedTargets
+	case apiObject.HttpRoute != nil && apiObject.HttpRoute.Action != nil:
+		return apiObject.HttpRoute.Action.WeightedTargets
+	case apiObject.TcpRoute != nil && apiObject.TcpRoute.Action != nil:
+		return apiObject.TcpRoute.Action.WeightedTargets
+	}
+
+	return nil
+}
+
+// vpcLatticeProtocol returns the VPC Lattice target group protocol and protocol version equivalent to an App Mesh listener protocol.
+func vpcLatticeProtocol(protocol string) (string, string) {
+	switch protocol {
+	case appmesh.PortProtocolGrpc:
+		return "HTTP", "GRPC"
+	case appmesh.PortProtocolHttp:
+		return "HTTP", "HTTP1"
+	case appmesh.PortProtocolHttp2:
+		return "HTTP", "HTTP2"
+	case appmesh.PortProtocolTcp:
+		return "TCP", ""
+	}
+
+	return "", ""
+}
+
+// vpcLatticeName joins the specified parts into a name that satisfies VPC Lattice naming rules.
+func vpcLatticeName(maxLen int, parts ...string) string {
+	name := strings.ToLower(strings.Join(parts, "-"))
+	name = vpcLatticeNameInvalidCharsRegexp.ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-")
+
+	if len(name) > maxLen {
+		name = strings.TrimRight(name[:maxLen], "-")
+	}
+
+	return name
+}
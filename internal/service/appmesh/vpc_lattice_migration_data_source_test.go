@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appmesh_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccVPCLatticeMigrationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_appmesh_vpc_lattice_migration.test"
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appmesh.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCLatticeMigrationDataSourceConfig_basic(rName, vsName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "mesh_name", "aws_appmesh_mesh.test", "name"),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "mesh_owner"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_network_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "service.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.virtual_service_name", vsName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service.0.service_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.provider_type", "virtual_router"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service.0.provider_name", "aws_appmesh_virtual_router.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.target_group.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "service.0.target_group.*", map[string]string{
+						"name":              fmt.Sprintf("%[1]s-%[1]s-1", rName),
+						"port":              "8080",
+						"protocol":          "HTTP",
+						"protocol_version":  "HTTP1",
+						"route_name":        rName,
+						"virtual_node_name": rName + "-1",
+						"weight":            "90",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "service.0.target_group.*", map[string]string{
+						"name":              fmt.Sprintf("%[1]s-%[1]s-2", rName),
+						"port":              "8080",
+						"protocol":          "HTTP",
+						"protocol_version":  "HTTP1",
+						"route_name":        rName,
+						"virtual_node_name": rName + "-2",
+						"weight":            "10",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCLatticeMigrationDataSourceConfig_basic(rName, vsName string) string {
+	return fmt.Sprintf(`
+resource "aws_appmesh_mesh" "test" {
+  name = %[1]q
+}
+
+resource "aws_appmesh_virtual_node" "test" {
+  count = 2
+
+  name      = "%[1]s-${count.index + 1}"
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    listener {
+      port_mapping {
+        port     = 8080
+        protocol = "http"
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_virtual_router" "test" {
+  name      = %[1]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    listener {
+      port_mapping {
+        port     = 8080
+        protocol = "http"
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_route" "test" {
+  name                = %[1]q
+  mesh_name           = aws_appmesh_mesh.test.id
+  virtual_router_name = aws_appmesh_virtual_router.test.name
+
+  spec {
+    http_route {
+      match {
+        prefix = "/"
+      }
+
+      action {
+        weighted_target {
+          virtual_node = aws_appmesh_virtual_node.test[0].name
+          weight       = 90
+        }
+
+        weighted_target {
+          virtual_node = aws_appmesh_virtual_node.test[1].name
+          weight       = 10
+        }
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_virtual_service" "test" {
+  name      = %[2]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    provider {
+      virtual_router {
+        virtual_router_name = aws_appmesh_virtual_router.test.name
+      }
+    }
+  }
+}
+
+data "aws_appmesh_vpc_lattice_migration" "test" {
+  mesh_name = aws_appmesh_mesh.test.name
+
+  depends_on = [aws_appmesh_route.test, aws_appmesh_virtual_service.test]
+}
+`, rName, vsName)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package artifact
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/artifact"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/artifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_artifact_report", name="Report")
+func dataSourceReport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"acceptance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"company_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"report_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"report_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"series": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"term_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"term_document_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ArtifactClient(ctx)
+
+	reportID := d.Get("report_id").(string)
+	var reportVersion int64
+	if v, ok := d.GetOk("report_version"); ok {
+		reportVersion = int64(v.(int))
+	}
+
+	report, err := findReportMetadataByTwoPartKey(ctx, conn, reportID, reportVersion)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Artifact Report (%s): %s", reportID, err)
+	}
+
+	// The report version is pinned so that the term and the document retrieved below
+	// always belong to the metadata returned above.
+	reportVersion = aws.ToInt64(report.Version)
+
+	term, err := conn.GetTermForReport(ctx, &artifact.GetTermForReportInput{
+		ReportId:      aws.String(reportID),
+		ReportVersion: aws.Int64(reportVersion),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Artifact Report (%s) term: %s", reportID, err)
+	}
+
+	// Passing the term token to GetReport records acceptance of the report's terms.
+	output, err := conn.GetReport(ctx, &artifact.GetReportInput{
+		ReportId:      aws.String(reportID),
+		ReportVersion: aws.Int64(reportVersion),
+		TermToken:     term.TermToken,
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Artifact Report (%s) document: %s", reportID, err)
+	}
+
+	d.SetId(reportID)
+	d.Set("acceptance_type", report.AcceptanceType)
+	d.Set("arn", report.Arn)
+	d.Set("category", report.Category)
+	d.Set("company_name", report.CompanyName)
+	d.Set("description", report.Description)
+	d.Set("document_url", output.DocumentPresignedUrl)
+	d.Set("name", report.Name)
+	if v := report.PeriodEnd; v != nil {
+		d.Set("period_end", aws.ToTime(v).Format(time.RFC3339))
+	}
+	if v := report.PeriodStart; v != nil {
+		d.Set("period_start", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("product_name", report.ProductName)
+	d.Set("report_version", reportVersion)
+	d.Set("series", report.Series)
+	d.Set("state", report.State)
+	d.Set("term_arn", report.TermArn)
+	d.Set("term_document_url", term.DocumentPresignedUrl)
+
+	return diags
+}
+
+func findReportMetadataByTwoPartKey(ctx context.Context, conn *artifact.Client, reportID string, reportVersion int64) (*awstypes.ReportDetail, error) {
+	input := &artifact.GetReportMetadataInput{
+		ReportId: aws.String(reportID),
+	}
+	if reportVersion > 0 {
+		input.ReportVersion = aws.Int64(reportVersion)
+	}
+
+	output, err := conn.GetReportMetadata(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReportDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReportDetails, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package artifact_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Artifact reports are published by AWS and cannot be created by the provider,
+// so the acceptance test references an existing report.
+const envVarReportID = "ARTIFACT_REPORT_ID"
+
+func TestAccArtifactReportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	reportID := acctest.SkipIfEnvVarNotSet(t, envVarReportID)
+	dataSourceName := "data.aws_artifact_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ArtifactEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportDataSourceConfig_basic(reportID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "document_url"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "report_id", reportID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "report_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "term_document_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReportDataSourceConfig_basic(reportID string) string {
+	return fmt.Sprintf(`
+data "aws_artifact_report" "test" {
+  report_id = %[1]q
+}
+`, reportID)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccB2BICapability_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v b2bi.GetCapabilityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_capability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.B2BIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapabilityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "b2bi", regexache.MustCompile(`capability/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.edi.0.input_location.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.input_location.0.key", "input"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.output_location.0.key", "output"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.edi.0.transformer_id", "aws_b2bi_transformer.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "edi"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapabilityConfig_basic(rName, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BICapability_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v b2bi.GetCapabilityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_capability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.B2BIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapabilityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfb2bi.ResourceCapability(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCapabilityExists(ctx context.Context, n string, v *b2bi.GetCapabilityOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIClient(ctx)
+
+		output, err := tfb2bi.FindCapabilityByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCapabilityDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_b2bi_capability" {
+				continue
+			}
+
+			_, err := tfb2bi.FindCapabilityByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("B2B Data Interchange Capability %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCapabilityConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccTransformerConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName))
+}
+
+func testAccCapabilityConfig_basic(rName, name string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfig_base(rName), fmt.Sprintf(`
+resource "aws_b2bi_capability" "test" {
+  name = %[1]q
+  type = "edi"
+
+  configuration {
+    edi {
+      transformer_id = aws_b2bi_transformer.test.id
+
+      input_location {
+        bucket_name = aws_s3_bucket.test.bucket
+        key         = "input"
+      }
+
+      output_location {
+        bucket_name = aws_s3_bucket.test.bucket
+        key         = "output"
+      }
+
+      type {
+        x12_details {
+          transaction_set = "X12_110"
+          version         = "VERSION_4010"
+        }
+      }
+    }
+  }
+}
+`, name))
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/b2bi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/b2bi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_b2bi_partnership", name="Partnership")
+// @Tags(identifierAttribute="arn")
+func resourcePartnership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePartnershipCreate,
+		ReadWithoutTimeout:   resourcePartnershipRead,
+		UpdateWithoutTimeout: resourcePartnershipUpdate,
+		DeleteWithoutTimeout: resourcePartnershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(5, 254),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"phone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(7, 22),
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trading_partner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePartnershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreatePartnershipInput{
+		Email:     aws.String(d.Get("email").(string)),
+		Name:      aws.String(name),
+		ProfileId: aws.String(d.Get("profile_id").(string)),
+		Tags:      getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok && v.(*schema.Set).Len() > 0 {
+		input.Capabilities = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("phone"); ok {
+		input.Phone = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePartnership(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating B2B Data Interchange Partnership (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.PartnershipId))
+
+	return append(diags, resourcePartnershipRead(ctx, d, meta)...)
+}
+
+func resourcePartnershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIClient(ctx)
+
+	output, err := findPartnershipByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2B Data Interchange Partnership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading B2B Data Interchange Partnership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.PartnershipArn)
+	d.Set("capabilities", output.Capabilities)
+	d.Set("email", output.Email)
+	d.Set("name", output.Name)
+	d.Set("phone", output.Phone)
+	d.Set("profile_id", output.ProfileId)
+	d.Set("trading_partner_id", output.TradingPartnerId)
+
+	return diags
+}
+
+func resourcePartnershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &b2bi.UpdatePartnershipInput{
+			PartnershipId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("capabilities") {
+			input.Capabilities = flex.ExpandStringValueSet(d.Get("capabilities").(*schema.Set))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdatePartnership(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating B2B Data Interchange Partnership (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePartnershipRead(ctx, d, meta)...)
+}
+
+func resourcePartnershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIClient(ctx)
+
+	log.Printf("[DEBUG] Deleting B2B Data Interchange Partnership: %s", d.Id())
+	_, err := conn.DeletePartnership(ctx, &b2bi.DeletePartnershipInput{
+		PartnershipId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting B2B Data Interchange Partnership (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPartnershipByID(ctx context.Context, conn *b2bi.Client, id string) (*b2bi.GetPartnershipOutput, error) {
+	input := &b2bi.GetPartnershipInput{
+		PartnershipId: aws.String(id),
+	}
+
+	output, err := conn.GetPartnership(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/b2bi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_b2bi_capability", &resource.Sweeper{
+		Name: "aws_b2bi_capability",
+		F:    sweepCapabilities,
+		Dependencies: []string{
+			"aws_b2bi_partnership",
+		},
+	})
+
+	resource.AddTestSweepers("aws_b2bi_partnership", &resource.Sweeper{
+		Name: "aws_b2bi_partnership",
+		F:    sweepPartnerships,
+	})
+
+	resource.AddTestSweepers("aws_b2bi_profile", &resource.Sweeper{
+		Name: "aws_b2bi_profile",
+		F:    sweepProfiles,
+		Dependencies: []string{
+			"aws_b2bi_partnership",
+		},
+	})
+
+	resource.AddTestSweepers("aws_b2bi_transformer", &resource.Sweeper{
+		Name: "aws_b2bi_transformer",
+		F:    sweepTransformers,
+		Dependencies: []string{
+			"aws_b2bi_capability",
+		},
+	})
+}
+
+func sweepCapabilities(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.B2BIClient(ctx)
+	input := &b2bi.ListCapabilitiesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := b2bi.NewListCapabilitiesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping B2B Data Interchange Capability sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing B2B Data Interchange Capabilities (%s): %w", region, err)
+		}
+
+		for _, v := range page.Capabilities {
+			r := resourceCapability()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.CapabilityId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping B2B Data Interchange Capabilities (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPartnerships(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.B2BIClient(ctx)
+	input := &b2bi.ListPartnershipsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := b2bi.NewListPartnershipsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping B2B Data Interchange Partnership sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing B2B Data Interchange Partnerships (%s): %w", region, err)
+		}
+
+		for _, v := range page.Partnerships {
+			r := resourcePartnership()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.PartnershipId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping B2B Data Interchange Partnerships (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProfiles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.B2BIClient(ctx)
+	input := &b2bi.ListProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := b2bi.NewListProfilesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping B2B Data Interchange Profile sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing B2B Data Interchange Profiles (%s): %w", region, err)
+		}
+
+		for _, v := range page.Profiles {
+			r := resourceProfile()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.ProfileId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping B2B Data Interchange Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTransformers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.B2BIClient(ctx)
+	input := &b2bi.ListTransformersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := b2bi.NewListTransformersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping B2B Data Interchange Transformer sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing B2B Data Interchange Transformers (%s): %w", region, err)
+		}
+
+		for _, v := range page.Transformers {
+			r := resourceTransformer()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.TransformerId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping B2B Data Interchange Transformers (%s): %w", region, err)
+	}
+
+	return nil
+}
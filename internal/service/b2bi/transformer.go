@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/b2bi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/b2bi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_b2bi_transformer", name="Transformer")
+// @Tags(identifierAttribute="arn")
+func resourceTransformer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransformerCreate,
+		ReadWithoutTimeout:   resourceTransformerRead,
+		UpdateWithoutTimeout: resourceTransformerUpdate,
+		DeleteWithoutTimeout: resourceTransformerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"edi_type": ediTypeSchema(),
+			"file_format": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.FileFormat](),
+			},
+			"mapping_template": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 350000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"sample_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.TransformerStatus](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// ediTypeSchema returns the schema shared by transformers and capabilities
+// for describing the EDI document type.
+func ediTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"x12_details": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"transaction_set": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.X12TransactionSet](),
+							},
+							"version": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.X12Version](),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTransformerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreateTransformerInput{
+		EdiType:         expandEDIType(d.Get("edi_type").([]interface{})),
+		FileFormat:      awstypes.FileFormat(d.Get("file_format").(string)),
+		MappingTemplate: aws.String(d.Get("mapping_template").(string)),
+		Name:            aws.String(name),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("sample_document"); ok {
+		input.SampleDocument = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateTransformer(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating B2B Data Interchange Transformer (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.TransformerId))
+
+	// Transformers are always created inactive.
+	if v, ok := d.GetOk("status"); ok && v.(string) != string(output.Status) {
+		_, err := conn.UpdateTransformer(ctx, &b2bi.UpdateTransformerInput{
+			Status:        awstypes.TransformerStatus(v.(string)),
+			TransformerId: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating B2B Data Interchange Transformer (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTransformerRead(ctx, d, meta)...)
+}
+
+func resourceTransformerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIClient(ctx)
+
+	output, err := findTransformerByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2B Data Interchange Transformer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading B2B Data Interchange Transformer (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.TransformerArn)
+	if err := d.Set("edi_type", flattenEDIType(output.EdiType)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting edi_type: %s", err)
+	}
+	d.Set("file_format", output.FileFormat)
+	d.Set("mapping_template", output.MappingTemplate)
+	d.Set("name", output.Name)
+	d.Set("sample_document", output.SampleDocument)
+	d.Set("status", output.Status)
+
+	return diags
+}
+
+func resourceTransformerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &b2bi.UpdateTransformerInput{
+			TransformerId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("edi_type") {
+			input.EdiType = expandEDIType(d.Get("edi_type").([]interface{}))
+		}
+
+		if d.HasChange("file_format") {
+			input.FileFormat = awstypes.FileFormat(d.Get("file_format").(string))
+		}
+
+		if d.HasChange("mapping_template") {
+			input.MappingTemplate = aws.String(d.Get("mapping_template").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("sample_document") {
+			input.SampleDocument = aws.String(d.Get("sample_document").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = awstypes.TransformerStatus(d.Get("status").(string))
+		}
+
+		_, err := conn.UpdateTransformer(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating B2B Data Interchange Transformer (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTransformerRead(ctx, d, meta)...)
+}
+
+func resourceTransformerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).B2BIClient(ctx)
+
+	log.Printf("[DEBUG] Deleting B2B Data Interchange Transformer: %s", d.Id())
+	_, err := conn.DeleteTransformer(ctx, &b2bi.DeleteTransformerInput{
+		TransformerId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting B2B Data Interchange Transformer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findTransformerByID(ctx context.Context, conn *b2bi.Client, id string) (*b2bi.GetTransformerOutput, error) {
+	input := &b2bi.GetTransformerInput{
+		TransformerId: aws.String(id),
+	}
+
+	output, err := conn.GetTransformer(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandEDIType(tfList []interface{}) awstypes.EdiType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["x12_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		x12Details := awstypes.X12Details{}
+
+		if v, ok := tfMap["transaction_set"].(string); ok && v != "" {
+			x12Details.TransactionSet = awstypes.X12TransactionSet(v)
+		}
+
+		if v, ok := tfMap["version"].(string); ok && v != "" {
+			x12Details.Version = awstypes.X12Version(v)
+		}
+
+		return &awstypes.EdiTypeMemberX12Details{
+			Value: x12Details,
+		}
+	}
+
+	return nil
+}
+
+func flattenEDIType(apiObject awstypes.EdiType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *awstypes.EdiTypeMemberX12Details:
+		tfMap["x12_details"] = []interface{}{map[string]interface{}{
+			"transaction_set": string(v.Value.TransactionSet),
+			"version":         string(v.Value.Version),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
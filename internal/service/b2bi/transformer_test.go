@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccB2BITransformer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v b2bi.GetTransformerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_transformer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.B2BIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransformerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "b2bi", regexache.MustCompile(`transformer/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.0.x12_details.0.transaction_set", "X12_110"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.0.x12_details.0.version", "VERSION_4010"),
+					resource.TestCheckResourceAttr(resourceName, "file_format", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "inactive"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccB2BITransformer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v b2bi.GetTransformerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_transformer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.B2BIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransformerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfb2bi.ResourceTransformer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccB2BITransformer_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v b2bi.GetTransformerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_transformer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.B2BIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransformerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_status(rName, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransformerConfig_status(rName, "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "inactive"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransformerExists(ctx context.Context, n string, v *b2bi.GetTransformerOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIClient(ctx)
+
+		output, err := tfb2bi.FindTransformerByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTransformerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_b2bi_transformer" {
+				continue
+			}
+
+			_, err := tfb2bi.FindTransformerByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("B2B Data Interchange Transformer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTransformerConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_transformer" "test" {
+  name             = %[1]q
+  file_format      = "JSON"
+  mapping_template = "{ \"ReferenceID\": interchange.isa_13 }"
+
+  edi_type {
+    x12_details {
+      transaction_set = "X12_110"
+      version         = "VERSION_4010"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccTransformerConfig_status(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_transformer" "test" {
+  name             = %[1]q
+  file_format      = "JSON"
+  mapping_template = "{ \"ReferenceID\": interchange.isa_13 }"
+  status           = %[2]q
+
+  edi_type {
+    x12_details {
+      transaction_set = "X12_110"
+      version         = "VERSION_4010"
+    }
+  }
+}
+`, rName, status)
+}
@@ -0,0 +1,468 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bcmdataexports
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bcmdataexports/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Export")
+// @Tags(identifierAttribute="arn")
+func newExportResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &exportResource{}, nil
+}
+
+type exportResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *exportResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bcmdataexports_export"
+}
+
+func (r *exportResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:     framework.ARNAttributeComputedOnly(),
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"export": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[exportModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Optional: true,
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"data_query": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataQueryModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"query_statement": schema.StringAttribute{
+										Required: true,
+									},
+									"table_configurations": schema.MapAttribute{
+										Optional: true,
+										ElementType: types.MapType{
+											ElemType: types.StringType,
+										},
+									},
+								},
+							},
+						},
+						"destination_configurations": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[destinationConfigurationsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"s3_destination": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3DestinationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"s3_bucket": schema.StringAttribute{
+													Required: true,
+												},
+												"s3_prefix": schema.StringAttribute{
+													Required: true,
+												},
+												"s3_region": schema.StringAttribute{
+													Required: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"s3_output_configurations": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[s3OutputConfigurationsModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"compression": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.CompressionOption](),
+																Required:   true,
+															},
+															"format": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.FormatOption](),
+																Required:   true,
+															},
+															"output_type": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.S3OutputType](),
+																Required:   true,
+															},
+															"overwrite": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.OverwriteOption](),
+																Required:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"refresh_cadence": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[refreshCadenceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"frequency": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FrequencyOption](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *exportResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data exportResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BCMDataExportsClient(ctx)
+
+	export, diags := data.expandExport(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &bcmdataexports.CreateExportInput{
+		Export:       export,
+		ResourceTags: getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateExport(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating BCM Data Exports Export (%s)", aws.ToString(export.Name)), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ExportARN = fwflex.StringToFramework(ctx, output.ExportArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *exportResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data exportResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BCMDataExportsClient(ctx)
+
+	exportARN := data.ID.ValueString()
+	output, err := findExportByARN(ctx, conn, exportARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading BCM Data Exports Export (%s)", exportARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenExport(ctx, output.Export)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *exportResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new exportResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BCMDataExportsClient(ctx)
+
+	if !new.Export.Equal(old.Export) {
+		export, diags := new.expandExport(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &bcmdataexports.UpdateExportInput{
+			Export:    export,
+			ExportArn: fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		_, err := conn.UpdateExport(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating BCM Data Exports Export (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *exportResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data exportResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BCMDataExportsClient(ctx)
+
+	exportARN := data.ID.ValueString()
+	_, err := conn.DeleteExport(ctx, &bcmdataexports.DeleteExportInput{
+		ExportArn: aws.String(exportARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting BCM Data Exports Export (%s)", exportARN), err.Error())
+
+		return
+	}
+}
+
+func (r *exportResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findExportByARN(ctx context.Context, conn *bcmdataexports.Client, arn string) (*bcmdataexports.GetExportOutput, error) {
+	input := &bcmdataexports.GetExportInput{
+		ExportArn: aws.String(arn),
+	}
+
+	output, err := conn.GetExport(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Export == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type exportResourceModel struct {
+	Export    fwtypes.ListNestedObjectValueOf[exportModel] `tfsdk:"export"`
+	ExportARN types.String                                 `tfsdk:"arn"`
+	ID        types.String                                 `tfsdk:"id"`
+	Tags      types.Map                                    `tfsdk:"tags"`
+	TagsAll   types.Map                                    `tfsdk:"tags_all"`
+}
+
+func (data *exportResourceModel) InitFromID() error {
+	data.ExportARN = data.ID
+
+	return nil
+}
+
+func (data *exportResourceModel) setID() {
+	data.ID = data.ExportARN
+}
+
+// expandExport returns the API representation of the export.
+// AutoFlex doesn't support the data query's map of maps, so table configurations are expanded explicitly.
+func (data *exportResourceModel) expandExport(ctx context.Context) (*awstypes.Export, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	exportData, d := data.Export.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.Export{}
+	diags.Append(fwflex.Expand(ctx, exportData, apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	dataQueryData, d := exportData.DataQuery.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !dataQueryData.TableConfigurations.IsNull() && !dataQueryData.TableConfigurations.IsUnknown() {
+		var tableConfigurations map[string]map[string]string
+		diags.Append(dataQueryData.TableConfigurations.ElementsAs(ctx, &tableConfigurations, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.DataQuery.TableConfigurations = tableConfigurations
+	}
+
+	return apiObject, diags
+}
+
+// flattenExport sets the model's export from its API representation.
+func (data *exportResourceModel) flattenExport(ctx context.Context, apiObject *awstypes.Export) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var exportData exportModel
+	diags.Append(fwflex.Flatten(ctx, apiObject, &exportData)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	dataQueryData, d := exportData.DataQuery.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tableConfigurations := types.MapNull(types.MapType{ElemType: types.StringType})
+	if apiObject.DataQuery != nil && len(apiObject.DataQuery.TableConfigurations) > 0 {
+		tableConfigurations, d = types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, apiObject.DataQuery.TableConfigurations)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	dataQueryData.TableConfigurations = tableConfigurations
+
+	exportData.DataQuery = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, dataQueryData)
+	data.Export = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &exportData)
+	data.ExportARN = fwflex.StringToFramework(ctx, apiObject.ExportArn)
+
+	return diags
+}
+
+type exportModel struct {
+	DataQuery                 fwtypes.ListNestedObjectValueOf[dataQueryModel]                 `tfsdk:"data_query"`
+	Description               types.String                                                    `tfsdk:"description"`
+	DestinationConfigurations fwtypes.ListNestedObjectValueOf[destinationConfigurationsModel] `tfsdk:"destination_configurations"`
+	Name                      types.String                                                    `tfsdk:"name"`
+	RefreshCadence            fwtypes.ListNestedObjectValueOf[refreshCadenceModel]            `tfsdk:"refresh_cadence"`
+}
+
+type dataQueryModel struct {
+	QueryStatement      types.String `tfsdk:"query_statement"`
+	TableConfigurations types.Map    `tfsdk:"table_configurations"`
+}
+
+type destinationConfigurationsModel struct {
+	S3Destination fwtypes.ListNestedObjectValueOf[s3DestinationModel] `tfsdk:"s3_destination"`
+}
+
+type s3DestinationModel struct {
+	S3Bucket               types.String                                                 `tfsdk:"s3_bucket"`
+	S3OutputConfigurations fwtypes.ListNestedObjectValueOf[s3OutputConfigurationsModel] `tfsdk:"s3_output_configurations"`
+	S3Prefix               types.String                                                 `tfsdk:"s3_prefix"`
+	S3Region               types.String                                                 `tfsdk:"s3_region"`
+}
+
+type s3OutputConfigurationsModel struct {
+	Compression fwtypes.StringEnum[awstypes.CompressionOption] `tfsdk:"compression"`
+	Format      fwtypes.StringEnum[awstypes.FormatOption]      `tfsdk:"format"`
+	OutputType  fwtypes.StringEnum[awstypes.S3OutputType]      `tfsdk:"output_type"`
+	Overwrite   fwtypes.StringEnum[awstypes.OverwriteOption]   `tfsdk:"overwrite"`
+}
+
+type refreshCadenceModel struct {
+	Frequency fwtypes.StringEnum[awstypes.FrequencyOption] `tfsdk:"frequency"`
+}
@@ -1,6 +1,7 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../generate/tags/main.go -AWSSDKVersion=2 -ServiceTagsSlice -ListTags -ListTagsInIDElem=ResourceArn -ListTagsOutTagsElem=ResourceTags -TagInIDElem=ResourceArn -TagInTagsElem=ResourceTags -TagType=ResourceTag -UntagInTagsElem=ResourceTagKeys -UpdateTags
 //go:generate go run ../../generate/servicepackage/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
@@ -42,7 +42,7 @@ func ResourceBudgetAction() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute), // unneeded, but a breaking change to remove
-			Update: schema.DefaultTimeout(5 * time.Minute), // unneeded, but a breaking change to remove
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -108,16 +108,18 @@ func ResourceBudgetAction() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"iam_action_definition": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: budgetActionDefinitionKeys,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"groups": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										MaxItems: 100,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										Type:         schema.TypeSet,
+										Optional:     true,
+										MaxItems:     100,
+										Elem:         &schema.Schema{Type: schema.TypeString},
+										AtLeastOneOf: budgetActionIAMActionDefinitionPrincipalKeys,
 									},
 									"policy_arn": {
 										Type:         schema.TypeString,
@@ -125,24 +127,27 @@ func ResourceBudgetAction() *schema.Resource {
 										ValidateFunc: verify.ValidARN,
 									},
 									"roles": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										MaxItems: 100,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										Type:         schema.TypeSet,
+										Optional:     true,
+										MaxItems:     100,
+										Elem:         &schema.Schema{Type: schema.TypeString},
+										AtLeastOneOf: budgetActionIAMActionDefinitionPrincipalKeys,
 									},
 									"users": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										MaxItems: 100,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										Type:         schema.TypeSet,
+										Optional:     true,
+										MaxItems:     100,
+										Elem:         &schema.Schema{Type: schema.TypeString},
+										AtLeastOneOf: budgetActionIAMActionDefinitionPrincipalKeys,
 									},
 								},
 							},
 						},
 						"scp_action_definition": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: budgetActionDefinitionKeys,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"policy_id": {
@@ -159,9 +164,10 @@ func ResourceBudgetAction() *schema.Resource {
 							},
 						},
 						"ssm_action_definition": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: budgetActionDefinitionKeys,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"action_sub_type": {
@@ -221,9 +227,49 @@ func ResourceBudgetAction() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceBudgetActionCustomizeDiff,
 	}
 }
 
+var (
+	budgetActionDefinitionKeys = []string{
+		"definition.0.iam_action_definition",
+		"definition.0.scp_action_definition",
+		"definition.0.ssm_action_definition",
+	}
+	budgetActionIAMActionDefinitionPrincipalKeys = []string{
+		"definition.0.iam_action_definition.0.groups",
+		"definition.0.iam_action_definition.0.roles",
+		"definition.0.iam_action_definition.0.users",
+	}
+)
+
+func resourceBudgetActionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Each action type is configured with its own definition block.
+	definitionKeys := map[awstypes.ActionType]string{
+		awstypes.ActionTypeIam: "iam_action_definition",
+		awstypes.ActionTypeScp: "scp_action_definition",
+		awstypes.ActionTypeSsm: "ssm_action_definition",
+	}
+
+	if !d.NewValueKnown("action_type") || !d.NewValueKnown("definition") {
+		return nil
+	}
+
+	actionType := awstypes.ActionType(d.Get("action_type").(string))
+	key, ok := definitionKeys[actionType]
+	if !ok {
+		return nil
+	}
+
+	if v, ok := d.Get("definition.0." + key).([]interface{}); !ok || len(v) == 0 {
+		return fmt.Errorf("action_type %s requires definition.0.%s", actionType, key)
+	}
+
+	return nil
+}
+
 func resourceBudgetActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BudgetsClient(ctx)
@@ -353,7 +399,9 @@ func resourceBudgetActionUpdate(ctx context.Context, d *schema.ResourceData, met
 		input.Subscribers = expandBudgetActionSubscriber(d.Get("subscriber").(*schema.Set))
 	}
 
-	_, err = conn.UpdateBudgetAction(ctx, input)
+	_, err = tfresource.RetryWhenIsA[*awstypes.ResourceLockedException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		return conn.UpdateBudgetAction(ctx, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Budget Action (%s): %s", d.Id(), err)
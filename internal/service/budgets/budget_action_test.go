@@ -176,6 +176,24 @@ func TestAccBudgetsBudgetAction_disappears(t *testing.T) {
 	})
 }
 
+func TestAccBudgetsBudgetAction_definitionMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BudgetsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BudgetsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBudgetActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBudgetActionConfig_definitionMismatch(rName),
+				ExpectError: regexache.MustCompile(`action_type APPLY_SCP_POLICY requires definition.0.scp_action_definition`),
+			},
+		},
+	})
+}
+
 func testAccBudgetActionExists(ctx context.Context, resourceName string, config *awstypes.Action) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -325,3 +343,36 @@ EOF
 }
 `, rName, approvalModel, thresholdValue, acctest.DefaultEmailAddress)
 }
+
+func testAccBudgetActionConfig_definitionMismatch(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_budgets_budget_action" "test" {
+  budget_name        = %[1]q
+  action_type        = "APPLY_SCP_POLICY"
+  approval_model     = "AUTOMATIC"
+  notification_type  = "ACTUAL"
+  execution_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+
+  action_threshold {
+    action_threshold_type  = "ABSOLUTE_VALUE"
+    action_threshold_value = 100
+  }
+
+  definition {
+    iam_action_definition {
+      policy_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:policy/%[1]s"
+      roles      = [%[1]q]
+    }
+  }
+
+  subscriber {
+    address           = %[2]q
+    subscription_type = "EMAIL"
+  }
+}
+`, rName, acctest.DefaultEmailAddress)
+}
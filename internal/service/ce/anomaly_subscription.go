@@ -5,13 +5,17 @@ package ce
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -104,10 +108,27 @@ func resourceAnomalySubscription() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceAnomalySubscriptionCustomizeDiff,
+		),
 	}
 }
 
+func resourceAnomalySubscriptionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("threshold_expression") {
+		return nil
+	}
+
+	if v, ok := d.GetOk("threshold_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := validateThresholdExpression(expandExpression(v.([]interface{})[0].(map[string]interface{}))); err != nil {
+			return fmt.Errorf("threshold_expression: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CEClient(ctx)
@@ -235,7 +256,7 @@ func findAnomalySubscriptionByARN(ctx context.Context, conn *costexplorer.Client
 
 	output, err := conn.GetAnomalySubscriptions(ctx, input)
 
-	if errs.IsA[*awstypes.UnknownMonitorException](err) {
+	if errs.IsA[*awstypes.UnknownSubscriptionException](err) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -291,3 +312,69 @@ func flattenSubscribers(apiObjects []awstypes.Subscriber) []interface{} {
 
 	return tfList
 }
+
+// validateThresholdExpression validates an anomaly subscription threshold expression.
+// A threshold expression is either a single total impact dimension or the AND/OR of total impact dimensions.
+func validateThresholdExpression(apiObject *awstypes.Expression) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	if apiObject.CostCategories != nil || apiObject.Not != nil || apiObject.Tags != nil {
+		return errors.New("only dimension, and and or expressions are supported")
+	}
+
+	var children []awstypes.Expression
+	switch {
+	case len(apiObject.And) > 0 && len(apiObject.Or) > 0:
+		return errors.New("only one of and or or can be specified")
+	case len(apiObject.And) > 0:
+		children = apiObject.And
+	case len(apiObject.Or) > 0:
+		children = apiObject.Or
+	default:
+		return validateThresholdExpressionDimension(apiObject.Dimensions)
+	}
+
+	if apiObject.Dimensions != nil {
+		return errors.New("dimension cannot be combined with and or or")
+	}
+
+	for _, child := range children {
+		if child.And != nil || child.CostCategories != nil || child.Not != nil || child.Or != nil || child.Tags != nil {
+			return errors.New("and and or expressions must only contain dimension expressions")
+		}
+
+		if err := validateThresholdExpressionDimension(child.Dimensions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateThresholdExpressionDimension(apiObject *awstypes.DimensionValues) error {
+	if apiObject == nil {
+		return errors.New("dimension is required")
+	}
+
+	switch key := apiObject.Key; key {
+	case awstypes.DimensionAnomalyTotalImpactAbsolute, awstypes.DimensionAnomalyTotalImpactPercentage:
+	default:
+		return fmt.Errorf("dimension key must be one of %s or %s, got %q", awstypes.DimensionAnomalyTotalImpactAbsolute, awstypes.DimensionAnomalyTotalImpactPercentage, key)
+	}
+
+	if len(apiObject.MatchOptions) != 1 || apiObject.MatchOptions[0] != awstypes.MatchOptionGreaterThanOrEqual {
+		return fmt.Errorf("dimension %s match_options must be [%s]", apiObject.Key, awstypes.MatchOptionGreaterThanOrEqual)
+	}
+
+	if len(apiObject.Values) != 1 {
+		return fmt.Errorf("dimension %s must have exactly one value", apiObject.Key)
+	}
+
+	if _, err := strconv.ParseFloat(apiObject.Values[0], 64); err != nil {
+		return fmt.Errorf("dimension %s value (%s) must be a number", apiObject.Key, apiObject.Values[0])
+	}
+
+	return nil
+}
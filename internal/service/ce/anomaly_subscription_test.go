@@ -274,6 +274,34 @@ func TestAccCEAnomalySubscription_Tags(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_thresholdExpressionInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, "SERVICE", "GREATER_THAN_OR_EQUAL", "100.0"),
+				ExpectError: regexache.MustCompile(`dimension key must be one of`),
+			},
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, "ANOMALY_TOTAL_IMPACT_ABSOLUTE", "EQUALS", "100.0"),
+				ExpectError: regexache.MustCompile(`match_options must be`),
+			},
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, "ANOMALY_TOTAL_IMPACT_PERCENTAGE", "GREATER_THAN_OR_EQUAL", "ten"),
+				ExpectError: regexache.MustCompile(`must be a number`),
+			},
+		},
+	})
+}
+
 func testAccCheckAnomalySubscriptionExists(ctx context.Context, n string, v *awstypes.AnomalySubscription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -373,6 +401,32 @@ resource "aws_ce_anomaly_subscription" "test" {
 `, rName, address))
 }
 
+func testAccAnomalySubscriptionConfig_thresholdExpression(rName, address, key, matchOption, value string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    dimension {
+      key           = %[3]q
+      values        = [%[5]q]
+      match_options = [%[4]q]
+    }
+  }
+}
+`, rName, address, key, matchOption, value))
+}
+
 func testAccAnomalySubscriptionConfig_monitorARNList(rName, rName2, address string) string {
 	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
 resource "aws_ce_anomaly_monitor" "test2" {
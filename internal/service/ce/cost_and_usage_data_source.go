@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ce
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	costAndUsageRootElementSchemaLevel = 2
+)
+
+// GetCostAndUsage metric names. These differ from the values of the Metric enum.
+func costAndUsageMetric_Values() []string {
+	return []string{
+		"AmortizedCost",
+		"BlendedCost",
+		"NetAmortizedCost",
+		"NetUnblendedCost",
+		"NormalizedUsageAmount",
+		"UnblendedCost",
+		"UsageQuantity",
+	}
+}
+
+// @SDKDataSource("aws_ce_cost_and_usage", name="Cost And Usage")
+func dataSourceCostAndUsage() *schema.Resource {
+	metricSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"amount": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"metric": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"unit": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCostAndUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem:     expressionElem(costAndUsageRootElementSchemaLevel),
+			},
+			"granularity": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Granularity](),
+			},
+			"group_by": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.GroupDefinitionType](),
+						},
+					},
+				},
+			},
+			"metrics": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(costAndUsageMetric_Values(), false),
+				},
+			},
+			"results_by_time": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"estimated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"group": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keys": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric": metricSchema(),
+								},
+							},
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total": metricSchema(),
+					},
+				},
+			},
+			"time_period": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 40),
+						},
+						"start": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 40),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCostAndUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CEClient(ctx)
+
+	input := &costexplorer.GetCostAndUsageInput{
+		Granularity: awstypes.Granularity(d.Get("granularity").(string)),
+		Metrics:     flex.ExpandStringValueSet(d.Get("metrics").(*schema.Set)),
+		TimePeriod:  expandTagsTimePeriod(d.Get("time_period").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Filter = expandExpression(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("group_by"); ok && len(v.([]interface{})) > 0 {
+		input.GroupBy = expandGroupDefinitions(v.([]interface{}))
+	}
+
+	results, err := findCostAndUsage(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cost Explorer Cost And Usage: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	if err := d.Set("results_by_time", flattenResultsByTime(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results_by_time: %s", err)
+	}
+
+	return diags
+}
+
+func findCostAndUsage(ctx context.Context, conn *costexplorer.Client, input *costexplorer.GetCostAndUsageInput) ([]awstypes.ResultByTime, error) {
+	var output []awstypes.ResultByTime
+
+	for {
+		page, err := conn.GetCostAndUsage(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResultsByTime...)
+
+		if aws.ToString(page.NextPageToken) == "" {
+			break
+		}
+
+		input.NextPageToken = page.NextPageToken
+	}
+
+	return output, nil
+}
+
+func expandGroupDefinitions(tfList []interface{}) []awstypes.GroupDefinition {
+	var apiObjects []awstypes.GroupDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.GroupDefinition{
+			Key:  aws.String(tfMap["key"].(string)),
+			Type: awstypes.GroupDefinitionType(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenResultsByTime(apiObjects []awstypes.ResultByTime) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"estimated": apiObject.Estimated,
+			"group":     flattenGroups(apiObject.Groups),
+			"total":     flattenMetricValues(apiObject.Total),
+		}
+
+		if v := apiObject.TimePeriod; v != nil {
+			tfMap["end"] = aws.ToString(v.End)
+			tfMap["start"] = aws.ToString(v.Start)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGroups(apiObjects []awstypes.Group) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"keys":   apiObject.Keys,
+			"metric": flattenMetricValues(apiObject.Metrics),
+		})
+	}
+
+	return tfList
+}
+
+// flattenMetricValues flattens a map of metric name to value into a list sorted by metric name.
+func flattenMetricValues(apiObjects map[string]awstypes.MetricValue) []interface{} {
+	metrics := make([]string, 0, len(apiObjects))
+	for k := range apiObjects {
+		metrics = append(metrics, k)
+	}
+	sort.Strings(metrics)
+
+	tfList := make([]interface{}, 0, len(metrics))
+
+	for _, metric := range metrics {
+		apiObject := apiObjects[metric]
+
+		tfList = append(tfList, map[string]interface{}{
+			"amount": aws.ToString(apiObject.Amount),
+			"metric": metric,
+			"unit":   aws.ToString(apiObject.Unit),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ce_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCECostAndUsageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ce_cost_and_usage.test"
+
+	formatDate := "2006-01-02"
+	currentTime := time.Now()
+	startDate := currentTime.AddDate(0, 0, -3).Format(formatDate)
+	endDate := currentTime.AddDate(0, 0, -1).Format(formatDate)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostAndUsageDataSourceConfig_basic(startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.0.start", startDate),
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.0.total.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.0.total.0.metric", "UnblendedCost"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results_by_time.0.total.0.amount"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCECostAndUsageDataSource_groupBy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ce_cost_and_usage.test"
+
+	formatDate := "2006-01-02"
+	currentTime := time.Now()
+	startDate := currentTime.AddDate(0, 0, -3).Format(formatDate)
+	endDate := currentTime.AddDate(0, 0, -1).Format(formatDate)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostAndUsageDataSourceConfig_groupBy(startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results_by_time.0.group.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCostAndUsageDataSourceConfig_basic(start, end string) string {
+	return fmt.Sprintf(`
+data "aws_ce_cost_and_usage" "test" {
+  granularity = "DAILY"
+  metrics     = ["UnblendedCost"]
+
+  time_period {
+    start = %[1]q
+    end   = %[2]q
+  }
+}
+`, start, end)
+}
+
+func testAccCostAndUsageDataSourceConfig_groupBy(start, end string) string {
+	return fmt.Sprintf(`
+data "aws_ce_cost_and_usage" "test" {
+  granularity = "DAILY"
+  metrics     = ["UnblendedCost", "UsageQuantity"]
+
+  time_period {
+    start = %[1]q
+    end   = %[2]q
+  }
+
+  group_by {
+    type = "DIMENSION"
+    key  = "SERVICE"
+  }
+
+  filter {
+    not {
+      dimension {
+        key    = "RECORD_TYPE"
+        values = ["Credit", "Refund"]
+      }
+    }
+  }
+}
+`, start, end)
+}
@@ -24,6 +24,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceCostAndUsage,
+			TypeName: "aws_ce_cost_and_usage",
+			Name:     "Cost And Usage",
+		},
 		{
 			Factory:  dataSourceCostCategory,
 			TypeName: "aws_ce_cost_category",
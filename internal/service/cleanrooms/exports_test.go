@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+// Exports for use in tests only.
+var (
+	FindConfiguredTableAssociationByTwoPartKey = findConfiguredTableAssociationByTwoPartKey
+	FindMembershipByID                         = findMembershipByID
+	FindPrivacyBudgetTemplateByTwoPartKey      = findPrivacyBudgetTemplateByTwoPartKey
+)
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_privacy_budget_template")
+// @Tags(identifierAttribute="arn")
+func ResourcePrivacyBudgetTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePrivacyBudgetTemplateCreate,
+		ReadWithoutTimeout:   resourcePrivacyBudgetTemplateRead,
+		UpdateWithoutTimeout: resourcePrivacyBudgetTemplateUpdate,
+		DeleteWithoutTimeout: resourcePrivacyBudgetTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_refresh": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.PrivacyBudgetTemplateAutoRefresh](),
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"differential_privacy": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"epsilon": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 20),
+									},
+									"users_noise_per_query": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(10, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"privacy_budget_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          types.PrivacyBudgetTypeDifferentialPrivacy,
+				ValidateDiagFunc: enum.Validate[types.PrivacyBudgetType](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNamePrivacyBudgetTemplate = "Privacy Budget Template"
+
+	privacyBudgetTemplateResourceIDPartCount = 2
+)
+
+func resourcePrivacyBudgetTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID := d.Get("membership_id").(string)
+	input := &cleanrooms.CreatePrivacyBudgetTemplateInput{
+		AutoRefresh:          types.PrivacyBudgetTemplateAutoRefresh(d.Get("auto_refresh").(string)),
+		MembershipIdentifier: aws.String(membershipID),
+		PrivacyBudgetType:    types.PrivacyBudgetType(d.Get("privacy_budget_type").(string)),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Parameters = expandPrivacyBudgetTemplateParametersInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	out, err := conn.CreatePrivacyBudgetTemplate(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNamePrivacyBudgetTemplate, membershipID, err)
+	}
+
+	if out == nil || out.PrivacyBudgetTemplate == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNamePrivacyBudgetTemplate, membershipID, errors.New("empty output"))
+	}
+
+	id, err := flex.FlattenResourceId([]string{membershipID, aws.ToString(out.PrivacyBudgetTemplate.Id)}, privacyBudgetTemplateResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNamePrivacyBudgetTemplate, membershipID, err)
+	}
+	d.SetId(id)
+
+	return append(diags, resourcePrivacyBudgetTemplateRead(ctx, d, meta)...)
+}
+
+func resourcePrivacyBudgetTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), privacyBudgetTemplateResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNamePrivacyBudgetTemplate, d.Id(), err)
+	}
+
+	template, err := findPrivacyBudgetTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Privacy Budget Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNamePrivacyBudgetTemplate, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, template.Arn)
+	d.Set("auto_refresh", template.AutoRefresh)
+	d.Set("collaboration_arn", template.CollaborationArn)
+	d.Set("collaboration_id", template.CollaborationId)
+	d.Set("create_time", template.CreateTime.String())
+	d.Set("membership_arn", template.MembershipArn)
+	d.Set("membership_id", template.MembershipId)
+	if err := d.Set("parameters", flattenPrivacyBudgetTemplateParametersOutput(template.Parameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
+	d.Set("privacy_budget_type", template.PrivacyBudgetType)
+	d.Set("update_time", template.UpdateTime.String())
+
+	return diags
+}
+
+func resourcePrivacyBudgetTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		parts, err := flex.ExpandResourceId(d.Id(), privacyBudgetTemplateResourceIDPartCount, false)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNamePrivacyBudgetTemplate, d.Id(), err)
+		}
+
+		input := &cleanrooms.UpdatePrivacyBudgetTemplateInput{
+			MembershipIdentifier:            aws.String(parts[0]),
+			PrivacyBudgetTemplateIdentifier: aws.String(parts[1]),
+			PrivacyBudgetType:               types.PrivacyBudgetType(d.Get("privacy_budget_type").(string)),
+		}
+
+		if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Parameters = expandPrivacyBudgetTemplateUpdateParameters(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err = conn.UpdatePrivacyBudgetTemplate(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNamePrivacyBudgetTemplate, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePrivacyBudgetTemplateRead(ctx, d, meta)...)
+}
+
+func resourcePrivacyBudgetTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), privacyBudgetTemplateResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNamePrivacyBudgetTemplate, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Privacy Budget Template %s", d.Id())
+	_, err = conn.DeletePrivacyBudgetTemplate(ctx, &cleanrooms.DeletePrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(parts[0]),
+		PrivacyBudgetTemplateIdentifier: aws.String(parts[1]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNamePrivacyBudgetTemplate, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPrivacyBudgetTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, templateID string) (*types.PrivacyBudgetTemplate, error) {
+	in := &cleanrooms.GetPrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(membershipID),
+		PrivacyBudgetTemplateIdentifier: aws.String(templateID),
+	}
+
+	out, err := conn.GetPrivacyBudgetTemplate(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PrivacyBudgetTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.PrivacyBudgetTemplate, nil
+}
+
+func expandPrivacyBudgetTemplateParametersInput(tfMap map[string]interface{}) types.PrivacyBudgetTemplateParametersInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["differential_privacy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.PrivacyBudgetTemplateParametersInputMemberDifferentialPrivacy{
+			Value: types.DifferentialPrivacyTemplateParametersInput{
+				Epsilon:            aws.Int32(int32(tfMap["epsilon"].(int))),
+				UsersNoisePerQuery: aws.Int32(int32(tfMap["users_noise_per_query"].(int))),
+			},
+		}
+	}
+
+	return nil
+}
+
+func expandPrivacyBudgetTemplateUpdateParameters(tfMap map[string]interface{}) types.PrivacyBudgetTemplateUpdateParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["differential_privacy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.PrivacyBudgetTemplateUpdateParametersMemberDifferentialPrivacy{
+			Value: types.DifferentialPrivacyTemplateUpdateParameters{
+				Epsilon:            aws.Int32(int32(tfMap["epsilon"].(int))),
+				UsersNoisePerQuery: aws.Int32(int32(tfMap["users_noise_per_query"].(int))),
+			},
+		}
+	}
+
+	return nil
+}
+
+func flattenPrivacyBudgetTemplateParametersOutput(apiObject types.PrivacyBudgetTemplateParametersOutput) []interface{} {
+	switch v := apiObject.(type) {
+	case *types.PrivacyBudgetTemplateParametersOutputMemberDifferentialPrivacy:
+		tfMap := map[string]interface{}{
+			"differential_privacy": []interface{}{map[string]interface{}{
+				"epsilon":               aws.ToInt32(v.Value.Epsilon),
+				"users_noise_per_query": aws.ToInt32(v.Value.UsersNoisePerQuery),
+			}},
+		}
+		return []interface{}{tfMap}
+	default:
+		return nil
+	}
+}
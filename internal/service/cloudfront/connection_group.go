@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudfront_connection_group", name="Connection Group")
+// @Tags(identifierAttribute="arn")
+func resourceConnectionGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConnectionGroupCreate,
+		ReadWithoutTimeout:   resourceConnectionGroupRead,
+		UpdateWithoutTimeout: resourceConnectionGroupUpdate,
+		DeleteWithoutTimeout: resourceConnectionGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_deployment", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"anycast_ip_list_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"routing_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConnectionGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateConnectionGroupInput{
+		Enabled:     aws.Bool(d.Get("enabled").(bool)),
+		Ipv6Enabled: aws.Bool(d.Get("ipv6_enabled").(bool)),
+		Name:        aws.String(name),
+		Tags:        getTagsInV2(ctx),
+	}
+
+	if v, ok := d.GetOk("anycast_ip_list_id"); ok {
+		input.AnycastIpListId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateConnectionGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFront Connection Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ConnectionGroup.Id))
+
+	if d.Get("wait_for_deployment").(bool) {
+		if _, err := waitConnectionGroupDeployed(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Connection Group (%s) deploy: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConnectionGroupRead(ctx, d, meta)...)
+}
+
+func resourceConnectionGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	output, err := findConnectionGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Connection Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Connection Group (%s): %s", d.Id(), err)
+	}
+
+	connectionGroup := output.ConnectionGroup
+	d.Set("anycast_ip_list_id", connectionGroup.AnycastIpListId)
+	d.Set("arn", connectionGroup.Arn)
+	d.Set("enabled", connectionGroup.Enabled)
+	d.Set("etag", output.ETag)
+	d.Set("ipv6_enabled", connectionGroup.Ipv6Enabled)
+	d.Set("is_default", connectionGroup.IsDefault)
+	d.Set("name", connectionGroup.Name)
+	d.Set("routing_endpoint", connectionGroup.RoutingEndpoint)
+	d.Set("status", connectionGroup.Status)
+
+	return diags
+}
+
+func resourceConnectionGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all", "wait_for_deployment") {
+		input := &cloudfront.UpdateConnectionGroupInput{
+			Enabled:     aws.Bool(d.Get("enabled").(bool)),
+			Id:          aws.String(d.Id()),
+			IfMatch:     aws.String(d.Get("etag").(string)),
+			Ipv6Enabled: aws.Bool(d.Get("ipv6_enabled").(bool)),
+		}
+
+		if v, ok := d.GetOk("anycast_ip_list_id"); ok {
+			input.AnycastIpListId = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateConnectionGroup(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFront Connection Group (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("wait_for_deployment").(bool) {
+			if _, err := waitConnectionGroupDeployed(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Connection Group (%s) deploy: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceConnectionGroupRead(ctx, d, meta)...)
+}
+
+func resourceConnectionGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	// A connection group must be disabled and deployed before it can be deleted.
+	output, err := disableConnectionGroup(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling CloudFront Connection Group (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting CloudFront Connection Group: %s", d.Id())
+	_, err = conn.DeleteConnectionGroup(ctx, &cloudfront.DeleteConnectionGroupInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: output.ETag,
+	})
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Connection Group (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitConnectionGroupDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Connection Group (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// disableConnectionGroup disables the specified connection group, if necessary, and waits for it to be deployed.
+func disableConnectionGroup(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetConnectionGroupOutput, error) {
+	output, err := findConnectionGroupByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(output.ConnectionGroup.Status) == "InProgress" {
+		output, err = waitConnectionGroupDeployed(ctx, conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !aws.ToBool(output.ConnectionGroup.Enabled) {
+		return output, nil
+	}
+
+	input := &cloudfront.UpdateConnectionGroupInput{
+		AnycastIpListId: output.ConnectionGroup.AnycastIpListId,
+		Enabled:         aws.Bool(false),
+		Id:              aws.String(id),
+		IfMatch:         output.ETag,
+		Ipv6Enabled:     output.ConnectionGroup.Ipv6Enabled,
+	}
+
+	if _, err := conn.UpdateConnectionGroup(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return waitConnectionGroupDeployed(ctx, conn, id)
+}
+
+func findConnectionGroupByID(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetConnectionGroupOutput, error) {
+	input := &cloudfront.GetConnectionGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetConnectionGroup(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConnectionGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusConnectionGroup(ctx context.Context, conn *cloudfront.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findConnectionGroupByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.ConnectionGroup.Status), nil
+	}
+}
+
+func waitConnectionGroupDeployed(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetConnectionGroupOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"InProgress"},
+		Target:     []string{"Deployed"},
+		Refresh:    statusConnectionGroup(ctx, conn, id),
+		Timeout:    90 * time.Minute,
+		MinTimeout: 15 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetConnectionGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectionGroupDeleted(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetConnectionGroupOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"InProgress", "Deployed"},
+		Target:     []string{},
+		Refresh:    statusConnectionGroup(ctx, conn, id),
+		Timeout:    90 * time.Minute,
+		MinTimeout: 15 * time.Second,
+		Delay:      15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetConnectionGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,611 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudfront_distribution_tenant", name="Distribution Tenant")
+// @Tags(identifierAttribute="arn")
+func resourceDistributionTenant() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDistributionTenantCreate,
+		ReadWithoutTimeout:   resourceDistributionTenantRead,
+		UpdateWithoutTimeout: resourceDistributionTenantUpdate,
+		DeleteWithoutTimeout: resourceDistributionTenantDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_deployment", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"customizations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"geo_restriction": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"locations": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"restriction_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.GeoRestrictionType](),
+									},
+								},
+							},
+						},
+						"web_acl": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.CustomizationActionType](),
+									},
+									"arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"domains": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"managed_certificate_request": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_transparency_logging_preference": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.CertificateTransparencyLoggingPreference](),
+						},
+						"primary_domain_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"validation_token_host": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ValidationTokenHost](),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDistributionTenantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateDistributionTenantInput{
+		DistributionId: aws.String(d.Get("distribution_id").(string)),
+		Domains:        expandDomainItems(d.Get("domains").(*schema.Set).List()),
+		Enabled:        aws.Bool(d.Get("enabled").(bool)),
+		Name:           aws.String(name),
+		Tags:           getTagsInV2(ctx),
+	}
+
+	if v, ok := d.GetOk("connection_group_id"); ok {
+		input.ConnectionGroupId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customizations"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Customizations = expandCustomizations(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("managed_certificate_request"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManagedCertificateRequest = expandManagedCertificateRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("parameter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Parameters = expandParameters(v.(*schema.Set).List())
+	}
+
+	output, err := conn.CreateDistributionTenant(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFront Distribution Tenant (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.DistributionTenant.Id))
+
+	if d.Get("wait_for_deployment").(bool) {
+		if _, err := waitDistributionTenantDeployed(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Distribution Tenant (%s) deploy: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDistributionTenantRead(ctx, d, meta)...)
+}
+
+func resourceDistributionTenantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	output, err := findDistributionTenantByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Distribution Tenant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Distribution Tenant (%s): %s", d.Id(), err)
+	}
+
+	tenant := output.DistributionTenant
+	d.Set("arn", tenant.Arn)
+	d.Set("connection_group_id", tenant.ConnectionGroupId)
+	if err := d.Set("customizations", flattenCustomizations(tenant.Customizations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting customizations: %s", err)
+	}
+	d.Set("distribution_id", tenant.DistributionId)
+	d.Set("domains", flattenDomainResults(tenant.Domains))
+	d.Set("enabled", tenant.Enabled)
+	d.Set("etag", output.ETag)
+	d.Set("name", tenant.Name)
+	if err := d.Set("parameter", flattenParameters(tenant.Parameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
+	}
+	d.Set("status", tenant.Status)
+
+	return diags
+}
+
+func resourceDistributionTenantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all", "wait_for_deployment") {
+		input := &cloudfront.UpdateDistributionTenantInput{
+			DistributionId: aws.String(d.Get("distribution_id").(string)),
+			Domains:        expandDomainItems(d.Get("domains").(*schema.Set).List()),
+			Enabled:        aws.Bool(d.Get("enabled").(bool)),
+			Id:             aws.String(d.Id()),
+			IfMatch:        aws.String(d.Get("etag").(string)),
+			Parameters:     expandParameters(d.Get("parameter").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("connection_group_id"); ok {
+			input.ConnectionGroupId = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("customizations"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Customizations = expandCustomizations(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if d.HasChange("managed_certificate_request") {
+			if v, ok := d.GetOk("managed_certificate_request"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ManagedCertificateRequest = expandManagedCertificateRequest(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		_, err := conn.UpdateDistributionTenant(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFront Distribution Tenant (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("wait_for_deployment").(bool) {
+			if _, err := waitDistributionTenantDeployed(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Distribution Tenant (%s) deploy: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceDistributionTenantRead(ctx, d, meta)...)
+}
+
+func resourceDistributionTenantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
+
+	// A distribution tenant must be disabled and deployed before it can be deleted.
+	output, err := disableDistributionTenant(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling CloudFront Distribution Tenant (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting CloudFront Distribution Tenant: %s", d.Id())
+	_, err = conn.DeleteDistributionTenant(ctx, &cloudfront.DeleteDistributionTenantInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: output.ETag,
+	})
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Distribution Tenant (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDistributionTenantDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudFront Distribution Tenant (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// disableDistributionTenant disables the specified distribution tenant, if necessary, and waits for it to be deployed.
+func disableDistributionTenant(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionTenantOutput, error) {
+	output, err := findDistributionTenantByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(output.DistributionTenant.Status) == "InProgress" {
+		output, err = waitDistributionTenantDeployed(ctx, conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !aws.ToBool(output.DistributionTenant.Enabled) {
+		return output, nil
+	}
+
+	tenant := output.DistributionTenant
+	input := &cloudfront.UpdateDistributionTenantInput{
+		ConnectionGroupId: tenant.ConnectionGroupId,
+		Customizations:    tenant.Customizations,
+		DistributionId:    tenant.DistributionId,
+		Enabled:           aws.Bool(false),
+		Id:                aws.String(id),
+		IfMatch:           output.ETag,
+		Parameters:        tenant.Parameters,
+	}
+
+	for _, v := range tenant.Domains {
+		input.Domains = append(input.Domains, awstypes.DomainItem{Domain: v.Domain})
+	}
+
+	if _, err := conn.UpdateDistributionTenant(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return waitDistributionTenantDeployed(ctx, conn, id)
+}
+
+func findDistributionTenantByID(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionTenantOutput, error) {
+	input := &cloudfront.GetDistributionTenantInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDistributionTenant(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DistributionTenant == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDistributionTenant(ctx context.Context, conn *cloudfront.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDistributionTenantByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.DistributionTenant.Status), nil
+	}
+}
+
+func waitDistributionTenantDeployed(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionTenantOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"InProgress"},
+		Target:     []string{"Deployed"},
+		Refresh:    statusDistributionTenant(ctx, conn, id),
+		Timeout:    90 * time.Minute,
+		MinTimeout: 15 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetDistributionTenantOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDistributionTenantDeleted(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetDistributionTenantOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"InProgress", "Deployed"},
+		Target:     []string{},
+		Refresh:    statusDistributionTenant(ctx, conn, id),
+		Timeout:    90 * time.Minute,
+		MinTimeout: 15 * time.Second,
+		Delay:      15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudfront.GetDistributionTenantOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDomainItems(tfList []interface{}) []awstypes.DomainItem {
+	var apiObjects []awstypes.DomainItem
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, awstypes.DomainItem{
+			Domain: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDomainResults(apiObjects []awstypes.DomainResult) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, aws.ToString(apiObject.Domain))
+	}
+
+	return tfList
+}
+
+func expandCustomizations(tfMap map[string]interface{}) *awstypes.Customizations {
+	apiObject := &awstypes.Customizations{}
+
+	if v, ok := tfMap["certificate"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Certificate = &awstypes.Certificate{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["geo_restriction"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.GeoRestrictions = &awstypes.GeoRestrictionCustomization{
+			RestrictionType: awstypes.GeoRestrictionType(tfMap["restriction_type"].(string)),
+		}
+
+		if v, ok := tfMap["locations"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.GeoRestrictions.Locations = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	if v, ok := tfMap["web_acl"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.WebAcl = &awstypes.WebAclCustomization{
+			Action: awstypes.CustomizationActionType(tfMap["action"].(string)),
+		}
+
+		if v, ok := tfMap["arn"].(string); ok && v != "" {
+			apiObject.WebAcl.Arn = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenCustomizations(apiObject *awstypes.Customizations) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Certificate; v != nil {
+		tfMap["certificate"] = []interface{}{map[string]interface{}{
+			"arn": aws.ToString(v.Arn),
+		}}
+	}
+
+	if v := apiObject.GeoRestrictions; v != nil {
+		tfMap["geo_restriction"] = []interface{}{map[string]interface{}{
+			"locations":        v.Locations,
+			"restriction_type": string(v.RestrictionType),
+		}}
+	}
+
+	if v := apiObject.WebAcl; v != nil {
+		tfMap["web_acl"] = []interface{}{map[string]interface{}{
+			"action": string(v.Action),
+			"arn":    aws.ToString(v.Arn),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandManagedCertificateRequest(tfMap map[string]interface{}) *awstypes.ManagedCertificateRequest {
+	apiObject := &awstypes.ManagedCertificateRequest{
+		ValidationTokenHost: awstypes.ValidationTokenHost(tfMap["validation_token_host"].(string)),
+	}
+
+	if v, ok := tfMap["certificate_transparency_logging_preference"].(string); ok && v != "" {
+		apiObject.CertificateTransparencyLoggingPreference = awstypes.CertificateTransparencyLoggingPreference(v)
+	}
+
+	if v, ok := tfMap["primary_domain_name"].(string); ok && v != "" {
+		apiObject.PrimaryDomainName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandParameters(tfList []interface{}) []awstypes.Parameter {
+	var apiObjects []awstypes.Parameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.Parameter{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenParameters(apiObjects []awstypes.Parameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.ToString(apiObject.Name),
+			"value": aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
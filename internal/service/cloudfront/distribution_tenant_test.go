@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Distribution tenants belong to a multi-tenant distribution, which aws_cloudfront_distribution cannot create.
+// The tests use an existing multi-tenant distribution whose certificate covers subdomains of ACM_CERTIFICATE_ROOT_DOMAIN.
+const envVarMultiTenantDistributionID = "CLOUDFRONT_MULTI_TENANT_DISTRIBUTION_ID"
+
+func TestAccCloudFrontDistributionTenant_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	distributionID := acctest.SkipIfEnvVarNotSet(t, envVarMultiTenantDistributionID)
+	domain := acctest.ACMCertificateRandomSubDomain(acctest.ACMCertificateDomainFromEnv(t))
+	var v cloudfront.GetDistributionTenantOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_distribution_tenant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionTenantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionTenantConfig_basic(rName, distributionID, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionTenantExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "connection_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "customizations.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "distribution_id", distributionID),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "domains.*", domain),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Deployed"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_deployment"},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontDistributionTenant_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	distributionID := acctest.SkipIfEnvVarNotSet(t, envVarMultiTenantDistributionID)
+	domain := acctest.ACMCertificateRandomSubDomain(acctest.ACMCertificateDomainFromEnv(t))
+	var v cloudfront.GetDistributionTenantOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_distribution_tenant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionTenantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionTenantConfig_basic(rName, distributionID, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionTenantExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudfront.ResourceDistributionTenant(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontDistributionTenant_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	distributionID := acctest.SkipIfEnvVarNotSet(t, envVarMultiTenantDistributionID)
+	domain := acctest.ACMCertificateRandomSubDomain(acctest.ACMCertificateDomainFromEnv(t))
+	var v cloudfront.GetDistributionTenantOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_distribution_tenant.test"
+	connectionGroupResourceName := "aws_cloudfront_connection_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionTenantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionTenantConfig_basic(rName, distributionID, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionTenantExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccDistributionTenantConfig_updated(rName, distributionID, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionTenantExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "connection_group_id", connectionGroupResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "customizations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "customizations.0.geo_restriction.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "customizations.0.geo_restriction.0.restriction_type", "whitelist"),
+					resource.TestCheckResourceAttr(resourceName, "customizations.0.geo_restriction.0.locations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_deployment"},
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionTenantDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfront_distribution_tenant" {
+				continue
+			}
+
+			_, err := tfcloudfront.FindDistributionTenantByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudFront Distribution Tenant %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDistributionTenantExists(ctx context.Context, n string, v *cloudfront.GetDistributionTenantOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		output, err := tfcloudfront.FindDistributionTenantByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDistributionTenantConfig_basic(rName, distributionID, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution_tenant" "test" {
+  name            = %[1]q
+  distribution_id = %[2]q
+  domains         = [%[3]q]
+}
+`, rName, distributionID, domain)
+}
+
+func testAccDistributionTenantConfig_updated(rName, distributionID, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_connection_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudfront_distribution_tenant" "test" {
+  name                = %[1]q
+  distribution_id     = %[2]q
+  domains             = [%[3]q]
+  connection_group_id = aws_cloudfront_connection_group.test.id
+  enabled             = false
+
+  customizations {
+    geo_restriction {
+      restriction_type = "whitelist"
+      locations        = ["US", "CA"]
+    }
+  }
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, rName, distributionID, domain)
+}
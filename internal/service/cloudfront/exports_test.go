@@ -5,11 +5,15 @@ package cloudfront
 
 // Exports for use in tests only.
 var (
+	ResourceConnectionGroup            = resourceConnectionGroup
 	ResourceContinuousDeploymentPolicy = newResourceContinuousDeploymentPolicy
+	ResourceDistributionTenant         = resourceDistributionTenant
 	ResourceFunction                   = resourceFunction
 	ResourceKeyValueStore              = newKeyValueStoreResource
 
-	FindFunctionByTwoPartKey = findFunctionByTwoPartKey
-	FindKeyValueStoreByName  = findKeyValueStoreByName
-	FindPublicKeyByID        = findPublicKeyByID
+	FindConnectionGroupByID    = findConnectionGroupByID
+	FindDistributionTenantByID = findDistributionTenantByID
+	FindFunctionByTwoPartKey   = findFunctionByTwoPartKey
+	FindKeyValueStoreByName    = findKeyValueStoreByName
+	FindPublicKeyByID          = findPublicKeyByID
 )
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	defaultOriginAccessControlPolicyStatementSid = "AllowCloudFrontServicePrincipal"
+)
+
+// @SDKDataSource("aws_cloudfront_origin_access_control_policy_statement", name="Origin Access Control Policy Statement")
+func dataSourceOriginAccessControlPolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceOriginAccessControlPolicyStatementRead,
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"bucket_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"distribution_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin_access_control_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sid": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  defaultOriginAccessControlPolicyStatementSid,
+			},
+		},
+	}
+}
+
+func dataSourceOriginAccessControlPolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	id := d.Get("origin_access_control_id").(string)
+	output, err := findOriginAccessControlByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFront Origin Access Control (%s): %s", id, err)
+	}
+
+	if originType := aws.StringValue(output.OriginAccessControl.OriginAccessControlConfig.OriginAccessControlOriginType); originType != cloudfront.OriginAccessControlOriginTypesS3 {
+		return sdkdiag.AppendErrorf(diags, "CloudFront Origin Access Control (%s) origin type is %s, not %s", id, originType, cloudfront.OriginAccessControlOriginTypesS3)
+	}
+
+	var actions interface{} = "s3:GetObject"
+	if v, ok := d.GetOk("actions"); ok && v.(*schema.Set).Len() > 0 {
+		v := flex.ExpandStringValueSet(v.(*schema.Set))
+		slices.Sort(v)
+		actions = v
+	}
+
+	doc := &tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Sid:       d.Get("sid").(string),
+				Effect:    "Allow",
+				Actions:   actions,
+				Resources: d.Get("bucket_arn").(string) + "/*",
+				Principals: tfiam.IAMPolicyStatementPrincipalSet{
+					{
+						Type:        "Service",
+						Identifiers: "cloudfront.amazonaws.com",
+					},
+				},
+				Conditions: tfiam.IAMPolicyStatementConditionSet{
+					{
+						Test:     "StringEquals",
+						Variable: "AWS:SourceArn",
+						Values:   d.Get("distribution_arn").(string),
+					},
+				},
+			},
+		},
+	}
+
+	b, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing CloudFront Origin Access Control (%s) policy statement: %s", id, err)
+	}
+
+	jsonString := string(b)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("json", jsonString)
+
+	return diags
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontOriginAccessControlPolicyStatementDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudfront_origin_access_control_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginAccessControlDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginAccessControlPolicyStatementDataSourceConfig_basic(rName, "s3"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowCloudFrontServicePrincipal",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:%[1]s:s3:::%[2]s/*",
+      "Principal": {
+        "Service": "cloudfront.amazonaws.com"
+      },
+      "Condition": {
+        "StringEquals": {
+          "AWS:SourceArn": "arn:%[1]s:cloudfront::123456789012:distribution/EDFDVBD6EXAMPLE"
+        }
+      }
+    }
+  ]
+}`, acctest.Partition(), rName)),
+				),
+			},
+			{
+				Config:      testAccOriginAccessControlPolicyStatementDataSourceConfig_basic(rName, "mediastore"),
+				ExpectError: regexache.MustCompile(`origin type is mediastore, not s3`),
+			},
+		},
+	})
+}
+
+func testAccOriginAccessControlPolicyStatementDataSourceConfig_basic(rName, originType string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_cloudfront_origin_access_control" "test" {
+  name                              = %[1]q
+  origin_access_control_origin_type = %[2]q
+  signing_behavior                  = "always"
+  signing_protocol                  = "sigv4"
+}
+
+data "aws_cloudfront_origin_access_control_policy_statement" "test" {
+  bucket_arn               = aws_s3_bucket.test.arn
+  distribution_arn         = "arn:${data.aws_partition.current.partition}:cloudfront::123456789012:distribution/EDFDVBD6EXAMPLE"
+  origin_access_control_id = aws_cloudfront_origin_access_control.test.id
+}
+`, rName, originType)
+}
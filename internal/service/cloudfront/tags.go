@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !generate
+// +build !generate
+
+package cloudfront
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+)
+
+// getTagsInV2 returns cloudfront service tags from Context for AWS SDK for Go v2 inputs.
+func getTagsInV2(ctx context.Context) *awstypes.Tags {
+	tags := getTagsIn(ctx)
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObject := &awstypes.Tags{}
+
+	for _, tag := range tags {
+		apiObject.Items = append(apiObject.Items, awstypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+
+	return apiObject
+}
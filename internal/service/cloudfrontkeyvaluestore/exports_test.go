@@ -5,7 +5,9 @@ package cloudfrontkeyvaluestore
 
 // Exports for use in tests only.
 var (
-	ResourceKey = newKeyResource
+	ResourceKey  = newKeyResource
+	ResourceKeys = newKeysResource
 
 	FindKeyByTwoPartKey = findKeyByTwoPartKey
+	FindKeysByARN       = findKeysByARN
 )
@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of puts and deletes in a single UpdateKeys request.
+	updateKeysMaxItems = 50
+)
+
+// @FrameworkResource(name="Keys")
+func newKeysResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &keysResource{}
+
+	return r, nil
+}
+
+type keysResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*keysResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfrontkeyvaluestore_keys"
+}
+
+func (r *keysResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"key_value_store_arn": schema.StringAttribute{
+				CustomType:          fwtypes.ARNType,
+				Required:            true,
+				MarkdownDescription: "The Amazon Resource Name (ARN) of the Key Value Store.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total size of the Key Value Store in bytes.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_key_value_pair": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[keyValuePairModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The key to put.",
+						},
+						"value": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The value to put.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *keysResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data keysResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.KvsARN.ValueString()
+	pairs, diags := data.KeyValuePairs.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var puts []awstypes.PutKeyRequestListItem
+	for _, v := range pairs {
+		puts = append(puts, awstypes.PutKeyRequestListItem{
+			Key:   fwflex.StringFromFramework(ctx, v.Key),
+			Value: fwflex.StringFromFramework(ctx, v.Value),
+		})
+	}
+
+	output, err := updateKeys(ctx, conn, kvsARN, puts, nil)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront KeyValueStore (%s) Keys", kvsARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(kvsARN)
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *keysResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data keysResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.ID.ValueString()
+	kvs, err := findKeyValueStoreByARN(ctx, conn, kvsARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore (%s)", kvsARN), err.Error())
+
+		return
+	}
+
+	items, err := findKeysByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront KeyValueStore (%s) Keys", kvsARN), err.Error())
+
+		return
+	}
+
+	var managed map[string]struct{}
+	if !data.KeyValuePairs.IsNull() {
+		pairs, diags := data.KeyValuePairs.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		managed = make(map[string]struct{}, len(pairs))
+		for _, v := range pairs {
+			managed[v.Key.ValueString()] = struct{}{}
+		}
+	}
+
+	// Only keys managed by this resource are tracked. On import all keys are.
+	var pairs []*keyValuePairModel
+	for _, v := range items {
+		if managed != nil {
+			if _, ok := managed[aws.ToString(v.Key)]; !ok {
+				continue
+			}
+		}
+
+		pairs = append(pairs, &keyValuePairModel{
+			Key:   fwflex.StringToFramework(ctx, v.Key),
+			Value: fwflex.StringToFramework(ctx, v.Value),
+		})
+	}
+
+	if len(pairs) == 0 {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(tfresource.NewEmptyResultError(kvsARN)))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	v, diags := fwtypes.NewSetNestedObjectValueOfSlice(ctx, pairs)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.KeyValuePairs = v
+
+	// Set attributes for import.
+	data.KvsARN, diags = fwtypes.ARNValue(kvsARN)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, kvs.TotalSizeInBytes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *keysResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new keysResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	if !new.KeyValuePairs.Equal(old.KeyValuePairs) {
+		kvsARN := new.KvsARN.ValueString()
+
+		oldPairs, diags := old.KeyValuePairs.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		newPairs, diags := new.KeyValuePairs.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		oldValues := make(map[string]string, len(oldPairs))
+		for _, v := range oldPairs {
+			oldValues[v.Key.ValueString()] = v.Value.ValueString()
+		}
+
+		var puts []awstypes.PutKeyRequestListItem
+		for _, v := range newPairs {
+			key, value := v.Key.ValueString(), v.Value.ValueString()
+			if oldValue, ok := oldValues[key]; !ok || oldValue != value {
+				puts = append(puts, awstypes.PutKeyRequestListItem{
+					Key:   aws.String(key),
+					Value: aws.String(value),
+				})
+			}
+			delete(oldValues, key)
+		}
+
+		var deletes []awstypes.DeleteKeyRequestListItem
+		for key := range oldValues {
+			deletes = append(deletes, awstypes.DeleteKeyRequestListItem{
+				Key: aws.String(key),
+			})
+		}
+
+		output, err := updateKeys(ctx, conn, kvsARN, puts, deletes)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront KeyValueStore (%s) Keys", kvsARN), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		if output != nil {
+			new.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+		}
+	} else {
+		new.TotalSizeInBytes = old.TotalSizeInBytes
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *keysResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data keysResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	kvsARN := data.ID.ValueString()
+	pairs, diags := data.KeyValuePairs.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var deletes []awstypes.DeleteKeyRequestListItem
+	for _, v := range pairs {
+		deletes = append(deletes, awstypes.DeleteKeyRequestListItem{
+			Key: fwflex.StringFromFramework(ctx, v.Key),
+		})
+	}
+
+	_, err := updateKeys(ctx, conn, kvsARN, nil, deletes)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront KeyValueStore (%s) Keys", kvsARN), err.Error())
+
+		return
+	}
+}
+
+// updateKeys puts and deletes keys in batches of at most 50 changes.
+// Returns the output of the last request, or nil if there were no changes.
+func updateKeys(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN string, puts []awstypes.PutKeyRequestListItem, deletes []awstypes.DeleteKeyRequestListItem) (*cloudfrontkeyvaluestore.UpdateKeysOutput, error) {
+	// Updating keys changes the etag of the key value store.
+	// Use a mutex serialize actions
+	mutexKey := kvsARN
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	etag, err := findETagByARN(ctx, conn, kvsARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading ETag: %w", err)
+	}
+
+	var output *cloudfrontkeyvaluestore.UpdateKeysOutput
+	for len(puts) > 0 || len(deletes) > 0 {
+		input := &cloudfrontkeyvaluestore.UpdateKeysInput{
+			IfMatch: etag,
+			KvsARN:  aws.String(kvsARN),
+		}
+
+		n := min(len(deletes), updateKeysMaxItems)
+		input.Deletes, deletes = deletes[:n], deletes[n:]
+		n = min(len(puts), updateKeysMaxItems-n)
+		input.Puts, puts = puts[:n], puts[n:]
+
+		output, err = conn.UpdateKeys(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		etag = output.ETag
+	}
+
+	return output, nil
+}
+
+func findKeyValueStoreByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, arn string) (*cloudfrontkeyvaluestore.DescribeKeyValueStoreOutput, error) {
+	input := &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+		KvsARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeKeyValueStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findKeysByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, arn string) ([]awstypes.ListKeysResponseListItem, error) {
+	input := &cloudfrontkeyvaluestore.ListKeysInput{
+		KvsARN: aws.String(arn),
+	}
+	var output []awstypes.ListKeysResponseListItem
+
+	pages := cloudfrontkeyvaluestore.NewListKeysPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type keysResourceModel struct {
+	ID               types.String                                      `tfsdk:"id"`
+	KeyValuePairs    fwtypes.SetNestedObjectValueOf[keyValuePairModel] `tfsdk:"resource_key_value_pair"`
+	KvsARN           fwtypes.ARN                                       `tfsdk:"key_value_store_arn"`
+	TotalSizeInBytes types.Int64                                       `tfsdk:"total_size_in_bytes"`
+}
+
+type keyValuePairModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
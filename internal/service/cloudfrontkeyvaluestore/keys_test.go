@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfrontkeyvaluestore "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfrontkeyvaluestore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontKeyValueStoreKeys_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeysDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysConfig_basic(rName, 60, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExists(ctx, resourceName, 60),
+					resource.TestCheckResourceAttrPair(resourceName, "id", "aws_cloudfront_key_value_store.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_value_store_arn", "aws_cloudfront_key_value_store.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", "60"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key_value_pair.*", map[string]string{
+						"key":   "key0",
+						"value": "value1",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "total_size_in_bytes"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKeysConfig_basic(rName, 2, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "resource_key_value_pair.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key_value_pair.*", map[string]string{
+						"key":   "key1",
+						"value": "value2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontKeyValueStoreKeys_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfrontkeyvaluestore_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CloudFront)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFront),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeysDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeysConfig_basic(rName, 2, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeysExists(ctx, resourceName, 2),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcloudfrontkeyvaluestore.ResourceKeys, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKeysDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfrontkeyvaluestore_keys" {
+				continue
+			}
+
+			output, err := tfcloudfrontkeyvaluestore.FindKeysByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("CloudFront KeyValueStore %s still has %d keys", rs.Primary.ID, len(output))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeysExists(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontKeyValueStoreClient(ctx)
+
+		output, err := tfcloudfrontkeyvaluestore.FindKeysByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != count {
+			return fmt.Errorf("CloudFront KeyValueStore %s has %d keys, want %d", rs.Primary.ID, got, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccKeysConfig_basic(rName string, count int, value string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudfrontkeyvaluestore_keys" "test" {
+  key_value_store_arn = aws_cloudfront_key_value_store.test.arn
+
+  dynamic "resource_key_value_pair" {
+    for_each = range(%[2]d)
+
+    content {
+      key   = "key${resource_key_value_pair.value}"
+      value = %[3]q
+    }
+  }
+}
+`, rName, count, value)
+}
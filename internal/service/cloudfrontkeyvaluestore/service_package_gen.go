@@ -24,6 +24,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newKeyResource,
 			Name:    "Key",
 		},
+		{
+			Factory: newKeysResource,
+			Name:    "Keys",
+		},
 	}
 }
 
@@ -5,11 +5,13 @@ package cloudwatch
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,8 +35,17 @@ func resourceDashboard() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		// Note that we specify both the `dashboard_body` and
-		// the `dashboard_name` as being required, even though
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			// When the dashboard is composed from `widget` blocks the body is derived from them.
+			if diff.HasChange("widget") && len(diff.Get("widget").([]interface{})) > 0 {
+				return diff.SetNewComputed("dashboard_body")
+			}
+
+			return nil
+		},
+
+		// Note that we specify the `dashboard_name` and one of
+		// `dashboard_body` or `widget` as being required, even though
 		// according to the REST API documentation both are
 		// optional: http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_PutDashboard.html#API_PutDashboard_RequestParameters
 		Schema: map[string]*schema.Schema{
@@ -44,7 +55,9 @@ func resourceDashboard() *schema.Resource {
 			},
 			"dashboard_body": {
 				Type:                  schema.TypeString,
-				Required:              true,
+				Optional:              true,
+				Computed:              true,
+				ExactlyOneOf:          []string{"dashboard_body", "widget"},
 				ValidateFunc:          validation.StringIsJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
 				DiffSuppressOnRefresh: true,
@@ -59,6 +72,53 @@ func resourceDashboard() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validDashboardName,
 			},
+			"widget": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"dashboard_body", "widget"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"properties": {
+							Type:                  schema.TypeString,
+							Required:              true,
+							ValidateFunc:          validation.StringIsJSON,
+							DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+							DiffSuppressOnRefresh: true,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(dashboardWidgetType_Values(), false),
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, 24),
+						},
+						"x": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"y": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -73,6 +133,16 @@ func resourceDashboardPut(ctx context.Context, d *schema.ResourceData, meta inte
 		DashboardName: aws.String(name),
 	}
 
+	if v, ok := d.GetOk("widget"); ok && len(v.([]interface{})) > 0 {
+		body, err := expandDashboardBody(v.([]interface{}), d.GetRawConfig().GetAttr("widget"))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.DashboardBody = aws.String(body)
+	}
+
 	_, err := conn.PutDashboard(ctx, input)
 
 	if err != nil {
@@ -105,6 +175,17 @@ func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("dashboard_arn", output.DashboardArn)
 	d.Set("dashboard_body", output.DashboardBody)
 	d.Set("dashboard_name", output.DashboardName)
+	if v, ok := d.GetOk("widget"); ok && len(v.([]interface{})) > 0 {
+		widgets, err := flattenDashboardBody(aws.ToString(output.DashboardBody))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := d.Set("widget", widgets); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting widget: %s", err)
+		}
+	}
 
 	return diags
 }
@@ -149,3 +230,133 @@ func findDashboardByName(ctx context.Context, conn *cloudwatch.Client, name stri
 
 	return output, nil
 }
+
+const (
+	dashboardWidgetTypeAlarm    = "alarm"
+	dashboardWidgetTypeCustom   = "custom"
+	dashboardWidgetTypeExplorer = "explorer"
+	dashboardWidgetTypeLog      = "log"
+	dashboardWidgetTypeMetric   = "metric"
+	dashboardWidgetTypeText     = "text"
+)
+
+func dashboardWidgetType_Values() []string {
+	return []string{
+		dashboardWidgetTypeAlarm,
+		dashboardWidgetTypeCustom,
+		dashboardWidgetTypeExplorer,
+		dashboardWidgetTypeLog,
+		dashboardWidgetTypeMetric,
+		dashboardWidgetTypeText,
+	}
+}
+
+// dashboardBody is the subset of the dashboard body structure that can be expressed with `widget` blocks.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+type dashboardBody struct {
+	Widgets []dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Height     int             `json:"height"`
+	Properties json.RawMessage `json:"properties"`
+	Type       string          `json:"type"`
+	Width      int             `json:"width"`
+	X          *int            `json:"x,omitempty"`
+	Y          *int            `json:"y,omitempty"`
+}
+
+// expandDashboardBody builds a dashboard body from `widget` blocks.
+// The raw configuration is used to omit unconfigured widget coordinates so that CloudWatch lays those widgets out automatically.
+func expandDashboardBody(tfList []interface{}, rawConfig cty.Value) (string, error) {
+	apiObject := dashboardBody{
+		Widgets: []dashboardWidget{},
+	}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		widget := dashboardWidget{
+			Height:     tfMap["height"].(int),
+			Properties: json.RawMessage(tfMap["properties"].(string)),
+			Type:       tfMap["type"].(string),
+			Width:      tfMap["width"].(int),
+		}
+
+		var rawWidget cty.Value
+		if rawConfig.IsKnown() && !rawConfig.IsNull() && rawConfig.LengthInt() > i {
+			rawWidget = rawConfig.Index(cty.NumberIntVal(int64(i)))
+		}
+
+		if v := tfMap["x"].(int); v != 0 || isDashboardWidgetAttrConfigured(rawWidget, "x") {
+			widget.X = aws.Int(v)
+		}
+
+		if v := tfMap["y"].(int); v != 0 || isDashboardWidgetAttrConfigured(rawWidget, "y") {
+			widget.Y = aws.Int(v)
+		}
+
+		apiObject.Widgets = append(apiObject.Widgets, widget)
+	}
+
+	body, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+func isDashboardWidgetAttrConfigured(rawWidget cty.Value, name string) bool {
+	if rawWidget.IsNull() || !rawWidget.IsKnown() {
+		return false
+	}
+
+	v := rawWidget.GetAttr(name)
+
+	return v.IsKnown() && !v.IsNull()
+}
+
+func flattenDashboardBody(body string) ([]interface{}, error) {
+	var apiObject dashboardBody
+
+	if err := json.Unmarshal([]byte(body), &apiObject); err != nil {
+		return nil, err
+	}
+
+	var tfList []interface{}
+
+	for _, widget := range apiObject.Widgets {
+		tfMap := map[string]interface{}{
+			"height": widget.Height,
+			"type":   widget.Type,
+			"width":  widget.Width,
+		}
+
+		if v := widget.Properties; len(v) > 0 {
+			properties, err := structure.NormalizeJsonString(string(v))
+
+			if err != nil {
+				return nil, err
+			}
+
+			tfMap["properties"] = properties
+		}
+
+		if v := widget.X; v != nil {
+			tfMap["x"] = aws.ToInt(v)
+		}
+
+		if v := widget.Y; v != nil {
+			tfMap["y"] = aws.ToInt(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
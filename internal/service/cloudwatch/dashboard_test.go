@@ -54,6 +54,59 @@ func TestAccCloudWatchDashboard_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchDashboard_widget(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_widget(rName, "Hi there from Terraform: CloudWatch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_body"),
+					resource.TestCheckResourceAttr(resourceName, "widget.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.type", "text"),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.x", "0"),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.y", "0"),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.width", "24"),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.height", "2"),
+					resource.TestCheckResourceAttr(resourceName, "widget.1.type", "metric"),
+					resource.TestCheckResourceAttr(resourceName, "widget.1.width", "6"),
+					resource.TestCheckResourceAttr(resourceName, "widget.1.height", "6"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"widget"},
+			},
+			{
+				Config: testAccDashboardConfig_widget(rName, "Hi there from Terraform: CloudWatch - updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "widget.#", "2"),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_basic(rName, basicWidget),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "widget.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchDashboard_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dashboard cloudwatch.GetDashboardOutput
@@ -168,3 +221,39 @@ EOF
 }
 `, rName, body)
 }
+
+func testAccDashboardConfig_widget(rName, markdown string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+
+  widget {
+    type   = "text"
+    x      = 0
+    y      = 0
+    width  = 24
+    height = 2
+
+    properties = jsonencode({
+      markdown = %[2]q
+    })
+  }
+
+  widget {
+    type = "metric"
+
+    properties = jsonencode({
+      metrics = [
+        ["AWS/EC2", "CPUUtilization"],
+      ]
+      period = 300
+      region = data.aws_region.current.name
+      stat   = "Average"
+      title  = "EC2 CPU"
+    })
+  }
+}
+`, rName, markdown)
+}
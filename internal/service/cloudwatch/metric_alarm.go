@@ -143,9 +143,12 @@ func resourceMetricAlarm() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(1, 255),
 						},
 						"expression": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1024),
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 2048),
+								validMetricDataQueryExpression,
+							),
 						},
 						"id": {
 							Type:         schema.TypeString,
@@ -308,6 +311,12 @@ func resourceMetricAlarm() *schema.Resource {
 									return errors.New("No metric_query may have both `expression` and a `metric` specified")
 								}
 							}
+
+							if isMetricsInsightsQuery(v.(string)) {
+								if v, ok := tfMap["period"].(int); !ok || v == 0 {
+									return errors.New("A metric_query with a Metrics Insights `expression` must specify `period`")
+								}
+							}
 						}
 					}
 				}
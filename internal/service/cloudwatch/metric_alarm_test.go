@@ -431,6 +431,14 @@ func TestAccCloudWatchMetricAlarm_metricQuery(t *testing.T) {
 				Config:      testAccMetricAlarmConfig_badMetricQuery(rName),
 				ExpectError: regexache.MustCompile("No metric_query may have both `expression` and a `metric` specified"),
 			},
+			{
+				Config:      testAccMetricAlarmConfig_metricQueryExpressionQueryInvalid(rName),
+				ExpectError: regexache.MustCompile(`is not a valid Metrics Insights query`),
+			},
+			{
+				Config:      testAccMetricAlarmConfig_metricQueryExpressionQueryNoPeriod(rName),
+				ExpectError: regexache.MustCompile("A metric_query with a Metrics Insights `expression` must specify `period`"),
+			},
 			{
 				Config: testAccMetricAlarmConfig_metricQueryExpressionQuery(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -947,6 +955,41 @@ resource "aws_cloudwatch_metric_alarm" "test" {
 `, rName)
 }
 
+func testAccMetricAlarmConfig_metricQueryExpressionQueryInvalid(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 3
+  threshold           = 30000
+
+  metric_query {
+    id          = "m1"
+    expression  = "SELECT MillisBehindLatest FROM SCHEMA(\"foo\", Operation, ShardId)"
+    period      = 60
+    return_data = true
+  }
+}
+`, rName)
+}
+
+func testAccMetricAlarmConfig_metricQueryExpressionQueryNoPeriod(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 3
+  threshold           = 30000
+
+  metric_query {
+    id          = "m1"
+    expression  = "SELECT MAX(MillisBehindLatest) FROM SCHEMA(\"foo\", Operation, ShardId)"
+    return_data = true
+  }
+}
+`, rName)
+}
+
 func testAccMetricAlarmConfig_metricQueryCrossAccount(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -5,6 +5,7 @@ package cloudwatch
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -47,7 +49,10 @@ func resourceMetricStream() *schema.Resource {
 			Delete: schema.DefaultTimeout(2 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			validateMetricStreamStatisticsConfigurationOutputFormat,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -292,7 +297,7 @@ func resourceMetricStreamRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	d.Set("include_linked_accounts_metrics", output.IncludeLinkedAccountsMetrics)
-	d.Set("last_update_date", output.CreationDate.Format(time.RFC3339))
+	d.Set("last_update_date", output.LastUpdateDate.Format(time.RFC3339))
 	d.Set("name", output.Name)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.ToString(output.Name)))
 	d.Set("output_format", output.OutputFormat)
@@ -453,6 +458,39 @@ func validateMetricStreamName(v interface{}, k string) (ws []string, errors []er
 	)(v, k)
 }
 
+// validateMetricStreamStatisticsConfigurationOutputFormat ensures that only percentile
+// statistics are requested when streaming in an OpenTelemetry output format.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_MetricStreamStatisticsConfiguration.html.
+func validateMetricStreamStatisticsConfigurationOutputFormat(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	outputFormat := types.MetricStreamOutputFormat(diff.Get("output_format").(string))
+
+	if outputFormat == types.MetricStreamOutputFormatJson || outputFormat == "" {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("statistics_configuration").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["additional_statistics"].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, v := range flex.ExpandStringValueSet(v) {
+			if !metricStreamPercentileStatisticRegex.MatchString(v) {
+				return fmt.Errorf("additional statistic %q is not supported with output format %q, only percentile statistics (e.g. p99) are supported", v, outputFormat)
+			}
+		}
+	}
+
+	return nil
+}
+
+var metricStreamPercentileStatisticRegex = regexache.MustCompile(`^p(\d{1,2})(\.\d{0,10})?$`)
+
 func expandMetricStreamFilters(tfList []interface{}) []types.MetricStreamFilter {
 	var apiObjects []types.MetricStreamFilter
 
@@ -5,6 +5,7 @@ package cloudwatch
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 )
@@ -40,3 +41,36 @@ func validEC2AutomateARN(v interface{}, k string) (ws []string, errors []error)
 
 	return
 }
+
+var (
+	metricsInsightsQueryPrefixRegex = regexache.MustCompile(`(?i)^\s*SELECT\s`)
+	metricsInsightsQueryRegex       = regexache.MustCompile(`(?is)^\s*SELECT\s+(AVG|COUNT|MAX|MIN|SUM)\s*\(\s*("[^"]+"|[^\s()"]+)\s*\)` +
+		`\s+FROM\s+(SCHEMA\s*\([^()]+\)|"[^"]+"|[^\s()"]+)` +
+		`(\s+WHERE\s+.+?)?` +
+		`(\s+GROUP\s+BY\s+.+?)?` +
+		`(\s+ORDER\s+BY\s+(AVG|COUNT|MAX|MIN|SUM)\s*\(\s*\)(\s+(ASC|DESC))?)?` +
+		`(\s+LIMIT\s+\d+)?\s*$`)
+)
+
+// isMetricsInsightsQuery returns whether a metric data query expression is a Metrics Insights (SQL) query
+// as opposed to a metric math expression.
+func isMetricsInsightsQuery(expression string) bool {
+	return metricsInsightsQueryPrefixRegex.MatchString(expression)
+}
+
+func validMetricDataQueryExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !isMetricsInsightsQuery(value) {
+		return
+	}
+
+	// https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/cloudwatch-metrics-insights-querylanguage.html
+	if !metricsInsightsQueryRegex.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q is not a valid Metrics Insights query, expected SELECT FUNCTION(metricName) FROM namespace | SCHEMA(...) [WHERE ...] [GROUP BY ...] [ORDER BY FUNCTION() [ASC | DESC]] [LIMIT number]: %q",
+			k, strings.TrimSpace(value)))
+	}
+
+	return
+}
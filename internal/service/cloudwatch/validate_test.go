@@ -72,3 +72,38 @@ func TestValidEC2AutomateARN(t *testing.T) {
 		}
 	}
 }
+
+func TestValidMetricDataQueryExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		"m1 / m2 * 100",
+		"ANOMALY_DETECTION_BAND(m1)",
+		`SELECT AVG(CPUUtilization) FROM SCHEMA("AWS/EC2", InstanceId)`,
+		`SELECT MAX(MillisBehindLatest) FROM SCHEMA("foo", Operation, ShardId) WHERE Operation = 'ProcessTask'`,
+		`SELECT MAX(CPUUtilization) FROM "AWS/EC2" WHERE InstanceType = 'c5.large' GROUP BY InstanceId ORDER BY MAX() DESC LIMIT 10`,
+		`select count(RequestCount) from SCHEMA("AWS/ApplicationELB", LoadBalancer) group by LoadBalancer`,
+		"SELECT SUM(\"Bytes Sent\")\nFROM \"Custom/App\"\nORDER BY SUM() ASC",
+	}
+	for _, v := range validExpressions {
+		_, errors := validMetricDataQueryExpression(v, "expression")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid metric data query expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := []string{
+		`SELECT CPUUtilization FROM "AWS/EC2"`,
+		"SELECT AVG(CPUUtilization)",
+		`SELECT MEDIAN(CPUUtilization) FROM "AWS/EC2"`,
+		`SELECT AVG(CPUUtilization) FROM "AWS/EC2" LIMIT ten`,
+		`SELECT AVG(CPUUtilization) FROM "AWS/EC2" ORDER BY AVG() SIDEWAYS`,
+		`SELECT AVG(CPUUtilization) FROM SCHEMA("AWS/EC2", InstanceId`,
+	}
+	for _, v := range invalidExpressions {
+		_, errors := validMetricDataQueryExpression(v, "expression")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid metric data query expression", v)
+		}
+	}
+}
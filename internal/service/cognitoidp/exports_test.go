@@ -6,6 +6,7 @@ package cognitoidp
 // Exports for use in tests only.
 var (
 	ResourceIdentityProvider        = resourceIdentityProvider
+	ResourceManagedLoginBranding    = resourceManagedLoginBranding
 	ResourceManagedUserPoolClient   = newManagedUserPoolClientResource
 	ResourceResourceServer          = resourceResourceServer
 	ResourceRiskConfiguration       = resourceRiskConfiguration
@@ -19,7 +20,9 @@ var (
 
 	FindGroupByTwoPartKey                   = findGroupByTwoPartKey
 	FindIdentityProviderByTwoPartKey        = findIdentityProviderByTwoPartKey
+	FindManagedLoginBrandingByTwoPartKey    = findManagedLoginBrandingByTwoPartKey
 	FindUserByTwoPartKey                    = findUserByTwoPartKey
 	FindUserPoolByID                        = findUserPoolByID
 	FindUserPoolUICustomizationByTwoPartKey = findUserPoolUICustomizationByTwoPartKey
+	ManagedLoginBrandingAssetsHash          = managedLoginBrandingAssetsHash
 )
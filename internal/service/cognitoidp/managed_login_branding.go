@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cognito_managed_login_branding", name="Managed Login Branding")
+func resourceManagedLoginBranding() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceManagedLoginBrandingCreate,
+		ReadWithoutTimeout:   resourceManagedLoginBrandingRead,
+		UpdateWithoutTimeout: resourceManagedLoginBrandingUpdate,
+		DeleteWithoutTimeout: resourceManagedLoginBrandingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asset": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 40,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidBase64String,
+						},
+						"category": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AssetCategoryType](),
+						},
+						"color_mode": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ColorSchemeModeType](),
+						},
+						"extension": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AssetExtensionType](),
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"asset_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"managed_login_branding_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"settings": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"use_cognito_provided_values": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: resourceManagedLoginBrandingCustomizeDiff,
+	}
+}
+
+const (
+	managedLoginBrandingResourceIDPartCount = 2
+)
+
+func resourceManagedLoginBrandingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	userPoolID, clientID := d.Get("user_pool_id").(string), d.Get("client_id").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{userPoolID, clientID}, managedLoginBrandingResourceIDPartCount, false))
+	input := &cognitoidentityprovider.CreateManagedLoginBrandingInput{
+		ClientId:                 aws.String(clientID),
+		UseCognitoProvidedValues: d.Get("use_cognito_provided_values").(bool),
+		UserPoolId:               aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("asset"); ok && v.(*schema.Set).Len() > 0 {
+		assets, err := expandAssetTypes(v.(*schema.Set).List())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Assets = assets
+	}
+
+	if v, ok := d.GetOk("settings"); ok {
+		settings, err := tfjson.SmithyDocumentFromString(v.(string), document.NewLazyDocument)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Settings = settings
+	}
+
+	output, err := conn.CreateManagedLoginBranding(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Cognito Managed Login Branding (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("managed_login_branding_id", output.ManagedLoginBranding.ManagedLoginBrandingId)
+
+	return append(diags, resourceManagedLoginBrandingRead(ctx, d, meta)...)
+}
+
+func resourceManagedLoginBrandingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), managedLoginBrandingResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	userPoolID, clientID := parts[0], parts[1]
+
+	branding, err := findManagedLoginBrandingByTwoPartKey(ctx, conn, userPoolID, clientID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cognito Managed Login Branding %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cognito Managed Login Branding (%s): %s", d.Id(), err)
+	}
+
+	// The assets' contents are tracked by their hash, as the configured source files cannot be derived from them.
+	d.Set("asset_hash", managedLoginBrandingAssetsHash(branding.Assets))
+	d.Set("client_id", clientID)
+	d.Set("managed_login_branding_id", branding.ManagedLoginBrandingId)
+	if branding.Settings != nil {
+		v, err := tfjson.SmithyDocumentToString(branding.Settings)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("settings", v)
+	} else {
+		d.Set("settings", nil)
+	}
+	d.Set("use_cognito_provided_values", branding.UseCognitoProvidedValues)
+	d.Set("user_pool_id", branding.UserPoolId)
+
+	return diags
+}
+
+func resourceManagedLoginBrandingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	input := &cognitoidentityprovider.UpdateManagedLoginBrandingInput{
+		ManagedLoginBrandingId:   aws.String(d.Get("managed_login_branding_id").(string)),
+		UseCognitoProvidedValues: d.Get("use_cognito_provided_values").(bool),
+		UserPoolId:               aws.String(d.Get("user_pool_id").(string)),
+	}
+
+	// The request replaces all of the branding's assets, so always include the configured ones.
+	assets, err := expandAssetTypes(d.Get("asset").(*schema.Set).List())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input.Assets = assets
+	if input.Assets == nil {
+		input.Assets = []awstypes.AssetType{}
+	}
+
+	if v, ok := d.GetOk("settings"); ok {
+		settings, err := tfjson.SmithyDocumentFromString(v.(string), document.NewLazyDocument)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Settings = settings
+	}
+
+	_, err = conn.UpdateManagedLoginBranding(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Cognito Managed Login Branding (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceManagedLoginBrandingRead(ctx, d, meta)...)
+}
+
+func resourceManagedLoginBrandingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Cognito Managed Login Branding: %s", d.Id())
+	_, err := conn.DeleteManagedLoginBranding(ctx, &cognitoidentityprovider.DeleteManagedLoginBrandingInput{
+		ManagedLoginBrandingId: aws.String(d.Get("managed_login_branding_id").(string)),
+		UserPoolId:             aws.String(d.Get("user_pool_id").(string)),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Cognito Managed Login Branding (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceManagedLoginBrandingCustomizeDiff plans an update when the contents of the configured assets,
+// including any local source files, no longer match those in AWS.
+func resourceManagedLoginBrandingCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("asset") {
+		return d.SetNewComputed("asset_hash")
+	}
+
+	assets, err := expandAssetTypes(d.Get("asset").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	if hash := managedLoginBrandingAssetsHash(assets); hash != d.Get("asset_hash").(string) {
+		return d.SetNew("asset_hash", hash)
+	}
+
+	return nil
+}
+
+func findManagedLoginBrandingByTwoPartKey(ctx context.Context, conn *cognitoidentityprovider.Client, userPoolID, clientID string) (*awstypes.ManagedLoginBrandingType, error) {
+	input := &cognitoidentityprovider.DescribeManagedLoginBrandingByClientInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	output, err := conn.DescribeManagedLoginBrandingByClient(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ManagedLoginBranding == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ManagedLoginBranding, nil
+}
+
+func expandAssetTypes(tfList []interface{}) ([]awstypes.AssetType, error) {
+	var apiObjects []awstypes.AssetType
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.AssetType{
+			Category:  awstypes.AssetCategoryType(tfMap["category"].(string)),
+			ColorMode: awstypes.ColorSchemeModeType(tfMap["color_mode"].(string)),
+			Extension: awstypes.AssetExtensionType(tfMap["extension"].(string)),
+		}
+
+		if v, ok := tfMap["bytes"].(string); ok && v != "" {
+			v, err := itypes.Base64Decode(v)
+			if err != nil {
+				return nil, err
+			}
+
+			apiObject.Bytes = v
+		}
+
+		if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+			apiObject.ResourceId = aws.String(v)
+		}
+
+		if v, ok := tfMap["source"].(string); ok && v != "" {
+			if apiObject.Bytes != nil {
+				return nil, fmt.Errorf("asset (%s, %s): only one of bytes or source can be set", apiObject.Category, apiObject.ColorMode)
+			}
+
+			v, err := os.ReadFile(v)
+			if err != nil {
+				return nil, fmt.Errorf("reading asset (%s, %s) source: %w", apiObject.Category, apiObject.ColorMode, err)
+			}
+
+			apiObject.Bytes = v
+		}
+
+		if apiObject.Bytes == nil {
+			return nil, fmt.Errorf("asset (%s, %s): one of bytes or source must be set", apiObject.Category, apiObject.ColorMode)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+// managedLoginBrandingAssetsHash returns a hash of the assets' categories, color modes, extensions and contents that does not depend on their order.
+func managedLoginBrandingAssetsHash(apiObjects []awstypes.AssetType) string {
+	if len(apiObjects) == 0 {
+		return ""
+	}
+
+	apiObjects = append([]awstypes.AssetType(nil), apiObjects...)
+	sort.Slice(apiObjects, func(i, j int) bool {
+		if apiObjects[i].Category != apiObjects[j].Category {
+			return apiObjects[i].Category < apiObjects[j].Category
+		}
+
+		return apiObjects[i].ColorMode < apiObjects[j].ColorMode
+	})
+
+	var buf bytes.Buffer
+	for _, apiObject := range apiObjects {
+		for _, v := range [][]byte{
+			[]byte(apiObject.Category),
+			[]byte(apiObject.ColorMode),
+			[]byte(apiObject.Extension),
+			apiObject.Bytes,
+		} {
+			// Length-prefix each field so that different assets cannot produce the same input.
+			buf.Write(binary.BigEndian.AppendUint64(nil, uint64(len(v))))
+			buf.Write(v)
+		}
+	}
+
+	hash := sha256.Sum256(buf.Bytes())
+
+	return hex.EncodeToString(hash[:])
+}
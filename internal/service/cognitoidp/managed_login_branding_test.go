@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcognitoidp "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestManagedLoginBrandingAssetsHash(t *testing.T) {
+	t.Parallel()
+
+	logo := awstypes.AssetType{
+		Bytes:     []byte("logo"),
+		Category:  awstypes.AssetCategoryTypePageHeaderLogo,
+		ColorMode: awstypes.ColorSchemeModeTypeLight,
+		Extension: awstypes.AssetExtensionTypePng,
+	}
+	background := awstypes.AssetType{
+		Bytes:     []byte("background"),
+		Category:  awstypes.AssetCategoryTypePageBackground,
+		ColorMode: awstypes.ColorSchemeModeTypeDark,
+		Extension: awstypes.AssetExtensionTypeJpeg,
+	}
+	modified := logo
+	modified.Bytes = []byte("modified logo")
+
+	hash := tfcognitoidp.ManagedLoginBrandingAssetsHash([]awstypes.AssetType{logo, background})
+
+	if got := tfcognitoidp.ManagedLoginBrandingAssetsHash(nil); got != "" {
+		t.Errorf("hash of no assets = %q, want empty", got)
+	}
+	if got := tfcognitoidp.ManagedLoginBrandingAssetsHash([]awstypes.AssetType{background, logo}); got != hash {
+		t.Errorf("hash depends on asset order: %q != %q", got, hash)
+	}
+	if got := tfcognitoidp.ManagedLoginBrandingAssetsHash([]awstypes.AssetType{modified, background}); got == hash {
+		t.Error("hash unchanged by asset contents")
+	}
+	if got := tfcognitoidp.ManagedLoginBrandingAssetsHash([]awstypes.AssetType{logo}); got == hash {
+		t.Error("hash unchanged by removed asset")
+	}
+}
+
+func TestAccCognitoIDPManagedLoginBranding_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_managed_login_branding.test"
+	clientResourceName := "aws_cognito_user_pool_client.test"
+	userPoolResourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedLoginBrandingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedLoginBrandingConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedLoginBrandingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "asset.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "asset_hash", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", clientResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "managed_login_branding_id"),
+					resource.TestCheckResourceAttr(resourceName, "use_cognito_provided_values", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", userPoolResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPManagedLoginBranding_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_managed_login_branding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedLoginBrandingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedLoginBrandingConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedLoginBrandingExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcognitoidp.ResourceManagedLoginBranding(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPManagedLoginBranding_asset(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_managed_login_branding.test"
+
+	// Terraform runs in a temporary directory, so source files are referred to by absolute path.
+	filename, err := filepath.Abs("test-fixtures/logo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	updatedFilename, err := filepath.Abs("test-fixtures/logo_modified.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var hash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedLoginBrandingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedLoginBrandingConfig_assetSource(rName, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedLoginBrandingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "asset.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "asset.*", map[string]string{
+						"category":   "FORM_LOGO",
+						"color_mode": "LIGHT",
+						"extension":  "PNG",
+						"source":     filename,
+					}),
+					resource.TestCheckResourceAttrWith(resourceName, "asset_hash", func(v string) error {
+						if v == "" {
+							return fmt.Errorf("asset_hash not set")
+						}
+						hash = v
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"asset"},
+			},
+			{
+				Config: testAccManagedLoginBrandingConfig_assetSource(rName, updatedFilename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedLoginBrandingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "asset.#", "1"),
+					resource.TestCheckResourceAttrWith(resourceName, "asset_hash", func(v string) error {
+						if v == hash {
+							return fmt.Errorf("asset_hash unchanged after source file changed")
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccManagedLoginBrandingConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedLoginBrandingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "asset.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "asset_hash", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckManagedLoginBrandingDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cognito_managed_login_branding" {
+				continue
+			}
+
+			_, err := tfcognitoidp.FindManagedLoginBrandingByTwoPartKey(ctx, conn, rs.Primary.Attributes["user_pool_id"], rs.Primary.Attributes["client_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Cognito Managed Login Branding %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckManagedLoginBrandingExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPClient(ctx)
+
+		_, err := tfcognitoidp.FindManagedLoginBrandingByTwoPartKey(ctx, conn, rs.Primary.Attributes["user_pool_id"], rs.Primary.Attributes["client_id"])
+
+		return err
+	}
+}
+
+func testAccManagedLoginBrandingConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name           = %[1]q
+  user_pool_tier = "ESSENTIALS"
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+`, rName)
+}
+
+func testAccManagedLoginBrandingConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccManagedLoginBrandingConfig_base(rName), `
+resource "aws_cognito_managed_login_branding" "test" {
+  client_id    = aws_cognito_user_pool_client.test.id
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  use_cognito_provided_values = true
+}
+`)
+}
+
+func testAccManagedLoginBrandingConfig_assetSource(rName, filename string) string {
+	return acctest.ConfigCompose(testAccManagedLoginBrandingConfig_base(rName), fmt.Sprintf(`
+resource "aws_cognito_managed_login_branding" "test" {
+  client_id    = aws_cognito_user_pool_client.test.id
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  asset {
+    category   = "FORM_LOGO"
+    color_mode = "LIGHT"
+    extension  = "PNG"
+    source     = %[1]q
+  }
+}
+`, filename))
+}
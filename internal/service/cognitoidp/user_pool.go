@@ -12,6 +12,9 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	cognitoidentityprovider_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -20,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -205,6 +210,25 @@ func resourceUserPool() *schema.Resource {
 					},
 				},
 			},
+			"email_mfa_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(6, 20000),
+						},
+						"subject": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+					},
+				},
+			},
 			"email_verification_message": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -474,6 +498,24 @@ func resourceUserPool() *schema.Resource {
 					},
 				},
 			},
+			"sign_in_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_first_auth_factors": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.AuthFactorType](),
+							},
+						},
+					},
+				},
+			},
 			"sms_authentication_message": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -558,6 +600,12 @@ func resourceUserPool() *schema.Resource {
 					},
 				},
 			},
+			"user_pool_tier": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.UserPoolTierType](),
+			},
 			"username_attributes": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -631,6 +679,27 @@ func resourceUserPool() *schema.Resource {
 					},
 				},
 			},
+			"web_authn_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relying_party_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"user_verification": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.UserVerificationType](),
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -790,26 +859,41 @@ func resourceUserPoolCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.StringValue(outputRaw.(*cognitoidentityprovider.CreateUserPoolOutput).UserPool.Id))
 
-	if v := d.Get("mfa_configuration").(string); v != cognitoidentityprovider.UserPoolMfaTypeOff {
-		input := &cognitoidentityprovider.SetUserPoolMfaConfigInput{
-			MfaConfiguration:              aws.String(v),
+	client := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	// The feature plan must be set before any MFA configuration that depends on it.
+	if d.Get("user_pool_tier").(string) != "" || len(d.Get("sign_in_policy").([]interface{})) > 0 {
+		if err := updateUserPoolTierAndSignInPolicy(ctx, client, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s) feature plan: %s", d.Id(), err)
+		}
+	}
+
+	if v := d.Get("mfa_configuration").(string); v != cognitoidentityprovider.UserPoolMfaTypeOff || len(d.Get("web_authn_configuration").([]interface{})) > 0 {
+		input := &cognitoidentityprovider_sdkv2.SetUserPoolMfaConfigInput{
+			MfaConfiguration:              awstypes.UserPoolMfaType(v),
 			SoftwareTokenMfaConfiguration: expandSoftwareTokenMFAConfiguration(d.Get("software_token_mfa_configuration").([]interface{})),
-			UserPoolId:                    aws.String(d.Id()),
+			UserPoolId:                    aws_sdkv2.String(d.Id()),
+			WebAuthnConfiguration:         expandWebAuthnConfiguration(d.Get("web_authn_configuration").([]interface{})),
 		}
 
-		if v := d.Get("sms_configuration").([]interface{}); len(v) > 0 && v[0] != nil {
-			input.SmsMfaConfiguration = &cognitoidentityprovider.SmsMfaConfigType{
-				SmsConfiguration: expandSMSConfiguration(v),
-			}
+		// A WebAuthn configuration can be set without MFA, as passkeys are a first authentication factor.
+		if input.MfaConfiguration != awstypes.UserPoolMfaTypeOff {
+			input.EmailMfaConfiguration = expandEmailMFAConfiguration(d.Get("email_mfa_configuration").([]interface{}))
+
+			if v := d.Get("sms_configuration").([]interface{}); len(v) > 0 && v[0] != nil {
+				input.SmsMfaConfiguration = &awstypes.SmsMfaConfigType{
+					SmsConfiguration: expandSMSConfigurationV2(v),
+				}
 
-			if v, ok := d.GetOk("sms_authentication_message"); ok {
-				input.SmsMfaConfiguration.SmsAuthenticationMessage = aws.String(v.(string))
+				if v, ok := d.GetOk("sms_authentication_message"); ok {
+					input.SmsMfaConfiguration.SmsAuthenticationMessage = aws_sdkv2.String(v.(string))
+				}
 			}
 		}
 
 		_, err := tfresource.RetryWhen(ctx, propagationTimeout, func() (any, error) {
-			return conn.SetUserPoolMfaConfigWithContext(ctx, input)
-		}, userPoolErrorRetryable)
+			return client.SetUserPoolMfaConfig(ctx, input)
+		}, userPoolErrorRetryableV2)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
@@ -896,20 +980,44 @@ func resourceUserPoolRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	setTagsOut(ctx, userPool.UserPoolTags)
 
-	input := &cognitoidentityprovider.GetUserPoolMfaConfigInput{
-		UserPoolId: aws.String(d.Id()),
+	client := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	// The feature plan and sign-in policy are only available in AWS SDK for Go v2.
+	userPoolV2, err := findUserPoolByIDV2(ctx, client, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cognito User Pool (%s): %s", d.Id(), err)
 	}
 
-	output, err := conn.GetUserPoolMfaConfigWithContext(ctx, input)
+	var signInPolicy *awstypes.SignInPolicyType
+	if userPoolV2.Policies != nil {
+		signInPolicy = userPoolV2.Policies.SignInPolicy
+	}
+	if err := d.Set("sign_in_policy", flattenSignInPolicy(signInPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sign_in_policy: %s", err)
+	}
+	d.Set("user_pool_tier", userPoolV2.UserPoolTier)
+
+	input := &cognitoidentityprovider_sdkv2.GetUserPoolMfaConfigInput{
+		UserPoolId: aws_sdkv2.String(d.Id()),
+	}
+
+	output, err := client.GetUserPoolMfaConfig(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
 	}
 
+	if err := d.Set("email_mfa_configuration", flattenEmailMFAConfiguration(output.EmailMfaConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting email_mfa_configuration: %s", err)
+	}
 	d.Set("mfa_configuration", output.MfaConfiguration)
 	if err := d.Set("software_token_mfa_configuration", flattenSoftwareTokenMFAConfiguration(output.SoftwareTokenMfaConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting software_token_mfa_configuration: %s", err)
 	}
+	if err := d.Set("web_authn_configuration", flattenWebAuthnConfiguration(output.WebAuthnConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting web_authn_configuration: %s", err)
+	}
 
 	return diags
 }
@@ -917,37 +1025,52 @@ func resourceUserPoolRead(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceUserPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CognitoIDPConn(ctx)
+	client := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	// The feature plan must be changed before any MFA configuration that depends on it.
+	if d.HasChange("user_pool_tier") {
+		if err := updateUserPoolTierAndSignInPolicy(ctx, client, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s) feature plan: %s", d.Id(), err)
+		}
+	}
 
 	// MFA updates.
 	if d.HasChanges(
+		"email_mfa_configuration",
 		"mfa_configuration",
 		"sms_authentication_message",
 		"sms_configuration",
 		"software_token_mfa_configuration",
+		"web_authn_configuration",
 	) {
-		mfaConfiguration := d.Get("mfa_configuration").(string)
-		input := &cognitoidentityprovider.SetUserPoolMfaConfigInput{
-			MfaConfiguration:              aws.String(mfaConfiguration),
+		mfaConfiguration := awstypes.UserPoolMfaType(d.Get("mfa_configuration").(string))
+		input := &cognitoidentityprovider_sdkv2.SetUserPoolMfaConfigInput{
+			MfaConfiguration:              mfaConfiguration,
 			SoftwareTokenMfaConfiguration: expandSoftwareTokenMFAConfiguration(d.Get("software_token_mfa_configuration").([]interface{})),
-			UserPoolId:                    aws.String(d.Id()),
+			UserPoolId:                    aws_sdkv2.String(d.Id()),
+			WebAuthnConfiguration:         expandWebAuthnConfiguration(d.Get("web_authn_configuration").([]interface{})),
 		}
 
-		// Since SMS configuration applies to both verification and MFA, only include if MFA is enabled.
+		// Since SMS and email configuration apply to both verification and MFA, only include if MFA is enabled.
 		// Otherwise, the API will return the following error:
 		// InvalidParameterException: Invalid MFA configuration given, can't turn off MFA and configure an MFA together.
-		if v := d.Get("sms_configuration").([]interface{}); len(v) > 0 && v[0] != nil && mfaConfiguration != cognitoidentityprovider.UserPoolMfaTypeOff {
-			input.SmsMfaConfiguration = &cognitoidentityprovider.SmsMfaConfigType{
-				SmsConfiguration: expandSMSConfiguration(v),
-			}
+		if mfaConfiguration != awstypes.UserPoolMfaTypeOff {
+			input.EmailMfaConfiguration = expandEmailMFAConfiguration(d.Get("email_mfa_configuration").([]interface{}))
+
+			if v := d.Get("sms_configuration").([]interface{}); len(v) > 0 && v[0] != nil {
+				input.SmsMfaConfiguration = &awstypes.SmsMfaConfigType{
+					SmsConfiguration: expandSMSConfigurationV2(v),
+				}
 
-			if v, ok := d.GetOk("sms_authentication_message"); ok {
-				input.SmsMfaConfiguration.SmsAuthenticationMessage = aws.String(v.(string))
+				if v, ok := d.GetOk("sms_authentication_message"); ok {
+					input.SmsMfaConfiguration.SmsAuthenticationMessage = aws_sdkv2.String(v.(string))
+				}
 			}
 		}
 
 		_, err := tfresource.RetryWhen(ctx, propagationTimeout, func() (any, error) {
-			return conn.SetUserPoolMfaConfigWithContext(ctx, input)
-		}, userPoolErrorRetryable)
+			return client.SetUserPoolMfaConfig(ctx, input)
+		}, userPoolErrorRetryableV2)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting Cognito User Pool (%s) MFA configuration: %s", d.Id(), err)
@@ -958,6 +1081,7 @@ func resourceUserPoolUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	// NOTES:
 	//  * Include SMS configuration changes since settings are shared between verification and MFA.
 	//  * For backwards compatibility, include SMS authentication message changes without SMS MFA since the API allows it.
+	userPoolUpdated := false
 	if d.HasChanges(
 		"account_recovery_setting",
 		"admin_create_user_config",
@@ -1141,6 +1265,16 @@ func resourceUserPoolUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s): %s", d.Id(), err)
 		}
+
+		userPoolUpdated = true
+	}
+
+	// UpdateUserPool resets any setting not in the request, and AWS SDK for Go v1 cannot specify the feature plan
+	// or the sign-in policy, so they are set again after any other update.
+	if userPoolUpdated || d.HasChange("sign_in_policy") {
+		if err := updateUserPoolTierAndSignInPolicy(ctx, client, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s) feature plan: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("schema") {
@@ -1198,6 +1332,17 @@ func userPoolErrorRetryable(err error) (bool, error) {
 	}
 }
 
+func userPoolErrorRetryableV2(err error) (bool, error) {
+	switch {
+	case errs.IsAErrorMessageContains[*awstypes.InvalidSmsRoleTrustRelationshipException](err, "Role does not have a trust relationship allowing Cognito to assume the role"),
+		errs.IsAErrorMessageContains[*awstypes.InvalidSmsRoleAccessPolicyException](err, "Role does not have permission to publish with SNS"):
+		return true, err
+
+	default:
+		return false, err
+	}
+}
+
 func findUserPoolByID(ctx context.Context, conn *cognitoidentityprovider.CognitoIdentityProvider, id string) (*cognitoidentityprovider.UserPoolType, error) {
 	input := &cognitoidentityprovider.DescribeUserPoolInput{
 		UserPoolId: aws.String(id),
@@ -1223,6 +1368,85 @@ func findUserPoolByID(ctx context.Context, conn *cognitoidentityprovider.Cognito
 	return output.UserPool, nil
 }
 
+func findUserPoolByIDV2(ctx context.Context, conn *cognitoidentityprovider_sdkv2.Client, id string) (*awstypes.UserPoolType, error) {
+	input := &cognitoidentityprovider_sdkv2.DescribeUserPoolInput{
+		UserPoolId: aws_sdkv2.String(id),
+	}
+
+	output, err := conn.DescribeUserPool(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserPool == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserPool, nil
+}
+
+// updateUserPoolTierAndSignInPolicy sets the user pool's feature plan and sign-in policy.
+// UpdateUserPool resets any setting not in the request, so the request includes the user pool's other current settings.
+func updateUserPoolTierAndSignInPolicy(ctx context.Context, conn *cognitoidentityprovider_sdkv2.Client, d *schema.ResourceData) error {
+	userPool, err := findUserPoolByIDV2(ctx, conn, d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &cognitoidentityprovider_sdkv2.UpdateUserPoolInput{
+		AccountRecoverySetting:      userPool.AccountRecoverySetting,
+		AdminCreateUserConfig:       userPool.AdminCreateUserConfig,
+		AutoVerifiedAttributes:      userPool.AutoVerifiedAttributes,
+		DeletionProtection:          userPool.DeletionProtection,
+		DeviceConfiguration:         userPool.DeviceConfiguration,
+		EmailConfiguration:          userPool.EmailConfiguration,
+		EmailVerificationMessage:    userPool.EmailVerificationMessage,
+		EmailVerificationSubject:    userPool.EmailVerificationSubject,
+		LambdaConfig:                userPool.LambdaConfig,
+		MfaConfiguration:            userPool.MfaConfiguration,
+		Policies:                    userPool.Policies,
+		PoolName:                    userPool.Name,
+		SmsAuthenticationMessage:    userPool.SmsAuthenticationMessage,
+		SmsConfiguration:            userPool.SmsConfiguration,
+		SmsVerificationMessage:      userPool.SmsVerificationMessage,
+		UserAttributeUpdateSettings: userPool.UserAttributeUpdateSettings,
+		UserPoolAddOns:              userPool.UserPoolAddOns,
+		UserPoolId:                  aws_sdkv2.String(d.Id()),
+		UserPoolTags:                userPool.UserPoolTags,
+		UserPoolTier:                userPool.UserPoolTier,
+		VerificationMessageTemplate: userPool.VerificationMessageTemplate,
+	}
+
+	// UnusedAccountValidityDays cannot be specified along with the password policy's TemporaryPasswordValidityDays.
+	if v := input.AdminCreateUserConfig; v != nil {
+		v.UnusedAccountValidityDays = 0
+	}
+
+	if v, ok := d.GetOk("user_pool_tier"); ok {
+		input.UserPoolTier = awstypes.UserPoolTierType(v.(string))
+	}
+
+	if v := expandSignInPolicy(d.Get("sign_in_policy").([]interface{})); v != nil {
+		if input.Policies == nil {
+			input.Policies = &awstypes.UserPoolPolicyType{}
+		}
+		input.Policies.SignInPolicy = v
+	}
+
+	_, err = conn.UpdateUserPool(ctx, input)
+
+	return err
+}
+
 func expandSMSConfiguration(tfList []interface{}) *cognitoidentityprovider.SmsConfigurationType {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -1247,17 +1471,97 @@ func expandSMSConfiguration(tfList []interface{}) *cognitoidentityprovider.SmsCo
 	return apiObject
 }
 
-func expandSoftwareTokenMFAConfiguration(tfList []interface{}) *cognitoidentityprovider.SoftwareTokenMfaConfigType {
+func expandSMSConfigurationV2(tfList []interface{}) *awstypes.SmsConfigurationType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.SmsConfigurationType{}
+
+	if v, ok := tfMap["external_id"].(string); ok && v != "" {
+		apiObject.ExternalId = aws_sdkv2.String(v)
+	}
+
+	if v, ok := tfMap["sns_caller_arn"].(string); ok && v != "" {
+		apiObject.SnsCallerArn = aws_sdkv2.String(v)
+	}
+
+	if v, ok := tfMap["sns_region"].(string); ok && v != "" {
+		apiObject.SnsRegion = aws_sdkv2.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSoftwareTokenMFAConfiguration(tfList []interface{}) *awstypes.SoftwareTokenMfaConfigType {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
 	}
 
 	tfMap := tfList[0].(map[string]interface{})
 
-	apiObject := &cognitoidentityprovider.SoftwareTokenMfaConfigType{}
+	apiObject := &awstypes.SoftwareTokenMfaConfigType{}
 
 	if v, ok := tfMap["enabled"].(bool); ok {
-		apiObject.Enabled = aws.Bool(v)
+		apiObject.Enabled = v
+	}
+
+	return apiObject
+}
+
+func expandEmailMFAConfiguration(tfList []interface{}) *awstypes.EmailMfaConfigType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.EmailMfaConfigType{}
+
+	if v, ok := tfMap["message"].(string); ok && v != "" {
+		apiObject.Message = aws_sdkv2.String(v)
+	}
+
+	if v, ok := tfMap["subject"].(string); ok && v != "" {
+		apiObject.Subject = aws_sdkv2.String(v)
+	}
+
+	return apiObject
+}
+
+func expandWebAuthnConfiguration(tfList []interface{}) *awstypes.WebAuthnConfigurationType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.WebAuthnConfigurationType{}
+
+	if v, ok := tfMap["relying_party_id"].(string); ok && v != "" {
+		apiObject.RelyingPartyId = aws_sdkv2.String(v)
+	}
+
+	if v, ok := tfMap["user_verification"].(string); ok && v != "" {
+		apiObject.UserVerification = awstypes.UserVerificationType(v)
+	}
+
+	return apiObject
+}
+
+func expandSignInPolicy(tfList []interface{}) *awstypes.SignInPolicyType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.SignInPolicyType{}
+
+	if v, ok := tfMap["allowed_first_auth_factors"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedFirstAuthFactors = flex.ExpandStringyValueSet[awstypes.AuthFactorType](v)
 	}
 
 	return apiObject
@@ -1285,15 +1589,59 @@ func flattenSMSConfiguration(apiObject *cognitoidentityprovider.SmsConfiguration
 	return []interface{}{tfMap}
 }
 
-func flattenSoftwareTokenMFAConfiguration(apiObject *cognitoidentityprovider.SoftwareTokenMfaConfigType) []interface{} {
+func flattenSoftwareTokenMFAConfiguration(apiObject *awstypes.SoftwareTokenMfaConfigType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": apiObject.Enabled,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEmailMFAConfiguration(apiObject *awstypes.EmailMfaConfigType) []interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
 	tfMap := map[string]interface{}{}
 
-	if v := apiObject.Enabled; v != nil {
-		tfMap["enabled"] = aws.BoolValue(v)
+	if v := apiObject.Message; v != nil {
+		tfMap["message"] = aws_sdkv2.ToString(v)
+	}
+
+	if v := apiObject.Subject; v != nil {
+		tfMap["subject"] = aws_sdkv2.ToString(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenWebAuthnConfiguration(apiObject *awstypes.WebAuthnConfigurationType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"user_verification": apiObject.UserVerification,
+	}
+
+	if v := apiObject.RelyingPartyId; v != nil {
+		tfMap["relying_party_id"] = aws_sdkv2.ToString(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSignInPolicy(apiObject *awstypes.SignInPolicyType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allowed_first_auth_factors": flex.FlattenStringyValueSet(apiObject.AllowedFirstAuthFactors),
 	}
 
 	return []interface{}{tfMap}
@@ -0,0 +1,542 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_comprehend_endpoint", name="Endpoint")
+// @Tags(identifierAttribute="id")
+func ResourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEndpointCreate,
+		ReadWithoutTimeout:   resourceEndpointRead,
+		UpdateWithoutTimeout: resourceEndpointUpdate,
+		DeleteWithoutTimeout: resourceEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"autoscaling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"scale_in_cooldown": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"scale_out_cooldown": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"target_utilization": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(1, 100),
+						},
+					},
+				},
+			},
+			"current_inference_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"desired_inference_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				// Once Application Auto Scaling manages the endpoint it owns the inference unit count.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old != "" && len(d.Get("autoscaling").([]interface{})) > 0
+				},
+			},
+			"model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 40),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z](-*[0-9A-Za-z])*$`), "must contain A-Z, a-z, 0-9, and hypen (-)"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	name := d.Get("name").(string)
+	in := &comprehend.CreateEndpointInput{
+		ClientRequestToken:    aws.String(name),
+		DesiredInferenceUnits: aws.Int32(int32(d.Get("desired_inference_units").(int))),
+		EndpointName:          aws.String(name),
+		ModelArn:              aws.String(d.Get("model_arn").(string)),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("data_access_role_arn"); ok {
+		in.DataAccessRoleArn = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenIsA[*types.InvalidRequestException](ctx, iamPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateEndpoint(ctx, in)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Comprehend Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*comprehend.CreateEndpointOutput).EndpointArn))
+
+	if _, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("autoscaling"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := putEndpointAutoScaling(ctx, meta.(*conns.AWSClient), d, v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "configuring Comprehend Endpoint (%s) autoscaling: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEndpointRead(ctx, d, meta)...)
+}
+
+func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	out, err := FindEndpointByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.EndpointArn)
+	d.Set("current_inference_units", out.CurrentInferenceUnits)
+	d.Set("data_access_role_arn", out.DataAccessRoleArn)
+	d.Set("desired_inference_units", out.DesiredInferenceUnits)
+	d.Set("model_arn", out.ModelArn)
+
+	name, err := EndpointParseARN(aws.ToString(out.EndpointArn))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+	d.Set("name", name)
+
+	autoscaling, err := findEndpointAutoScaling(ctx, meta.(*conns.AWSClient), aws.ToString(out.EndpointArn), aws.ToString(out.ModelArn), name)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Endpoint (%s) autoscaling: %s", d.Id(), err)
+	}
+
+	if err := d.Set("autoscaling", autoscaling); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting autoscaling: %s", err)
+	}
+
+	return diags
+}
+
+func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	if d.HasChanges("data_access_role_arn", "desired_inference_units", "model_arn") {
+		in := &comprehend.UpdateEndpointInput{
+			EndpointArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_access_role_arn") {
+			in.DesiredDataAccessRoleArn = aws.String(d.Get("data_access_role_arn").(string))
+		}
+
+		if d.HasChange("desired_inference_units") {
+			in.DesiredInferenceUnits = aws.Int32(int32(d.Get("desired_inference_units").(int)))
+		}
+
+		if d.HasChange("model_arn") {
+			in.DesiredModelArn = aws.String(d.Get("model_arn").(string))
+		}
+
+		if _, err := conn.UpdateEndpoint(ctx, in); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Comprehend Endpoint (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Endpoint (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("autoscaling") {
+		if v, ok := d.GetOk("autoscaling"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := putEndpointAutoScaling(ctx, meta.(*conns.AWSClient), d, v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return sdkdiag.AppendErrorf(diags, "configuring Comprehend Endpoint (%s) autoscaling: %s", d.Id(), err)
+			}
+		} else {
+			o, _ := d.GetChange("model_arn")
+			if err := deregisterEndpointAutoScaling(ctx, meta.(*conns.AWSClient), d.Id(), o.(string)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing Comprehend Endpoint (%s) autoscaling: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceEndpointRead(ctx, d, meta)...)
+}
+
+func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	if v, ok := d.GetOk("autoscaling"); ok && len(v.([]interface{})) > 0 {
+		if err := deregisterEndpointAutoScaling(ctx, meta.(*conns.AWSClient), d.Id(), d.Get("model_arn").(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "removing Comprehend Endpoint (%s) autoscaling: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting Comprehend Endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpoint(ctx, &comprehend.DeleteEndpointInput{
+		EndpointArn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// endpointScalableDimension returns the Application Auto Scaling dimension for the endpoint's model type.
+func endpointScalableDimension(modelARN string) (string, error) {
+	parsedARN, err := arn.Parse(modelARN)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case strings.HasPrefix(parsedARN.Resource, "document-classifier/"):
+		return applicationautoscaling.ScalableDimensionComprehendDocumentClassifierEndpointDesiredInferenceUnits, nil
+	case strings.HasPrefix(parsedARN.Resource, "entity-recognizer/"):
+		return applicationautoscaling.ScalableDimensionComprehendEntityRecognizerEndpointDesiredInferenceUnits, nil
+	}
+
+	return "", fmt.Errorf("unsupported model (%s)", modelARN)
+}
+
+func endpointScalingPolicyName(name string) string {
+	return name + "-target-tracking"
+}
+
+func putEndpointAutoScaling(ctx context.Context, client *conns.AWSClient, d *schema.ResourceData, tfMap map[string]interface{}) error {
+	conn := client.AppAutoScalingConn(ctx)
+
+	dimension, err := endpointScalableDimension(d.Get("model_arn").(string))
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.RegisterScalableTargetWithContext(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+		MaxCapacity:       aws_sdkv1.Int64(int64(tfMap["max_capacity"].(int))),
+		MinCapacity:       aws_sdkv1.Int64(int64(tfMap["min_capacity"].(int))),
+		ResourceId:        aws_sdkv1.String(d.Id()),
+		ScalableDimension: aws_sdkv1.String(dimension),
+		ServiceNamespace:  aws_sdkv1.String(applicationautoscaling.ServiceNamespaceComprehend),
+	})
+
+	if err != nil {
+		return fmt.Errorf("registering scalable target: %w", err)
+	}
+
+	policyName := endpointScalingPolicyName(d.Get("name").(string))
+
+	v, ok := tfMap["target_utilization"].(float64)
+	if !ok || v == 0 {
+		_, err := conn.DeleteScalingPolicyWithContext(ctx, &applicationautoscaling.DeleteScalingPolicyInput{
+			PolicyName:        aws_sdkv1.String(policyName),
+			ResourceId:        aws_sdkv1.String(d.Id()),
+			ScalableDimension: aws_sdkv1.String(dimension),
+			ServiceNamespace:  aws_sdkv1.String(applicationautoscaling.ServiceNamespaceComprehend),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+			return fmt.Errorf("deleting scaling policy (%s): %w", policyName, err)
+		}
+
+		return nil
+	}
+
+	config := &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+		PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws_sdkv1.String(applicationautoscaling.MetricTypeComprehendInferenceUtilization),
+		},
+		TargetValue: aws_sdkv1.Float64(v),
+	}
+
+	if v, ok := tfMap["scale_in_cooldown"].(int); ok && v > 0 {
+		config.ScaleInCooldown = aws_sdkv1.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scale_out_cooldown"].(int); ok && v > 0 {
+		config.ScaleOutCooldown = aws_sdkv1.Int64(int64(v))
+	}
+
+	_, err = conn.PutScalingPolicyWithContext(ctx, &applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:                               aws_sdkv1.String(policyName),
+		PolicyType:                               aws_sdkv1.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+		ResourceId:                               aws_sdkv1.String(d.Id()),
+		ScalableDimension:                        aws_sdkv1.String(dimension),
+		ServiceNamespace:                         aws_sdkv1.String(applicationautoscaling.ServiceNamespaceComprehend),
+		TargetTrackingScalingPolicyConfiguration: config,
+	})
+
+	if err != nil {
+		return fmt.Errorf("putting scaling policy (%s): %w", policyName, err)
+	}
+
+	return nil
+}
+
+// deregisterEndpointAutoScaling removes the scalable target, which also deletes its scaling policies.
+func deregisterEndpointAutoScaling(ctx context.Context, client *conns.AWSClient, endpointARN, modelARN string) error {
+	conn := client.AppAutoScalingConn(ctx)
+
+	dimension, err := endpointScalableDimension(modelARN)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeregisterScalableTargetWithContext(ctx, &applicationautoscaling.DeregisterScalableTargetInput{
+		ResourceId:        aws_sdkv1.String(endpointARN),
+		ScalableDimension: aws_sdkv1.String(dimension),
+		ServiceNamespace:  aws_sdkv1.String(applicationautoscaling.ServiceNamespaceComprehend),
+	})
+
+	if tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+func findEndpointAutoScaling(ctx context.Context, client *conns.AWSClient, endpointARN, modelARN, name string) ([]interface{}, error) {
+	conn := client.AppAutoScalingConn(ctx)
+
+	dimension, err := endpointScalableDimension(modelARN)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := tfappautoscaling.FindTargetByThreePartKey(ctx, conn, endpointARN, applicationautoscaling.ServiceNamespaceComprehend, dimension)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	tfMap := map[string]interface{}{
+		"max_capacity": aws_sdkv1.Int64Value(target.MaxCapacity),
+		"min_capacity": aws_sdkv1.Int64Value(target.MinCapacity),
+	}
+
+	output, err := conn.DescribeScalingPoliciesWithContext(ctx, &applicationautoscaling.DescribeScalingPoliciesInput{
+		PolicyNames:       aws_sdkv1.StringSlice([]string{endpointScalingPolicyName(name)}),
+		ResourceId:        aws_sdkv1.String(endpointARN),
+		ScalableDimension: aws_sdkv1.String(dimension),
+		ServiceNamespace:  aws_sdkv1.String(applicationautoscaling.ServiceNamespaceComprehend),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range output.ScalingPolicies {
+		if config := policy.TargetTrackingScalingPolicyConfiguration; config != nil {
+			tfMap["scale_in_cooldown"] = aws_sdkv1.Int64Value(config.ScaleInCooldown)
+			tfMap["scale_out_cooldown"] = aws_sdkv1.Int64Value(config.ScaleOutCooldown)
+			tfMap["target_utilization"] = aws_sdkv1.Float64Value(config.TargetValue)
+		}
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+func FindEndpointByARN(ctx context.Context, conn *comprehend.Client, arn string) (*types.EndpointProperties, error) {
+	in := &comprehend.DescribeEndpointInput{
+		EndpointArn: aws.String(arn),
+	}
+
+	out, err := conn.DescribeEndpoint(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.EndpointProperties == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.EndpointProperties, nil
+}
+
+func waitEndpointInService(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*types.EndpointProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(types.EndpointStatusCreating, types.EndpointStatusUpdating),
+		Target:       enum.Slice(types.EndpointStatusInService),
+		Refresh:      statusEndpoint(ctx, conn, arn),
+		PollInterval: endpointPollInterval,
+		Timeout:      timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if output, ok := outputRaw.(*types.EndpointProperties); ok {
+		if output.Status == types.EndpointStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointDeleted(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*types.EndpointProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(types.EndpointStatusDeleting),
+		Target:       []string{},
+		Refresh:      statusEndpoint(ctx, conn, arn),
+		PollInterval: endpointPollInterval,
+		Timeout:      timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.EndpointProperties); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusEndpoint(ctx context.Context, conn *comprehend.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindEndpointByARN(ctx, conn, arn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func EndpointParseARN(arnString string) (string, error) {
+	endpointARN, err := arn.Parse(arnString)
+	if err != nil {
+		return "", err
+	}
+
+	name, ok := strings.CutPrefix(endpointARN.Resource, "document-classifier-endpoint/")
+	if !ok {
+		name, ok = strings.CutPrefix(endpointARN.Resource, "entity-recognizer-endpoint/")
+	}
+	if !ok || name == "" {
+		return "", fmt.Errorf("unexpected format for Comprehend Endpoint ARN (%s)", arnString)
+	}
+
+	return name, nil
+}
@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Recommendation Preferences")
+func newRecommendationPreferencesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recommendationPreferencesResource{}
+
+	return r, nil
+}
+
+type recommendationPreferencesResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*recommendationPreferencesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_recommendation_preferences"
+}
+
+func (r *recommendationPreferencesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enhanced_infrastructure_metrics": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnhancedInfrastructureMetrics](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"inferred_workload_types": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InferredWorkloadTypesPreference](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"look_back_period": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LookBackPeriodPreference](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"savings_estimation_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SavingsEstimationMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"external_metrics_preference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[externalMetricsPreferenceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ExternalMetricsSource](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"preferred_resource": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[preferredResourceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"exclude_list": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"include_list": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"name": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PreferredResourceName](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"scope": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scopeModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ScopeName](),
+							Required:   true,
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"utilization_preference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[utilizationPreferenceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"metric_name": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CustomizableMetricName](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"metric_parameters": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customizableMetricParametersModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"headroom": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CustomizableMetricHeadroom](),
+										Required:   true,
+									},
+									"threshold": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CustomizableMetricThreshold](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recommendationPreferencesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recommendationPreferencesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.PutRecommendationPreferencesInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Compute Optimizer Recommendation Preferences", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID(ctx)
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, string(input.ResourceType), string(input.Scope.Name), aws.ToString(input.Scope.Value))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recommendationPreferencesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recommendationPreferencesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), recommendationPreferencesResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recommendationPreferencesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new recommendationPreferencesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.PutRecommendationPreferencesInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	output, err := findRecommendationPreferencesByThreePartKey(ctx, conn, string(input.ResourceType), string(input.Scope.Name), aws.ToString(input.Scope.Value))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recommendationPreferencesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recommendationPreferencesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.DeleteRecommendationPreferencesInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.RecommendationPreferenceNames = enum.EnumValues[awstypes.RecommendationPreferenceName]()
+
+	_, err := conn.DeleteRecommendationPreferences(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *recommendationPreferencesResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func findRecommendationPreferencesByThreePartKey(ctx context.Context, conn *computeoptimizer.Client, resourceType, scopeName, scopeValue string) (*awstypes.RecommendationPreferencesDetail, error) {
+	input := &computeoptimizer.GetRecommendationPreferencesInput{
+		ResourceType: awstypes.ResourceType(resourceType),
+		Scope: &awstypes.Scope{
+			Name:  awstypes.ScopeName(scopeName),
+			Value: aws.String(scopeValue),
+		},
+	}
+
+	return findRecommendationPreferences(ctx, conn, input, func(v *awstypes.RecommendationPreferencesDetail) bool {
+		return v.Scope != nil && string(v.Scope.Name) == scopeName && aws.ToString(v.Scope.Value) == scopeValue
+	})
+}
+
+func findRecommendationPreferences(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetRecommendationPreferencesInput, filter func(*awstypes.RecommendationPreferencesDetail) bool) (*awstypes.RecommendationPreferencesDetail, error) {
+	var output []awstypes.RecommendationPreferencesDetail
+
+	pages := computeoptimizer.NewGetRecommendationPreferencesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RecommendationPreferencesDetails {
+			v := v
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+type recommendationPreferencesResourceModel struct {
+	EnhancedInfrastructureMetrics fwtypes.StringEnum[awstypes.EnhancedInfrastructureMetrics]      `tfsdk:"enhanced_infrastructure_metrics"`
+	ExternalMetricsPreference     fwtypes.ListNestedObjectValueOf[externalMetricsPreferenceModel] `tfsdk:"external_metrics_preference"`
+	ID                            types.String                                                    `tfsdk:"id"`
+	InferredWorkloadTypes         fwtypes.StringEnum[awstypes.InferredWorkloadTypesPreference]    `tfsdk:"inferred_workload_types"`
+	LookBackPeriod                fwtypes.StringEnum[awstypes.LookBackPeriodPreference]           `tfsdk:"look_back_period"`
+	PreferredResources            fwtypes.ListNestedObjectValueOf[preferredResourceModel]         `tfsdk:"preferred_resource"`
+	ResourceType                  fwtypes.StringEnum[awstypes.ResourceType]                       `tfsdk:"resource_type"`
+	SavingsEstimationMode         fwtypes.StringEnum[awstypes.SavingsEstimationMode]              `tfsdk:"savings_estimation_mode"`
+	Scope                         fwtypes.ListNestedObjectValueOf[scopeModel]                     `tfsdk:"scope"`
+	UtilizationPreferences        fwtypes.ListNestedObjectValueOf[utilizationPreferenceModel]     `tfsdk:"utilization_preference"`
+}
+
+const (
+	recommendationPreferencesResourceIDPartCount = 3
+)
+
+func (data *recommendationPreferencesResourceModel) setID(ctx context.Context) {
+	scope, _ := data.Scope.ToPtr(ctx)
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ResourceType.ValueString(), scope.Name.ValueString(), scope.Value.ValueString()}, recommendationPreferencesResourceIDPartCount, false)))
+}
+
+type externalMetricsPreferenceModel struct {
+	Source fwtypes.StringEnum[awstypes.ExternalMetricsSource] `tfsdk:"source"`
+}
+
+type preferredResourceModel struct {
+	ExcludeList fwtypes.SetValueOf[types.String]                   `tfsdk:"exclude_list"`
+	IncludeList fwtypes.SetValueOf[types.String]                   `tfsdk:"include_list"`
+	Name        fwtypes.StringEnum[awstypes.PreferredResourceName] `tfsdk:"name"`
+}
+
+type scopeModel struct {
+	Name  fwtypes.StringEnum[awstypes.ScopeName] `tfsdk:"name"`
+	Value types.String                           `tfsdk:"value"`
+}
+
+type utilizationPreferenceModel struct {
+	MetricName       fwtypes.StringEnum[awstypes.CustomizableMetricName]                `tfsdk:"metric_name"`
+	MetricParameters fwtypes.ListNestedObjectValueOf[customizableMetricParametersModel] `tfsdk:"metric_parameters"`
+}
+
+type customizableMetricParametersModel struct {
+	Headroom  fwtypes.StringEnum[awstypes.CustomizableMetricHeadroom]  `tfsdk:"headroom"`
+	Threshold fwtypes.StringEnum[awstypes.CustomizableMetricThreshold] `tfsdk:"threshold"`
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -50,6 +51,31 @@ func ResourceProject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -69,6 +95,10 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.DefaultJobTimeoutMinutes = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandProjectVPCConfig(v.([]interface{}))
+	}
+
 	output, err := conn.CreateProjectWithContext(ctx, input)
 
 	if err != nil {
@@ -104,6 +134,9 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("name", project.Name)
 	d.Set("arn", arn)
 	d.Set("default_job_timeout_minutes", project.DefaultJobTimeoutMinutes)
+	if err := d.Set("vpc_config", flattenProjectVPCConfig(project.VpcConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
+	}
 
 	return diags
 }
@@ -125,6 +158,10 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DefaultJobTimeoutMinutes = aws.Int64(int64(d.Get("default_job_timeout_minutes").(int)))
 		}
 
+		if d.HasChange("vpc_config") {
+			input.VpcConfig = expandProjectVPCConfig(d.Get("vpc_config").([]interface{}))
+		}
+
 		_, err := conn.UpdateProjectWithContext(ctx, input)
 
 		if err != nil {
@@ -154,3 +191,33 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	return diags
 }
+
+func expandProjectVPCConfig(l []interface{}) *devicefarm.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &devicefarm.VpcConfig{
+		VpcId:            aws.String(m["vpc_id"].(string)),
+		SubnetIds:        flex.ExpandStringSet(m["subnet_ids"].(*schema.Set)),
+		SecurityGroupIds: flex.ExpandStringSet(m["security_group_ids"].(*schema.Set)),
+	}
+
+	return config
+}
+
+func flattenProjectVPCConfig(conf *devicefarm.VpcConfig) []interface{} {
+	if conf == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"vpc_id":             aws.StringValue(conf.VpcId),
+		"subnet_ids":         flex.FlattenStringSet(conf.SubnetIds),
+		"security_group_ids": flex.FlattenStringSet(conf.SecurityGroupIds),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdb
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_docdb_certificate")
+func DataSourceCertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCertificateRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"latest_valid_till": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid_from": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid_till": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DocDBConn(ctx)
+
+	input := &docdb.DescribeCertificatesInput{}
+
+	if v, ok := d.GetOk("id"); ok {
+		input.CertificateIdentifier = aws.String(v.(string))
+	}
+
+	certificates, err := findCertificates(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DocumentDB Certificates: %s", err)
+	}
+
+	if len(certificates) == 0 {
+		return sdkdiag.AppendErrorf(diags, "no DocumentDB Certificates match the criteria")
+	}
+
+	var certificate *docdb.Certificate
+
+	if d.Get("latest_valid_till").(bool) {
+		certificate = slices.MaxFunc(certificates, func(a, b *docdb.Certificate) int {
+			return aws.TimeValue(a.ValidTill).Compare(aws.TimeValue(b.ValidTill))
+		})
+	} else {
+		if len(certificates) > 1 {
+			return sdkdiag.AppendErrorf(diags, "multiple DocumentDB Certificates match the criteria; try changing search query")
+		}
+
+		certificate = certificates[0]
+	}
+
+	d.SetId(aws.StringValue(certificate.CertificateIdentifier))
+	d.Set("arn", certificate.CertificateArn)
+	d.Set("certificate_type", certificate.CertificateType)
+	d.Set("thumbprint", certificate.Thumbprint)
+	if certificate.ValidFrom != nil {
+		d.Set("valid_from", aws.TimeValue(certificate.ValidFrom).Format(time.RFC3339))
+	}
+	if certificate.ValidTill != nil {
+		d.Set("valid_till", aws.TimeValue(certificate.ValidTill).Format(time.RFC3339))
+	}
+
+	return diags
+}
+
+func findCertificates(ctx context.Context, conn *docdb.DocDB, input *docdb.DescribeCertificatesInput) ([]*docdb.Certificate, error) {
+	var output []*docdb.Certificate
+
+	err := conn.DescribeCertificatesPagesWithContext(ctx, input, func(page *docdb.DescribeCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Certificates {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
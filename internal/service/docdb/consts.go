@@ -50,12 +50,13 @@ const (
 )
 
 const (
-	globalClusterStatusAvailable = "available"
-	globalClusterStatusCreating  = "creating"
-	globalClusterStatusDeleted   = "deleted"
-	globalClusterStatusDeleting  = "deleting"
-	globalClusterStatusModifying = "modifying"
-	globalClusterStatusUpgrading = "upgrading"
+	globalClusterStatusAvailable     = "available"
+	globalClusterStatusCreating      = "creating"
+	globalClusterStatusDeleted       = "deleted"
+	globalClusterStatusDeleting      = "deleting"
+	globalClusterStatusModifying     = "modifying"
+	globalClusterStatusSwitchingOver = "switching-over"
+	globalClusterStatusUpgrading     = "upgrading"
 )
 
 const (
@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceCertificate,
+			TypeName: "aws_docdb_certificate",
+		},
 		{
 			Factory:  DataSourceEngineVersion,
 			TypeName: "aws_docdb_engine_version",
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -81,6 +82,16 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					enum.FrameworkValidate[awstypes.Auth](),
 				},
 			},
+			"backup_retention_period": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 35),
+				},
+			},
 			"endpoint": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -102,6 +113,13 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"preferred_backup_window": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"preferred_maintenance_window": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -118,6 +136,16 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					int64validator.Between(1, 32),
 				},
 			},
+			"shard_instance_count": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16),
+				},
+			},
 			"subnet_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -172,14 +200,26 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 		Tags:              getTagsIn(ctx),
 	}
 
+	if !plan.BackupRetentionPeriod.IsNull() && !plan.BackupRetentionPeriod.IsUnknown() {
+		input.BackupRetentionPeriod = flex.Int32FromFramework(ctx, plan.BackupRetentionPeriod)
+	}
+
 	if !plan.KmsKeyID.IsNull() || !plan.KmsKeyID.IsUnknown() {
 		input.KmsKeyId = flex.StringFromFramework(ctx, plan.KmsKeyID)
 	}
 
+	if !plan.PreferredBackupWindow.IsNull() && !plan.PreferredBackupWindow.IsUnknown() {
+		input.PreferredBackupWindow = flex.StringFromFramework(ctx, plan.PreferredBackupWindow)
+	}
+
 	if !plan.PreferredMaintenanceWindow.IsNull() || !plan.PreferredMaintenanceWindow.IsUnknown() {
 		input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
 	}
 
+	if !plan.ShardInstanceCount.IsNull() && !plan.ShardInstanceCount.IsUnknown() {
+		input.ShardInstanceCount = flex.Int32FromFramework(ctx, plan.ShardInstanceCount)
+	}
+
 	if !plan.SubnetIds.IsNull() || !plan.SubnetIds.IsUnknown() {
 		input.SubnetIds = flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
 	}
@@ -276,6 +316,14 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 			input.AuthType = awstypes.Auth(plan.AuthType.ValueString())
 		}
 
+		if !plan.BackupRetentionPeriod.Equal(state.BackupRetentionPeriod) {
+			input.BackupRetentionPeriod = flex.Int32FromFramework(ctx, plan.BackupRetentionPeriod)
+		}
+
+		if !plan.PreferredBackupWindow.Equal(state.PreferredBackupWindow) {
+			input.PreferredBackupWindow = flex.StringFromFramework(ctx, plan.PreferredBackupWindow)
+		}
+
 		if !plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow) {
 			input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
 		}
@@ -288,6 +336,10 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 			input.ShardCount = flex.Int32FromFramework(ctx, plan.ShardCount)
 		}
 
+		if !plan.ShardInstanceCount.Equal(state.ShardInstanceCount) {
+			input.ShardInstanceCount = flex.Int32FromFramework(ctx, plan.ShardInstanceCount)
+		}
+
 		if !plan.SubnetIds.Equal(state.SubnetIds) {
 			input.SubnetIds = flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
 		}
@@ -381,13 +433,16 @@ type resourceClusterData struct {
 	AdminUserPassword          types.String   `tfsdk:"admin_user_password"`
 	ARN                        types.String   `tfsdk:"arn"`
 	AuthType                   types.String   `tfsdk:"auth_type"`
+	BackupRetentionPeriod      types.Int64    `tfsdk:"backup_retention_period"`
 	Endpoint                   types.String   `tfsdk:"endpoint"`
 	ID                         types.String   `tfsdk:"id"`
 	KmsKeyID                   types.String   `tfsdk:"kms_key_id"`
 	Name                       types.String   `tfsdk:"name"`
+	PreferredBackupWindow      types.String   `tfsdk:"preferred_backup_window"`
 	PreferredMaintenanceWindow types.String   `tfsdk:"preferred_maintenance_window"`
 	ShardCapacity              types.Int64    `tfsdk:"shard_capacity"`
 	ShardCount                 types.Int64    `tfsdk:"shard_count"`
+	ShardInstanceCount         types.Int64    `tfsdk:"shard_instance_count"`
 	SubnetIds                  types.Set      `tfsdk:"subnet_ids"`
 	Tags                       types.Map      `tfsdk:"tags"`
 	TagsAll                    types.Map      `tfsdk:"tags_all"`
@@ -490,12 +545,15 @@ func (r *resourceClusterData) refreshFromOutput(ctx context.Context, output *aws
 	r.AdminUserName = flex.StringToFrameworkLegacy(ctx, output.AdminUserName)
 	r.AuthType = flex.StringValueToFramework(ctx, string(output.AuthType))
 	r.ARN = flex.StringToFramework(ctx, output.ClusterArn)
+	r.BackupRetentionPeriod = flex.Int32ToFramework(ctx, output.BackupRetentionPeriod)
 	r.Endpoint = flex.StringToFramework(ctx, output.ClusterEndpoint)
 	r.KmsKeyID = flex.StringToFramework(ctx, output.KmsKeyId)
 	r.Name = flex.StringToFramework(ctx, output.ClusterName)
+	r.PreferredBackupWindow = flex.StringToFramework(ctx, output.PreferredBackupWindow)
 	r.PreferredMaintenanceWindow = flex.StringToFramework(ctx, output.PreferredMaintenanceWindow)
 	r.ShardCapacity = flex.Int32ToFramework(ctx, output.ShardCapacity)
 	r.ShardCount = flex.Int32ToFramework(ctx, output.ShardCount)
+	r.ShardInstanceCount = flex.Int32ToFramework(ctx, output.ShardInstanceCount)
 	r.SubnetIds = flex.FlattenFrameworkStringValueSet(ctx, output.SubnetIds)
 	r.VpcSecurityGroupIds = flex.FlattenFrameworkStringValueSet(ctx, output.VpcSecurityGroupIds)
 }
@@ -504,9 +562,12 @@ func clusterHasChanges(_ context.Context, plan, state resourceClusterData) bool
 	return !plan.Name.Equal(state.Name) ||
 		!plan.AdminUserPassword.Equal(state.AdminUserPassword) ||
 		!plan.AuthType.Equal(state.AuthType) ||
+		!plan.BackupRetentionPeriod.Equal(state.BackupRetentionPeriod) ||
+		!plan.PreferredBackupWindow.Equal(state.PreferredBackupWindow) ||
 		!plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow) ||
 		!plan.ShardCapacity.Equal(state.ShardCapacity) ||
 		!plan.ShardCount.Equal(state.ShardCount) ||
+		!plan.ShardInstanceCount.Equal(state.ShardInstanceCount) ||
 		!plan.SubnetIds.Equal(state.SubnetIds) ||
 		!plan.VpcSecurityGroupIds.Equal(state.VpcSecurityGroupIds)
 }
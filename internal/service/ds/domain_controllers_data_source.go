@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_directory_service_domain_controllers", name="Domain Controllers")
+func DataSourceDomainControllers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDomainControllersRead,
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dns_ip_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"domain_controllers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_controller_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainControllersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	input := &directoryservice.DescribeDomainControllersInput{
+		DirectoryId: aws.String(directoryID),
+	}
+
+	output, err := FindDomainControllers(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Directory (%s) Domain Controllers: %s", directoryID, err)
+	}
+
+	var dnsIPAddresses []string
+	var tfList []interface{}
+
+	for _, v := range output {
+		// Domain controllers that are being created or deleted cannot serve DNS queries.
+		if aws.StringValue(v.Status) == directoryservice.DomainControllerStatusActive {
+			dnsIPAddresses = append(dnsIPAddresses, aws.StringValue(v.DnsIpAddr))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone":    aws.StringValue(v.AvailabilityZone),
+			"dns_ip_address":       aws.StringValue(v.DnsIpAddr),
+			"domain_controller_id": aws.StringValue(v.DomainControllerId),
+			"status":               aws.StringValue(v.Status),
+			"subnet_id":            aws.StringValue(v.SubnetId),
+			"vpc_id":               aws.StringValue(v.VpcId),
+		})
+	}
+
+	d.SetId(directoryID)
+	d.Set("dns_ip_addresses", dnsIPAddresses)
+	if err := d.Set("domain_controllers", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting domain_controllers: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSDomainControllersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_directory_service_domain_controllers.test"
+	resourceName := "aws_directory_service_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainControllersDataSourceConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "directory_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "domain_controllers.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dns_ip_addresses.#", resourceName, "dns_ip_addresses.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "domain_controllers.0.status", "Active"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_controllers.0.vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainControllersDataSourceConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_microsoft(rName, domain),
+		`
+data "aws_directory_service_domain_controllers" "test" {
+  directory_id = aws_directory_service_directory.test.id
+}
+`,
+	)
+}
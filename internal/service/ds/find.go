@@ -127,6 +127,42 @@ func FindDomainControllers(ctx context.Context, conn *directoryservice.Directory
 	return output, nil
 }
 
+func FindSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string) ([]*directoryservice.SettingEntry, error) {
+	input := &directoryservice.DescribeSettingsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output []*directoryservice.SettingEntry
+
+	for {
+		page, err := conn.DescribeSettingsWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SettingEntries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
 func FindRadiusSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string) (*directoryservice.RadiusSettings, error) {
 	output, err := FindDirectoryByID(ctx, conn, directoryID)
 
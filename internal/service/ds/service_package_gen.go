@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDirectory,
 			TypeName: "aws_directory_service_directory",
 		},
+		{
+			Factory:  DataSourceDomainControllers,
+			TypeName: "aws_directory_service_domain_controllers",
+			Name:     "Domain Controllers",
+		},
 	}
 }
 
@@ -66,6 +71,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Region",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceSettings,
+			TypeName: "aws_directory_service_settings",
+			Name:     "Settings",
+		},
 		{
 			Factory:  ResourceSharedDirectory,
 			TypeName: "aws_directory_service_shared_directory",
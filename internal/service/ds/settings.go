@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_directory_service_settings", name="Settings")
+func ResourceSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSettingsCreate,
+		ReadWithoutTimeout:   resourceSettingsRead,
+		UpdateWithoutTimeout: resourceSettingsUpdate,
+		DeleteWithoutTimeout: resourceSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"setting": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	settings := expandSettings(d.Get("setting").(*schema.Set).List())
+
+	if err := updateSettings(ctx, conn, directoryID, settings, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Directory Service Directory (%s) settings: %s", directoryID, err)
+	}
+
+	d.SetId(directoryID)
+
+	return append(diags, resourceSettingsRead(ctx, d, meta)...)
+}
+
+func resourceSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	output, err := FindSettings(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Directory (%s) settings not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Directory (%s) settings: %s", d.Id(), err)
+	}
+
+	// Only the settings managed by this resource are tracked, unless importing.
+	names := make(map[string]struct{})
+	for _, v := range expandSettings(d.Get("setting").(*schema.Set).List()) {
+		names[aws.StringValue(v.Name)] = struct{}{}
+	}
+
+	var tfList []interface{}
+	for _, v := range output {
+		name := aws.StringValue(v.Name)
+
+		if _, ok := names[name]; !ok && len(names) > 0 {
+			continue
+		}
+
+		// While an update is in progress the applied value is the previous one.
+		value := aws.StringValue(v.AppliedValue)
+		if v := aws.StringValue(v.RequestedValue); v != "" {
+			value = v
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+
+	d.Set("directory_id", d.Id())
+	if err := d.Set("setting", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	if d.HasChange("setting") {
+		o, n := d.GetChange("setting")
+		settings := expandSettings(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		if len(settings) > 0 {
+			if err := updateSettings(ctx, conn, d.Id(), settings, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Directory Service Directory (%s) settings: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceSettingsRead(ctx, d, meta)...)
+}
+
+func resourceSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Directory settings cannot be reset to their defaults, so they are left in place.
+	log.Printf("[WARN] Directory Service Directory (%s) settings are not reset on destroy", d.Id())
+
+	return nil
+}
+
+func updateSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, settings []*directoryservice.Setting, timeout time.Duration) error {
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings:    settings,
+	}
+
+	if _, err := conn.UpdateSettingsWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(settings))
+	for _, v := range settings {
+		names = append(names, aws.StringValue(v.Name))
+	}
+
+	_, err := waitSettingsUpdated(ctx, conn, directoryID, names, timeout)
+
+	return err
+}
+
+func expandSettings(tfList []interface{}) []*directoryservice.Setting {
+	var apiObjects []*directoryservice.Setting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &directoryservice.Setting{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_directory_service_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsConfig_basic(rName, domainName, "Disable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSettingValue(ctx, resourceName, "TLS_1_0", "Disable"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"name":  "TLS_1_0",
+						"value": "Disable",
+					}),
+				),
+			},
+			{
+				Config: testAccSettingsConfig_basic(rName, domainName, "Enable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSettingValue(ctx, resourceName, "TLS_1_0", "Enable"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"name":  "TLS_1_0",
+						"value": "Enable",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingValue(ctx context.Context, n, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn(ctx)
+
+		output, err := tfds.FindSettings(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output {
+			if aws.StringValue(v.Name) != name {
+				continue
+			}
+
+			if got := aws.StringValue(v.AppliedValue); got != value {
+				return fmt.Errorf("Directory Service Directory (%s) setting %s is %q, want %q", rs.Primary.ID, name, got, value)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Directory Service Directory (%s) setting %s not found", rs.Primary.ID, name)
+	}
+}
+
+func testAccSettingsConfig_basic(rName, domain, value string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_microsoft(rName, domain),
+		fmt.Sprintf(`
+resource "aws_directory_service_settings" "test" {
+  directory_id = aws_directory_service_directory.test.id
+
+  setting {
+    name  = "TLS_1_0"
+    value = %[1]q
+  }
+}
+`, value),
+	)
+}
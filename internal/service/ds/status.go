@@ -5,6 +5,7 @@ package ds
 
 import (
 	"context"
+	"slices"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
@@ -76,6 +77,38 @@ func statusRadius(ctx context.Context, conn *directoryservice.DirectoryService,
 	}
 }
 
+// statusSettings returns the combined request status of the named settings.
+func statusSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, names []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSettings(ctx, conn, directoryID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := directoryservice.DirectoryConfigurationStatusUpdated
+
+		for _, v := range output {
+			if !slices.Contains(names, aws.StringValue(v.Name)) {
+				continue
+			}
+
+			switch v := aws.StringValue(v.RequestStatus); v {
+			case directoryservice.DirectoryConfigurationStatusFailed:
+				return output, v, nil
+			case directoryservice.DirectoryConfigurationStatusRequested, directoryservice.DirectoryConfigurationStatusUpdating:
+				status = v
+			}
+		}
+
+		return output, status, nil
+	}
+}
+
 func statusRegion(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, regionName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindRegion(ctx, conn, directoryID, regionName)
@@ -6,6 +6,8 @@ package ds
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -69,6 +71,33 @@ func waitRadiusCompleted(ctx context.Context, conn *directoryservice.DirectorySe
 	return nil, err
 }
 
+func waitSettingsUpdated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, names []string, timeout time.Duration) ([]*directoryservice.SettingEntry, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{directoryservice.DirectoryConfigurationStatusRequested, directoryservice.DirectoryConfigurationStatusUpdating},
+		Target:  []string{directoryservice.DirectoryConfigurationStatusUpdated},
+		Refresh: statusSettings(ctx, conn, directoryID, names),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]*directoryservice.SettingEntry); ok {
+		var errs []error
+
+		for _, v := range output {
+			if aws.StringValue(v.RequestStatus) == directoryservice.DirectoryConfigurationStatusFailed && slices.Contains(names, aws.StringValue(v.Name)) {
+				errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Name), aws.StringValue(v.RequestStatusMessage)))
+			}
+		}
+
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitRegionCreated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, regionName string, timeout time.Duration) (*directoryservice.RegionDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{directoryservice.DirectoryStageRequested, directoryservice.DirectoryStageCreating, directoryservice.DirectoryStageCreated},
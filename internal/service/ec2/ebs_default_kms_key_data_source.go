@@ -7,11 +7,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 )
 
 // @SDKDataSource("aws_ebs_default_kms_key")
@@ -28,6 +30,14 @@ func DataSourceEBSDefaultKMSKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"target_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -40,8 +50,16 @@ func dataSourceEBSDefaultKMSKeyRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading EBS default KMS key: %s", err)
 	}
 
+	// The default key may be an alias, such as the AWS managed alias/aws/ebs. Resolve it to the target key.
+	key, err := tfkms.FindKeyByID(ctx, meta.(*conns.AWSClient).KMSConn(ctx), aws.StringValue(res.KmsKeyId))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS default KMS key (%s): %s", aws.StringValue(res.KmsKeyId), err)
+	}
+
 	d.SetId(meta.(*conns.AWSClient).Region)
 	d.Set("key_arn", res.KmsKeyId)
+	d.Set("target_key_arn", key.Arn)
+	d.Set("target_key_id", key.KeyId)
 
 	return diags
 }
@@ -6,6 +6,7 @@ package ec2_test
 import (
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -22,6 +23,8 @@ func TestAccEC2EBSDefaultKMSKeyDataSource_basic(t *testing.T) {
 				Config: testAccEBSDefaultKMSKeyDataSourceConfig_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEBSDefaultKMSKey(ctx, "data.aws_ebs_default_kms_key.current"),
+					acctest.MatchResourceAttrRegionalARN("data.aws_ebs_default_kms_key.current", "target_key_arn", "kms", regexache.MustCompile(`key/.+`)),
+					resource.TestCheckResourceAttrSet("data.aws_ebs_default_kms_key.current", "target_key_id"),
 				),
 			},
 		},
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ec2_allowed_images_settings", name="Allowed Images Settings")
+func ResourceAllowedImagesSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAllowedImagesSettingsPut,
+		ReadWithoutTimeout:   resourceAllowedImagesSettingsRead,
+		UpdateWithoutTimeout: resourceAllowedImagesSettingsPut,
+		DeleteWithoutTimeout: resourceAllowedImagesSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_criterion": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_providers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 200,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(allowedImagesSettingsState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAllowedImagesSettingsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.IsNewResource() || d.HasChange("state") {
+		state := d.Get("state").(string)
+
+		if slices.Contains(allowedImagesSettingsEnabledState_Values(), state) {
+			input := &ec2.EnableAllowedImagesSettingsInput{
+				AllowedImagesSettingsState: types.AllowedImagesSettingsEnabledState(state),
+			}
+
+			_, err := conn.EnableAllowedImagesSettings(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "enabling EC2 Allowed Images Settings: %s", err)
+			}
+		} else {
+			input := &ec2.DisableAllowedImagesSettingsInput{}
+
+			_, err := conn.DisableAllowedImagesSettings(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disabling EC2 Allowed Images Settings: %s", err)
+			}
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("image_criterion") {
+		input := &ec2.ReplaceImageCriteriaInAllowedImagesSettingsInput{
+			ImageCriteria: expandImageCriterionRequests(d.Get("image_criterion").([]interface{})),
+		}
+
+		_, err := conn.ReplaceImageCriteriaInAllowedImagesSettings(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "replacing EC2 Allowed Images Settings image criteria: %s", err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return append(diags, resourceAllowedImagesSettingsRead(ctx, d, meta)...)
+}
+
+func resourceAllowedImagesSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	output, err := FindAllowedImagesSettings(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Allowed Images Settings %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Allowed Images Settings (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("image_criterion", flattenImageCriteria(output.ImageCriteria)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting image_criterion: %s", err)
+	}
+	d.Set("managed_by", output.ManagedBy)
+	d.Set("state", output.State)
+
+	return diags
+}
+
+func resourceAllowedImagesSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Leaving Allowed AMIs enabled would keep restricting instance launches, so restore the defaults.
+	log.Printf("[DEBUG] Disabling EC2 Allowed Images Settings: %s", d.Id())
+	_, err := conn.DisableAllowedImagesSettings(ctx, &ec2.DisableAllowedImagesSettingsInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling EC2 Allowed Images Settings: %s", err)
+	}
+
+	_, err = conn.ReplaceImageCriteriaInAllowedImagesSettings(ctx, &ec2.ReplaceImageCriteriaInAllowedImagesSettingsInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "removing EC2 Allowed Images Settings image criteria: %s", err)
+	}
+
+	return diags
+}
+
+func expandImageCriterionRequests(tfList []interface{}) []types.ImageCriterionRequest {
+	var apiObjects []types.ImageCriterionRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.ImageCriterionRequest{}
+
+		if v, ok := tfMap["image_providers"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ImageProviders = flex.ExpandStringValueSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenImageCriteria(apiObjects []types.ImageCriterion) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"image_providers": flex.FlattenStringValueList(apiObject.ImageProviders),
+		})
+	}
+
+	return tfList
+}
+
+func allowedImagesSettingsDisabledState_Values() []string {
+	return enum.Values[types.AllowedImagesSettingsDisabledState]()
+}
+
+func allowedImagesSettingsEnabledState_Values() []string {
+	return enum.Values[types.AllowedImagesSettingsEnabledState]()
+}
+
+func allowedImagesSettingsState_Values() []string {
+	return append(allowedImagesSettingsEnabledState_Values(), allowedImagesSettingsDisabledState_Values()...)
+}
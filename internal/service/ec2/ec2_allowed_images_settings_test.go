@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2AllowedImagesSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic":          testAccAllowedImagesSettings_basic,
+		"imageCriterion": testAccAllowedImagesSettings_imageCriterion,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAllowedImagesSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_allowed_images_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowedImagesSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowedImagesSettingsConfig_basic("audit-mode"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "managed_by", "account"),
+					resource.TestCheckResourceAttr(resourceName, "state", "audit-mode"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowedImagesSettingsConfig_basic("disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAllowedImagesSettings_imageCriterion(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_allowed_images_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAllowedImagesSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowedImagesSettingsConfig_imageCriterion(`"amazon"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.0.image_providers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_criterion.0.image_providers.*", "amazon"),
+					resource.TestCheckResourceAttr(resourceName, "state", "audit-mode"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowedImagesSettingsConfig_imageCriterion(`"amazon", "aws-marketplace"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_criterion.0.image_providers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_criterion.0.image_providers.*", "amazon"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_criterion.0.image_providers.*", "aws-marketplace"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAllowedImagesSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_allowed_images_settings" {
+				continue
+			}
+
+			output, err := tfec2.FindAllowedImagesSettings(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if state := *output.State; state != "disabled" {
+				return fmt.Errorf("EC2 Allowed Images Settings %s still in state %s", rs.Primary.ID, state)
+			}
+
+			if len(output.ImageCriteria) > 0 {
+				return fmt.Errorf("EC2 Allowed Images Settings %s still has image criteria", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAllowedImagesSettingsConfig_basic(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_allowed_images_settings" "test" {
+  state = %[1]q
+}
+`, state)
+}
+
+func testAccAllowedImagesSettingsConfig_imageCriterion(imageProviders string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_allowed_images_settings" "test" {
+  state = "audit-mode"
+
+  image_criterion {
+    image_providers = [%[1]s]
+  }
+}
+`, imageProviders)
+}
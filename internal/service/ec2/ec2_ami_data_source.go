@@ -128,6 +128,10 @@ func DataSourceAMI() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_launched_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"most_recent": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -320,6 +324,16 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("image_type", image.ImageType)
 	d.Set("imds_support", image.ImdsSupport)
 	d.Set("kernel_id", image.KernelId)
+	// The last launched time is only available to the image owner.
+	if aws.StringValue(image.OwnerId) == meta.(*conns.AWSClient).AccountID {
+		lastLaunchedTime, err := FindImageLastLaunchedTimeByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 AMI (%s) last launched time: %s", d.Id(), err)
+		}
+
+		d.Set("last_launched_time", lastLaunchedTime)
+	}
 	d.Set("name", image.Name)
 	d.Set("owner_id", image.OwnerId)
 	d.Set("platform", image.Platform)
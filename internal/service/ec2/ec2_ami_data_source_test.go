@@ -45,6 +45,7 @@ func TestAccEC2AMIDataSource_natInstance(t *testing.T) {
 					resource.TestCheckResourceAttr(datasourceName, "image_owner_alias", "amazon"),
 					resource.TestCheckResourceAttr(datasourceName, "image_type", "machine"),
 					resource.TestCheckResourceAttr(datasourceName, "imds_support", ""),
+					resource.TestCheckResourceAttr(datasourceName, "last_launched_time", ""),
 					resource.TestCheckResourceAttr(datasourceName, "most_recent", "true"),
 					resource.TestMatchResourceAttr(datasourceName, "name", regexache.MustCompile("^amzn-ami-vpc-nat")),
 					acctest.MatchResourceAttrAccountID(datasourceName, "owner_id"),
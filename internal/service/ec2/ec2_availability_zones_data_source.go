@@ -53,6 +53,11 @@ func DataSourceAvailabilityZones() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"network_border_groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"state": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -113,11 +118,13 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 	excludeZoneIDs := d.Get("exclude_zone_ids").(*schema.Set)
 
 	groupNames := schema.NewSet(schema.HashString, nil)
+	networkBorderGroups := schema.NewSet(schema.HashString, nil)
 	names := []string{}
 	zoneIds := []string{}
 	for _, v := range resp.AvailabilityZones {
 		groupName := aws.StringValue(v.GroupName)
 		name := aws.StringValue(v.ZoneName)
+		networkBorderGroup := aws.StringValue(v.NetworkBorderGroup)
 		zoneID := aws.StringValue(v.ZoneId)
 
 		if excludeNames.Contains(name) {
@@ -132,6 +139,10 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 			groupNames.Add(groupName)
 		}
 
+		if networkBorderGroup != "" && !networkBorderGroups.Contains(networkBorderGroup) {
+			networkBorderGroups.Add(networkBorderGroup)
+		}
+
 		names = append(names, name)
 		zoneIds = append(zoneIds, zoneID)
 	}
@@ -144,6 +155,9 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 	if err := d.Set("names", names); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting Availability Zone names: %s", err)
 	}
+	if err := d.Set("network_border_groups", networkBorderGroups); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting network_border_groups: %s", err)
+	}
 	if err := d.Set("zone_ids", zoneIds); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting Availability Zone IDs: %s", err)
 	}
@@ -110,6 +110,7 @@ func TestAccEC2AvailabilityZonesDataSource_allAvailabilityZones(t *testing.T) {
 				Config: testAccAvailabilityZonesDataSourceConfig_all(),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAvailabilityZonesMeta(dataSourceName),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "network_border_groups.#", 1),
 				),
 			},
 		},
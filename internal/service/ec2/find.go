@@ -940,6 +940,26 @@ func FindImageLaunchPermissionsByID(ctx context.Context, conn *ec2.EC2, id strin
 	return output.LaunchPermissions, nil
 }
 
+func FindImageLastLaunchedTimeByID(ctx context.Context, conn *ec2.EC2, id string) (string, error) {
+	input := &ec2.DescribeImageAttributeInput{
+		Attribute: aws.String(ec2.ImageAttributeNameLastLaunchedTime),
+		ImageId:   aws.String(id),
+	}
+
+	output, err := FindImageAttribute(ctx, conn, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	// The attribute is absent if the image has never been used to launch an instance.
+	if output.LastLaunchedTime == nil {
+		return "", nil
+	}
+
+	return aws.StringValue(output.LastLaunchedTime.Value), nil
+}
+
 func FindImageLaunchPermission(ctx context.Context, conn *ec2.EC2, imageID, accountID, group, organizationARN, organizationalUnitARN string) (*ec2.LaunchPermission, error) {
 	output, err := FindImageLaunchPermissionsByID(ctx, conn, imageID)
 
@@ -6818,6 +6838,21 @@ func FindImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client
 	return output.ImageBlockPublicAccessState, nil
 }
 
+func FindAllowedImagesSettings(ctx context.Context, conn *ec2_sdkv2.Client) (*ec2_sdkv2.GetAllowedImagesSettingsOutput, error) {
+	input := &ec2_sdkv2.GetAllowedImagesSettingsInput{}
+	output, err := conn.GetAllowedImagesSettings(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.State == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindVerifiedAccessEndpoint(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeVerifiedAccessEndpointsInput) (*awstypes.VerifiedAccessEndpoint, error) {
 	output, err := FindVerifiedAccessEndpoints(ctx, conn, input)
 
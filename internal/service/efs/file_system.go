@@ -139,6 +139,7 @@ func ResourceFileSystem() *schema.Resource {
 								efs.ReplicationOverwriteProtectionEnabled,
 								efs.ReplicationOverwriteProtectionDisabled,
 							}, false),
+							DiffSuppressFunc: suppressReplicationOverwriteProtectionReplicating,
 						},
 					},
 				},
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package efs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_efs_file_system_protection", name="File System Protection")
+func ResourceFileSystemProtection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFileSystemProtectionCreate,
+		ReadWithoutTimeout:   resourceFileSystemProtectionRead,
+		UpdateWithoutTimeout: resourceFileSystemProtectionUpdate,
+		DeleteWithoutTimeout: resourceFileSystemProtectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"file_system_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"replication_overwrite": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					efs.ReplicationOverwriteProtectionEnabled,
+					efs.ReplicationOverwriteProtectionDisabled,
+				}, false),
+				DiffSuppressFunc: suppressReplicationOverwriteProtectionReplicating,
+			},
+		},
+	}
+}
+
+func resourceFileSystemProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EFSConn(ctx)
+
+	fsID := d.Get("file_system_id").(string)
+	input := &efs.UpdateFileSystemProtectionInput{
+		FileSystemId:                   aws.String(fsID),
+		ReplicationOverwriteProtection: aws.String(d.Get("replication_overwrite").(string)),
+	}
+
+	_, err := conn.UpdateFileSystemProtectionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EFS File System Protection (%s): %s", fsID, err)
+	}
+
+	d.SetId(fsID)
+
+	return append(diags, resourceFileSystemProtectionRead(ctx, d, meta)...)
+}
+
+func resourceFileSystemProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EFSConn(ctx)
+
+	fs, err := FindFileSystemByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EFS File System Protection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EFS File System Protection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("file_system_id", fs.FileSystemId)
+	if fs.FileSystemProtection != nil {
+		d.Set("replication_overwrite", fs.FileSystemProtection.ReplicationOverwriteProtection)
+	}
+
+	return diags
+}
+
+func resourceFileSystemProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EFSConn(ctx)
+
+	input := &efs.UpdateFileSystemProtectionInput{
+		FileSystemId:                   aws.String(d.Id()),
+		ReplicationOverwriteProtection: aws.String(d.Get("replication_overwrite").(string)),
+	}
+
+	_, err := conn.UpdateFileSystemProtectionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EFS File System Protection (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFileSystemProtectionRead(ctx, d, meta)...)
+}
+
+func resourceFileSystemProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EFSConn(ctx)
+
+	fs, err := FindFileSystemByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EFS File System Protection (%s): %s", d.Id(), err)
+	}
+
+	// The protection of a replication destination can't be changed until replication is deleted.
+	if fs.FileSystemProtection != nil && aws.StringValue(fs.FileSystemProtection.ReplicationOverwriteProtection) == efs.ReplicationOverwriteProtectionReplicating {
+		return diags
+	}
+
+	// Restore the default protection.
+	log.Printf("[DEBUG] Deleting EFS File System Protection: %s", d.Id())
+	_, err = conn.UpdateFileSystemProtectionWithContext(ctx, &efs.UpdateFileSystemProtectionInput{
+		FileSystemId:                   aws.String(d.Id()),
+		ReplicationOverwriteProtection: aws.String(efs.ReplicationOverwriteProtectionEnabled),
+	})
+
+	if tfawserr.ErrCodeEquals(err, efs.ErrCodeFileSystemNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EFS File System Protection (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// suppressReplicationOverwriteProtectionReplicating suppresses the diff when overwrite protection was disabled
+// and the file system has since become a replication destination.
+func suppressReplicationOverwriteProtectionReplicating(k, old, new string, d *schema.ResourceData) bool {
+	return old == efs.ReplicationOverwriteProtectionReplicating && new == efs.ReplicationOverwriteProtectionDisabled
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package efs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfefs "github.com/hashicorp/terraform-provider-aws/internal/service/efs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEFSFileSystemProtection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_efs_file_system_protection.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFileSystemProtectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileSystemProtectionConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileSystemProtection(ctx, resourceName, "DISABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "file_system_id", "aws_efs_file_system.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "replication_overwrite", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFileSystemProtectionConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileSystemProtection(ctx, resourceName, "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "replication_overwrite", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFileSystemProtection(ctx context.Context, n, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn(ctx)
+
+		output, err := tfefs.FindFileSystemByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.FileSystemProtection.ReplicationOverwriteProtection); got != want {
+			return fmt.Errorf("EFS File System (%s) replication overwrite protection is %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+// Destroying the resource restores the default protection.
+func testAccCheckFileSystemProtectionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EFSConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_efs_file_system_protection" {
+				continue
+			}
+
+			output, err := tfefs.FindFileSystemByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if got := aws.StringValue(output.FileSystemProtection.ReplicationOverwriteProtection); got != "ENABLED" {
+				return fmt.Errorf("EFS File System (%s) replication overwrite protection is %s", rs.Primary.ID, got)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccFileSystemProtectionConfig_basic(rName, replicationOverwrite string) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = %[1]q
+}
+
+resource "aws_efs_file_system_protection" "test" {
+  file_system_id        = aws_efs_file_system.test.id
+  replication_overwrite = %[2]q
+}
+`, rName, replicationOverwrite)
+}
@@ -70,6 +70,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceFileSystemPolicy,
 			TypeName: "aws_efs_file_system_policy",
 		},
+		{
+			Factory:  ResourceFileSystemProtection,
+			TypeName: "aws_efs_file_system_protection",
+			Name:     "File System Protection",
+		},
 		{
 			Factory:  ResourceMountTarget,
 			TypeName: "aws_efs_mount_target",
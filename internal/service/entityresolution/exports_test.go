@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+// Exports for use in tests only.
+var (
+	ResourceIDMappingWorkflow = resourceIDMappingWorkflow
+	ResourceMatchingWorkflow  = resourceMatchingWorkflow
+	ResourcePolicyStatement   = resourcePolicyStatement
+
+	FindIDMappingWorkflowByName     = findIDMappingWorkflowByName
+	FindMatchingWorkflowByName      = findMatchingWorkflowByName
+	FindPolicyStatementByTwoPartKey = findPolicyStatementByTwoPartKey
+)
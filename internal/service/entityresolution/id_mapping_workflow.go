@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/entityresolution/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_id_mapping_workflow", name="ID Mapping Workflow")
+// @Tags(identifierAttribute="arn")
+func resourceIDMappingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIDMappingWorkflowCreate,
+		ReadWithoutTimeout:   resourceIDMappingWorkflowRead,
+		UpdateWithoutTimeout: resourceIDMappingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceIDMappingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"id_mapping_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id_mapping_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.IdMappingType](),
+						},
+						"provider_properties": providerPropertiesSchema(),
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.IdNamespaceType](),
+						},
+					},
+				},
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workflow_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIDMappingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	name := d.Get("workflow_name").(string)
+	input := &entityresolution.CreateIdMappingWorkflowInput{
+		InputSourceConfig:  expandIDMappingWorkflowInputSources(d.Get("input_source_config").([]interface{})),
+		OutputSourceConfig: expandIDMappingWorkflowOutputSources(d.Get("output_source_config").([]interface{})),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+		Tags:               getTagsIn(ctx),
+		WorkflowName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("id_mapping_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IdMappingTechniques = expandIDMappingTechniques(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateIdMappingWorkflow(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution ID Mapping Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceIDMappingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceIDMappingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	output, err := findIDMappingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution ID Mapping Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.WorkflowArn)
+	d.Set("description", output.Description)
+	if output.IdMappingTechniques != nil {
+		if err := d.Set("id_mapping_techniques", []interface{}{flattenIDMappingTechniques(output.IdMappingTechniques)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting id_mapping_techniques: %s", err)
+		}
+	} else {
+		d.Set("id_mapping_techniques", nil)
+	}
+	if err := d.Set("input_source_config", flattenIDMappingWorkflowInputSources(output.InputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_source_config: %s", err)
+	}
+	if err := d.Set("output_source_config", flattenIDMappingWorkflowOutputSources(output.OutputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_source_config: %s", err)
+	}
+	d.Set("role_arn", output.RoleArn)
+	d.Set("workflow_name", output.WorkflowName)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceIDMappingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// UpdateIdMappingWorkflow replaces the whole workflow definition.
+		input := &entityresolution.UpdateIdMappingWorkflowInput{
+			InputSourceConfig:  expandIDMappingWorkflowInputSources(d.Get("input_source_config").([]interface{})),
+			OutputSourceConfig: expandIDMappingWorkflowOutputSources(d.Get("output_source_config").([]interface{})),
+			RoleArn:            aws.String(d.Get("role_arn").(string)),
+			WorkflowName:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("id_mapping_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.IdMappingTechniques = expandIDMappingTechniques(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateIdMappingWorkflow(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceIDMappingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceIDMappingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Entity Resolution ID Mapping Workflow: %s", d.Id())
+	_, err := conn.DeleteIdMappingWorkflow(ctx, &entityresolution.DeleteIdMappingWorkflowInput{
+		WorkflowName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIDMappingWorkflowByName(ctx context.Context, conn *entityresolution.Client, name string) (*entityresolution.GetIdMappingWorkflowOutput, error) {
+	input := &entityresolution.GetIdMappingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetIdMappingWorkflow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandIDMappingTechniques(tfMap map[string]interface{}) *awstypes.IdMappingTechniques {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.IdMappingTechniques{}
+
+	if v, ok := tfMap["id_mapping_type"].(string); ok && v != "" {
+		apiObject.IdMappingType = awstypes.IdMappingType(v)
+	}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProviderProperties = expandProviderProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandIDMappingWorkflowInputSources(tfList []interface{}) []awstypes.IdMappingWorkflowInputSource {
+	var apiObjects []awstypes.IdMappingWorkflowInputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.IdMappingWorkflowInputSource{}
+
+		if v, ok := tfMap["input_source_arn"].(string); ok && v != "" {
+			apiObject.InputSourceARN = aws.String(v)
+		}
+
+		if v, ok := tfMap["schema_name"].(string); ok && v != "" {
+			apiObject.SchemaName = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = awstypes.IdNamespaceType(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIDMappingWorkflowOutputSources(tfList []interface{}) []awstypes.IdMappingWorkflowOutputSource {
+	var apiObjects []awstypes.IdMappingWorkflowOutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.IdMappingWorkflowOutputSource{}
+
+		if v, ok := tfMap["kms_arn"].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["output_s3_path"].(string); ok && v != "" {
+			apiObject.OutputS3Path = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIDMappingTechniques(apiObject *awstypes.IdMappingTechniques) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IdMappingType; v != "" {
+		tfMap["id_mapping_type"] = string(v)
+	}
+
+	if v := apiObject.ProviderProperties; v != nil {
+		tfMap["provider_properties"] = []interface{}{flattenProviderProperties(v)}
+	}
+
+	return tfMap
+}
+
+func flattenIDMappingWorkflowInputSources(apiObjects []awstypes.IdMappingWorkflowInputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"input_source_arn": aws.ToString(apiObject.InputSourceARN),
+			"schema_name":      aws.ToString(apiObject.SchemaName),
+			"type":             string(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenIDMappingWorkflowOutputSources(apiObjects []awstypes.IdMappingWorkflowOutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"kms_arn":        aws.ToString(apiObject.KMSArn),
+			"output_s3_path": aws.ToString(apiObject.OutputS3Path),
+		})
+	}
+
+	return tfList
+}
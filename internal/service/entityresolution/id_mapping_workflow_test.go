@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEntityResolutionIDMappingWorkflow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	schemaName := acctest.SkipIfEnvVarNotSet(t, envVarSchemaName)
+	providerServiceARN := acctest.SkipIfEnvVarNotSet(t, envVarProviderServiceARN)
+	var v entityresolution.GetIdMappingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, schemaName, providerServiceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexache.MustCompile(`idmappingworkflow/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.id_mapping_type", "PROVIDER"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.provider_properties.0.provider_service_arn", providerServiceARN),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "workflow_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDMappingWorkflow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	schemaName := acctest.SkipIfEnvVarNotSet(t, envVarSchemaName)
+	providerServiceARN := acctest.SkipIfEnvVarNotSet(t, envVarProviderServiceARN)
+	var v entityresolution.GetIdMappingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, schemaName, providerServiceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceIDMappingWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIDMappingWorkflowExists(ctx context.Context, n string, v *entityresolution.GetIdMappingWorkflowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		output, err := tfentityresolution.FindIDMappingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIDMappingWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_id_mapping_workflow" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindIDMappingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution ID Mapping Workflow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccIDMappingWorkflowConfig_basic(rName, schemaName, providerServiceARN string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_id_mapping_workflow" "test" {
+  workflow_name = %[1]q
+  role_arn      = aws_iam_role.test.arn
+
+  id_mapping_techniques {
+    id_mapping_type = "PROVIDER"
+
+    provider_properties {
+      provider_service_arn = %[3]q
+
+      intermediate_source_configuration {
+        intermediate_s3_path = "s3://${aws_s3_bucket.test.bucket}/intermediate/"
+      }
+    }
+  }
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = %[2]q
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, schemaName, providerServiceARN))
+}
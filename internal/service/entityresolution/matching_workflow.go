@@ -0,0 +1,692 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/entityresolution/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_matching_workflow", name="Matching Workflow")
+// @Tags(identifierAttribute="arn")
+func resourceMatchingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMatchingWorkflowCreate,
+		ReadWithoutTimeout:   resourceMatchingWorkflowRead,
+		UpdateWithoutTimeout: resourceMatchingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceMatchingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"incremental_run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incremental_run_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.IncrementalRunType](),
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hashed": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"resolution_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_properties": providerPropertiesSchema(),
+						"resolution_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ResolutionType](),
+						},
+						"rule_based_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.AttributeMatchingModel](),
+									},
+									"rules": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"matching_keys": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"rule_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workflow_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// providerPropertiesSchema returns the schema shared by matching and ID mapping
+// workflows for configuring a provider service integration.
+func providerPropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"intermediate_source_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"intermediate_s3_path": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"provider_service_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceMatchingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	name := d.Get("workflow_name").(string)
+	input := &entityresolution.CreateMatchingWorkflowInput{
+		InputSourceConfig:  expandInputSources(d.Get("input_source_config").([]interface{})),
+		OutputSourceConfig: expandOutputSources(d.Get("output_source_config").([]interface{})),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+		Tags:               getTagsIn(ctx),
+		WorkflowName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("resolution_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ResolutionTechniques = expandResolutionTechniques(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateMatchingWorkflow(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution Matching Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	output, err := findMatchingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Matching Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.WorkflowArn)
+	d.Set("description", output.Description)
+	if output.IncrementalRunConfig != nil {
+		if err := d.Set("incremental_run_config", []interface{}{flattenIncrementalRunConfig(output.IncrementalRunConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting incremental_run_config: %s", err)
+		}
+	} else {
+		d.Set("incremental_run_config", nil)
+	}
+	if err := d.Set("input_source_config", flattenInputSources(output.InputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_source_config: %s", err)
+	}
+	if err := d.Set("output_source_config", flattenOutputSources(output.OutputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_source_config: %s", err)
+	}
+	if output.ResolutionTechniques != nil {
+		if err := d.Set("resolution_techniques", []interface{}{flattenResolutionTechniques(output.ResolutionTechniques)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resolution_techniques: %s", err)
+		}
+	} else {
+		d.Set("resolution_techniques", nil)
+	}
+	d.Set("role_arn", output.RoleArn)
+	d.Set("workflow_name", output.WorkflowName)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceMatchingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// UpdateMatchingWorkflow replaces the whole workflow definition.
+		input := &entityresolution.UpdateMatchingWorkflowInput{
+			InputSourceConfig:  expandInputSources(d.Get("input_source_config").([]interface{})),
+			OutputSourceConfig: expandOutputSources(d.Get("output_source_config").([]interface{})),
+			RoleArn:            aws.String(d.Get("role_arn").(string)),
+			WorkflowName:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("incremental_run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("resolution_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ResolutionTechniques = expandResolutionTechniques(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateMatchingWorkflow(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Entity Resolution Matching Workflow: %s", d.Id())
+	_, err := conn.DeleteMatchingWorkflow(ctx, &entityresolution.DeleteMatchingWorkflowInput{
+		WorkflowName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMatchingWorkflowByName(ctx context.Context, conn *entityresolution.Client, name string) (*entityresolution.GetMatchingWorkflowOutput, error) {
+	input := &entityresolution.GetMatchingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetMatchingWorkflow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandIncrementalRunConfig(tfMap map[string]interface{}) *awstypes.IncrementalRunConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.IncrementalRunConfig{}
+
+	if v, ok := tfMap["incremental_run_type"].(string); ok && v != "" {
+		apiObject.IncrementalRunType = awstypes.IncrementalRunType(v)
+	}
+
+	return apiObject
+}
+
+func expandInputSources(tfList []interface{}) []awstypes.InputSource {
+	var apiObjects []awstypes.InputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.InputSource{}
+
+		if v, ok := tfMap["apply_normalization"].(bool); ok {
+			apiObject.ApplyNormalization = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["input_source_arn"].(string); ok && v != "" {
+			apiObject.InputSourceARN = aws.String(v)
+		}
+
+		if v, ok := tfMap["schema_name"].(string); ok && v != "" {
+			apiObject.SchemaName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputSources(tfList []interface{}) []awstypes.OutputSource {
+	var apiObjects []awstypes.OutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.OutputSource{}
+
+		if v, ok := tfMap["apply_normalization"].(bool); ok {
+			apiObject.ApplyNormalization = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["kms_arn"].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["output"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Output = expandOutputAttributes(v)
+		}
+
+		if v, ok := tfMap["output_s3_path"].(string); ok && v != "" {
+			apiObject.OutputS3Path = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputAttributes(tfList []interface{}) []awstypes.OutputAttribute {
+	var apiObjects []awstypes.OutputAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.OutputAttribute{}
+
+		if v, ok := tfMap["hashed"].(bool); ok {
+			apiObject.Hashed = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandResolutionTechniques(tfMap map[string]interface{}) *awstypes.ResolutionTechniques {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ResolutionTechniques{}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProviderProperties = expandProviderProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["resolution_type"].(string); ok && v != "" {
+		apiObject.ResolutionType = awstypes.ResolutionType(v)
+	}
+
+	if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RuleBasedProperties = expandRuleBasedProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandProviderProperties(tfMap map[string]interface{}) *awstypes.ProviderProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ProviderProperties{}
+
+	if v, ok := tfMap["intermediate_source_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["intermediate_s3_path"].(string); ok && v != "" {
+			apiObject.IntermediateSourceConfiguration = &awstypes.IntermediateSourceConfiguration{
+				IntermediateS3Path: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["provider_service_arn"].(string); ok && v != "" {
+		apiObject.ProviderServiceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRuleBasedProperties(tfMap map[string]interface{}) *awstypes.RuleBasedProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.RuleBasedProperties{}
+
+	if v, ok := tfMap["attribute_matching_model"].(string); ok && v != "" {
+		apiObject.AttributeMatchingModel = awstypes.AttributeMatchingModel(v)
+	}
+
+	if v, ok := tfMap["rules"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			rule := awstypes.Rule{}
+
+			if v, ok := tfMap["matching_keys"].([]interface{}); ok && len(v) > 0 {
+				rule.MatchingKeys = flex.ExpandStringValueList(v)
+			}
+
+			if v, ok := tfMap["rule_name"].(string); ok && v != "" {
+				rule.RuleName = aws.String(v)
+			}
+
+			apiObject.Rules = append(apiObject.Rules, rule)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenIncrementalRunConfig(apiObject *awstypes.IncrementalRunConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IncrementalRunType; v != "" {
+		tfMap["incremental_run_type"] = string(v)
+	}
+
+	return tfMap
+}
+
+func flattenInputSources(apiObjects []awstypes.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.ToBool(apiObject.ApplyNormalization),
+			"input_source_arn":    aws.ToString(apiObject.InputSourceARN),
+			"schema_name":         aws.ToString(apiObject.SchemaName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputSources(apiObjects []awstypes.OutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"apply_normalization": aws.ToBool(apiObject.ApplyNormalization),
+			"kms_arn":             aws.ToString(apiObject.KMSArn),
+			"output_s3_path":      aws.ToString(apiObject.OutputS3Path),
+		}
+
+		var tfOutputs []interface{}
+
+		for _, apiObject := range apiObject.Output {
+			tfOutputs = append(tfOutputs, map[string]interface{}{
+				"hashed": aws.ToBool(apiObject.Hashed),
+				"name":   aws.ToString(apiObject.Name),
+			})
+		}
+
+		tfMap["output"] = tfOutputs
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenResolutionTechniques(apiObject *awstypes.ResolutionTechniques) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ProviderProperties; v != nil {
+		tfMap["provider_properties"] = []interface{}{flattenProviderProperties(v)}
+	}
+
+	if v := apiObject.ResolutionType; v != "" {
+		tfMap["resolution_type"] = string(v)
+	}
+
+	if v := apiObject.RuleBasedProperties; v != nil {
+		tfMap["rule_based_properties"] = []interface{}{flattenRuleBasedProperties(v)}
+	}
+
+	return tfMap
+}
+
+func flattenProviderProperties(apiObject *awstypes.ProviderProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IntermediateSourceConfiguration; v != nil {
+		tfMap["intermediate_source_configuration"] = []interface{}{map[string]interface{}{
+			"intermediate_s3_path": aws.ToString(v.IntermediateS3Path),
+		}}
+	}
+
+	if v := apiObject.ProviderServiceArn; v != nil {
+		tfMap["provider_service_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenRuleBasedProperties(apiObject *awstypes.RuleBasedProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AttributeMatchingModel; v != "" {
+		tfMap["attribute_matching_model"] = string(v)
+	}
+
+	var tfRules []interface{}
+
+	for _, apiObject := range apiObject.Rules {
+		tfRules = append(tfRules, map[string]interface{}{
+			"matching_keys": apiObject.MatchingKeys,
+			"rule_name":     aws.ToString(apiObject.RuleName),
+		})
+	}
+
+	tfMap["rules"] = tfRules
+
+	return tfMap
+}
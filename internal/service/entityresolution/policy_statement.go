@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/entityresolution/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	policyStatementResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_entityresolution_policy_statement", name="Policy Statement")
+func resourcePolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePolicyStatementCreate,
+		ReadWithoutTimeout:   resourcePolicyStatementRead,
+		DeleteWithoutTimeout: resourcePolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"condition": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ForceNew:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"effect": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.StatementEffectAllow,
+				ValidateDiagFunc: enum.Validate[awstypes.StatementEffect](),
+			},
+			"principal": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"statement_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourcePolicyStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	arn, statementID := d.Get("arn").(string), d.Get("statement_id").(string)
+	id, err := flex.FlattenResourceId([]string{arn, statementID}, policyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &entityresolution.AddPolicyStatementInput{
+		Action:      flex.ExpandStringValueSet(d.Get("action").(*schema.Set)),
+		Arn:         aws.String(arn),
+		Effect:      awstypes.StatementEffect(d.Get("effect").(string)),
+		Principal:   flex.ExpandStringValueSet(d.Get("principal").(*schema.Set)),
+		StatementId: aws.String(statementID),
+	}
+
+	if v, ok := d.GetOk("condition"); ok {
+		input.Condition = aws.String(v.(string))
+	}
+
+	// Statements are added to a single resource policy, serialize changes to it.
+	conns.GlobalMutexKV.Lock(arn)
+	defer conns.GlobalMutexKV.Unlock(arn)
+
+	_, err = conn.AddPolicyStatement(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution Policy Statement (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourcePolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourcePolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), policyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	arn, statementID := parts[0], parts[1]
+	statement, err := findPolicyStatementByTwoPartKey(ctx, conn, arn, statementID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", statement.actions())
+	d.Set("arn", arn)
+	d.Set("effect", statement.Effect)
+	d.Set("statement_id", statement.Sid)
+	// The service rewrites principals and conditions into IAM policy grammar
+	// (e.g. account IDs become root ARNs), so those are kept as configured.
+
+	return diags
+}
+
+func resourcePolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), policyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	arn, statementID := parts[0], parts[1]
+
+	conns.GlobalMutexKV.Lock(arn)
+	defer conns.GlobalMutexKV.Unlock(arn)
+
+	log.Printf("[DEBUG] Deleting Entity Resolution Policy Statement: %s", d.Id())
+	_, err = conn.DeletePolicyStatement(ctx, &entityresolution.DeletePolicyStatementInput{
+		Arn:         aws.String(arn),
+		StatementId: aws.String(statementID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+type policyDocument struct {
+	Statement []policyStatement
+}
+
+type policyStatement struct {
+	Action interface{}
+	Effect string
+	Sid    string
+}
+
+// actions returns the statement's Action element, which may be a single string or a list.
+func (s policyStatement) actions() []string {
+	switch v := s.Action.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var actions []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				actions = append(actions, v)
+			}
+		}
+		return actions
+	default:
+		return nil
+	}
+}
+
+func findPolicyStatementByTwoPartKey(ctx context.Context, conn *entityresolution.Client, arn, statementID string) (*policyStatement, error) {
+	input := &entityresolution.GetPolicyInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.ToString(output.Policy) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var policy policyDocument
+
+	if err := json.Unmarshal([]byte(aws.ToString(output.Policy)), &policy); err != nil {
+		return nil, err
+	}
+
+	for _, v := range policy.Statement {
+		if v.Sid == statementID {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: statementID,
+		Message:     fmt.Sprintf("statement %q not found in Entity Resolution resource policy (%s)", statementID, arn),
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEntityResolutionPolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	schemaName := acctest.SkipIfEnvVarNotSet(t, envVarSchemaName)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStatementConfig_basic(rName, schemaName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStatementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "action.*", "entityresolution:GetMatchingWorkflow"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_entityresolution_matching_workflow.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "effect", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"condition", "principal"},
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionPolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	schemaName := acctest.SkipIfEnvVarNotSet(t, envVarSchemaName)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyStatementConfig_basic(rName, schemaName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourcePolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		_, err := tfentityresolution.FindPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["arn"], rs.Primary.Attributes["statement_id"])
+
+		return err
+	}
+}
+
+func testAccCheckPolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_policy_statement" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["arn"], rs.Primary.Attributes["statement_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPolicyStatementConfig_basic(rName, schemaName string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_basic(rName, schemaName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_entityresolution_policy_statement" "test" {
+  arn          = aws_entityresolution_matching_workflow.test.arn
+  statement_id = %[1]q
+  effect       = "Allow"
+  action       = ["entityresolution:GetMatchingWorkflow"]
+  principal    = [data.aws_caller_identity.current.account_id]
+}
+`, rName))
+}
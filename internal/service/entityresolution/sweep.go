@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_entityresolution_id_mapping_workflow", &resource.Sweeper{
+		Name: "aws_entityresolution_id_mapping_workflow",
+		F:    sweepIDMappingWorkflows,
+	})
+
+	resource.AddTestSweepers("aws_entityresolution_matching_workflow", &resource.Sweeper{
+		Name: "aws_entityresolution_matching_workflow",
+		F:    sweepMatchingWorkflows,
+	})
+}
+
+func sweepIDMappingWorkflows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.EntityResolutionClient(ctx)
+	input := &entityresolution.ListIdMappingWorkflowsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := entityresolution.NewListIdMappingWorkflowsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Entity Resolution ID Mapping Workflow sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Entity Resolution ID Mapping Workflows (%s): %w", region, err)
+		}
+
+		for _, v := range page.WorkflowSummaries {
+			r := resourceIDMappingWorkflow()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.WorkflowName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution ID Mapping Workflows (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepMatchingWorkflows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.EntityResolutionClient(ctx)
+	input := &entityresolution.ListMatchingWorkflowsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := entityresolution.NewListMatchingWorkflowsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Entity Resolution Matching Workflow sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Entity Resolution Matching Workflows (%s): %w", region, err)
+		}
+
+		for _, v := range page.WorkflowSummaries {
+			r := resourceMatchingWorkflow()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.WorkflowName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution Matching Workflows (%s): %w", region, err)
+	}
+
+	return nil
+}
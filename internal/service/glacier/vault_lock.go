@@ -5,6 +5,7 @@ package glacier
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/glacier"
 	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -28,24 +30,44 @@ func resourceVaultLock() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultLockCreate,
 		ReadWithoutTimeout:   resourceVaultLockRead,
-		UpdateWithoutTimeout: schema.NoopContext, // Allow ignore_deletion_error update.
+		UpdateWithoutTimeout: resourceVaultLockUpdate,
 		DeleteWithoutTimeout: resourceVaultLockDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"complete_lock": {
 				Type:     schema.TypeBool,
 				Required: true,
-				ForceNew: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"ignore_deletion_error": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"lock_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lock_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"policy": {
 				Type:                  schema.TypeString,
 				Required:              true,
@@ -65,6 +87,11 @@ func resourceVaultLock() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+
+		// A completed lock can't be undone, so the lock is replaced. Deleting a completed lock returns an error unless ignore_deletion_error is set.
+		CustomizeDiff: customdiff.ForceNewIfChange("complete_lock", func(_ context.Context, old, new, meta interface{}) bool {
+			return old.(bool) && !new.(bool)
+		}),
 	}
 }
 
@@ -77,43 +104,28 @@ func resourceVaultLockCreate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
 
-	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
-
-	if err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
-	}
-
 	vaultName := d.Get("vault_name").(string)
-	input := &glacier.InitiateVaultLockInput{
-		AccountId: aws.String("-"),
-		Policy: &types.VaultLockPolicy{
-			Policy: aws.String(policy),
-		},
-		VaultName: aws.String(vaultName),
-	}
-
-	output, err := conn.InitiateVaultLock(ctx, input)
+	lockID, err := initiateVaultLock(ctx, conn, vaultName, d.Get("policy").(string))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Glacier Vault Lock (%s): %s", vaultName, err)
 	}
 
 	d.SetId(vaultName)
+	d.Set("lock_id", lockID)
 
 	if d.Get("complete_lock").(bool) {
-		input := &glacier.CompleteVaultLockInput{
-			LockId:    output.LockId,
-			VaultName: aws.String(vaultName),
-		}
+		if err := completeVaultLock(ctx, conn, d.Id(), lockID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "completing Glacier Vault Lock (%s): %s", d.Id(), err)
 
-		_, err := conn.CompleteVaultLock(ctx, input)
+			// Don't leave the vault with an in-progress lock that Terraform doesn't manage.
+			if err := abortVaultLock(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "aborting Glacier Vault Lock (%s): %s", d.Id(), err)
+			}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "completing Glacier Vault Lock (%s): %s", d.Id(), err)
-		}
+			d.SetId("")
 
-		if err := waitVaultLockComplete(ctx, conn, d.Id()); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Glacier Vault Lock (%s) completion: %s", d.Id(), err)
+			return diags
 		}
 	}
 
@@ -137,6 +149,9 @@ func resourceVaultLockRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	d.Set("complete_lock", aws.ToString(output.State) == lockStateLocked)
+	d.Set("creation_date", output.CreationDate)
+	d.Set("expiration_date", output.ExpirationDate)
+	d.Set("lock_state", output.State)
 	d.Set("vault_name", d.Id())
 
 	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.ToString(output.Policy))
@@ -150,14 +165,44 @@ func resourceVaultLockRead(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func resourceVaultLockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	// Complete a lock that has been tested.
+	if d.HasChange("complete_lock") && d.Get("complete_lock").(bool) && d.Get("lock_state").(string) == lockStateInProgress {
+		lockID := d.Get("lock_id").(string)
+
+		// The lock ID of an imported lock isn't known, so the lock is initiated again with the same policy.
+		if lockID == "" {
+			if err := abortVaultLock(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "aborting Glacier Vault Lock (%s): %s", d.Id(), err)
+			}
+
+			var err error
+			lockID, err = initiateVaultLock(ctx, conn, d.Id(), d.Get("policy").(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "initiating Glacier Vault Lock (%s): %s", d.Id(), err)
+			}
+
+			d.Set("lock_id", lockID)
+		}
+
+		if err := completeVaultLock(ctx, conn, d.Id(), lockID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "completing Glacier Vault Lock (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceVaultLockRead(ctx, d, meta)...)
+}
+
 func resourceVaultLockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
 
 	log.Printf("[DEBUG] Deleting Glacier Vault Lock: %s", d.Id())
-	_, err := conn.AbortVaultLock(ctx, &glacier.AbortVaultLockInput{
-		VaultName: aws.String(d.Id()),
-	})
+	err := abortVaultLock(ctx, conn, d.Id())
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
 		return diags
@@ -170,6 +215,63 @@ func resourceVaultLockDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// initiateVaultLock starts testing the specified vault lock policy, returning the lock ID used to complete the lock.
+func initiateVaultLock(ctx context.Context, conn *glacier.Client, vaultName, policy string) (string, error) {
+	policy, err := structure.NormalizeJsonString(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	input := &glacier.InitiateVaultLockInput{
+		AccountId: aws.String("-"),
+		Policy: &types.VaultLockPolicy{
+			Policy: aws.String(policy),
+		},
+		VaultName: aws.String(vaultName),
+	}
+
+	output, err := conn.InitiateVaultLock(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.LockId), nil
+}
+
+func completeVaultLock(ctx context.Context, conn *glacier.Client, vaultName, lockID string, timeout time.Duration) error {
+	input := &glacier.CompleteVaultLockInput{
+		AccountId: aws.String("-"),
+		LockId:    aws.String(lockID),
+		VaultName: aws.String(vaultName),
+	}
+
+	_, err := conn.CompleteVaultLock(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if err := waitVaultLockComplete(ctx, conn, vaultName, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+// abortVaultLock stops testing a vault lock policy. Completed locks can't be aborted.
+func abortVaultLock(ctx context.Context, conn *glacier.Client, vaultName string) error {
+	input := &glacier.AbortVaultLockInput{
+		AccountId: aws.String("-"),
+		VaultName: aws.String(vaultName),
+	}
+
+	_, err := conn.AbortVaultLock(ctx, input)
+
+	return err
+}
+
 func findVaultLockByName(ctx context.Context, conn *glacier.Client, name string) (*glacier.GetVaultLockOutput, error) {
 	input := &glacier.GetVaultLockInput{
 		AccountId: aws.String("-"),
@@ -212,12 +314,12 @@ func statusLockState(ctx context.Context, conn *glacier.Client, name string) ret
 	}
 }
 
-func waitVaultLockComplete(ctx context.Context, conn *glacier.Client, name string) error {
+func waitVaultLockComplete(ctx context.Context, conn *glacier.Client, name string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{lockStateInProgress},
 		Target:  []string{lockStateLocked},
 		Refresh: statusLockState(ctx, conn, name),
-		Timeout: 5 * time.Minute,
+		Timeout: timeout,
 	}
 
 	_, err := stateConf.WaitForStateContext(ctx)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glacier"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -37,7 +38,10 @@ func TestAccGlacierVaultLock_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock1),
 					resource.TestCheckResourceAttr(resourceName, "complete_lock", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
 					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_id"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "InProgress"),
 					resource.TestCheckResourceAttrSet(resourceName, "policy"),
 					resource.TestCheckResourceAttrPair(resourceName, "vault_name", vaultResourceName, "name"),
 				),
@@ -46,7 +50,7 @@ func TestAccGlacierVaultLock_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_deletion_error"},
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id"},
 			},
 		},
 	})
@@ -71,6 +75,7 @@ func TestAccGlacierVaultLock_completeLock(t *testing.T) {
 					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock1),
 					resource.TestCheckResourceAttr(resourceName, "complete_lock", "true"),
 					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", "true"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "Locked"),
 					resource.TestCheckResourceAttrSet(resourceName, "policy"),
 					resource.TestCheckResourceAttrPair(resourceName, "vault_name", vaultResourceName, "name"),
 				),
@@ -79,7 +84,40 @@ func TestAccGlacierVaultLock_completeLock(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_deletion_error"},
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id"},
+			},
+		},
+	})
+}
+
+func TestAccGlacierVaultLock_completeLockUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vaultLock1, vaultLock2 glacier.GetVaultLockOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_vault_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultLockConfig_complete(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock1),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "false"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "InProgress"),
+				),
+			},
+			{
+				Config: testAccVaultLockConfig_complete(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock2),
+					testAccCheckVaultLockNotRecreated(&vaultLock1, &vaultLock2),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "true"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "Locked"),
+				),
 			},
 		},
 	})
@@ -141,6 +179,16 @@ func testAccCheckVaultLockExists(ctx context.Context, n string, v *glacier.GetVa
 	}
 }
 
+func testAccCheckVaultLockNotRecreated(i, j *glacier.GetVaultLockOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(i.CreationDate) != aws.ToString(j.CreationDate) {
+			return fmt.Errorf("Glacier Vault Lock was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckVaultLockDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierClient(ctx)
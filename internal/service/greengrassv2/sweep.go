@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_greengrassv2_component_version", &resource.Sweeper{
+		Name: "aws_greengrassv2_component_version",
+		F:    sweepComponentVersions,
+		Dependencies: []string{
+			"aws_greengrassv2_deployment",
+		},
+	})
+
+	resource.AddTestSweepers("aws_greengrassv2_deployment", &resource.Sweeper{
+		Name: "aws_greengrassv2_deployment",
+		F:    sweepDeployments,
+	})
+}
+
+func sweepComponentVersions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.GreengrassV2Client(ctx)
+	input := &greengrassv2.ListComponentsInput{
+		Scope: awstypes.ComponentVisibilityScopePrivate,
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := greengrassv2.NewListComponentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Greengrass V2 Component Version sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Greengrass V2 Component Versions (%s): %w", region, err)
+		}
+
+		for _, v := range page.Components {
+			input := &greengrassv2.ListComponentVersionsInput{
+				Arn: v.Arn,
+			}
+
+			pages := greengrassv2.NewListComponentVersionsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					log.Printf("[WARN] Skipping Greengrass V2 Component (%s) versions: %s", aws.ToString(v.Arn), err)
+					break
+				}
+
+				for _, v := range page.ComponentVersions {
+					r := resourceComponentVersion()
+					d := r.Data(nil)
+					d.SetId(aws.ToString(v.Arn))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Greengrass V2 Component Versions (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeployments(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.GreengrassV2Client(ctx)
+	input := &greengrassv2.ListDeploymentsInput{
+		HistoryFilter: awstypes.DeploymentHistoryFilterAll,
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := greengrassv2.NewListDeploymentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Greengrass V2 Deployment sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Greengrass V2 Deployments (%s): %w", region, err)
+		}
+
+		for _, v := range page.Deployments {
+			r := resourceDeployment()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.DeploymentId))
+			d.Set("deployment_status", v.DeploymentStatus)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Greengrass V2 Deployments (%s): %w", region, err)
+	}
+
+	return nil
+}
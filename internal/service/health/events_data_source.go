@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/health/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_health_events", name="Events")
+func dataSourceEvents() *schema.Resource {
+	dateTimeRangeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidUTCTimestamp,
+					},
+					"to": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidUTCTimestamp,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"end_time": dateTimeRangeSchema(),
+			"entity_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"entity_values": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_status_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.EventStatusCode](),
+				},
+			},
+			"event_type_categories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.EventTypeCategory](),
+				},
+			},
+			"event_type_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_scope_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"last_updated_time": dateTimeRangeSchema(),
+			"regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"start_time": dateTimeRangeSchema(),
+		},
+	}
+}
+
+func dataSourceEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthClient(ctx)
+
+	filter := &awstypes.EventFilter{}
+
+	if v, ok := d.GetOk("availability_zones"); ok && v.(*schema.Set).Len() > 0 {
+		filter.AvailabilityZones = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("end_time"); ok && len(v.([]interface{})) > 0 {
+		filter.EndTimes = expandDateTimeRanges(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("entity_arns"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EntityArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("entity_values"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EntityValues = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_arns"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_status_codes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventStatusCodes = flex.ExpandStringyValueSet[awstypes.EventStatusCode](v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_type_categories"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventTypeCategories = flex.ExpandStringyValueSet[awstypes.EventTypeCategory](v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("event_type_codes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.EventTypeCodes = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("last_updated_time"); ok && len(v.([]interface{})) > 0 {
+		filter.LastUpdatedTimes = expandDateTimeRanges(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("regions"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Regions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("services"); ok && v.(*schema.Set).Len() > 0 {
+		filter.Services = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("start_time"); ok && len(v.([]interface{})) > 0 {
+		filter.StartTimes = expandDateTimeRanges(v.([]interface{}))
+	}
+
+	input := &health.DescribeEventsInput{
+		Filter: filter,
+	}
+
+	events, err := findEvents(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Health Events: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	if err := d.Set("events", flattenEvents(events)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting events: %s", err)
+	}
+
+	return diags
+}
+
+func findEvents(ctx context.Context, conn *health.Client, input *health.DescribeEventsInput) ([]awstypes.Event, error) {
+	var output []awstypes.Event
+
+	pages := health.NewDescribeEventsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Events...)
+	}
+
+	return output, nil
+}
+
+func expandDateTimeRanges(tfList []interface{}) []awstypes.DateTimeRange {
+	var apiObjects []awstypes.DateTimeRange
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.DateTimeRange{}
+
+		if v, ok := tfMap["from"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.From = aws.Time(v)
+		}
+
+		if v, ok := tfMap["to"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.To = aws.Time(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEvents(apiObjects []awstypes.Event) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":                 aws.ToString(apiObject.Arn),
+			"availability_zone":   aws.ToString(apiObject.AvailabilityZone),
+			"event_scope_code":    string(apiObject.EventScopeCode),
+			"event_type_category": string(apiObject.EventTypeCategory),
+			"event_type_code":     aws.ToString(apiObject.EventTypeCode),
+			"region":              aws.ToString(apiObject.Region),
+			"service":             aws.ToString(apiObject.Service),
+			"status_code":         string(apiObject.StatusCode),
+		}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastUpdatedTime; v != nil {
+			tfMap["last_updated_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartTime; v != nil {
+			tfMap["start_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
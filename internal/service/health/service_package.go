@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/health"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*health.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
+
+	return health.NewFromConfig(cfg, func(o *health.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		} else if config["partition"].(string) == names.StandardPartitionID {
+			// AWS Health's global endpoint is in the AWS Commercial us-east-1 Region.
+			// https://docs.aws.amazon.com/health/latest/ug/health-api.html#endpoints.
+			o.Region = names.USEast1RegionID
+		}
+	}), nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -58,13 +59,16 @@ func resourceGroup() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-			if d.HasChanges("name", "path") {
-				return d.SetNewComputed("arn")
-			}
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.HasChanges("name", "path") {
+					return d.SetNewComputed("arn")
+				}
 
-			return nil
-		},
+				return nil
+			},
+			customizeDiffPathLen,
+		),
 	}
 }
 
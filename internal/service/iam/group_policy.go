@@ -68,6 +68,8 @@ func resourceGroupPolicy() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customizeDiffPolicySize("policy", "group aggregate inline policy size", groupInlinePolicySizeMax),
 	}
 }
 
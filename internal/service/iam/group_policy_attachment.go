@@ -22,10 +22,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_iam_group_policy_attachment", name="Group Policy Attachment")
+// @Found(func="groupPolicyAttachmentFound", timeout="propagationTimeout")
 func resourceGroupPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupPolicyAttachmentCreate,
@@ -66,7 +68,7 @@ func resourceGroupPolicyAttachmentCreate(ctx context.Context, d *schema.Resource
 	//lintignore:R016 // Allow legacy unstable ID usage in managed resource
 	d.SetId(id.PrefixedUniqueId(fmt.Sprintf("%s-", group)))
 
-	return append(diags, resourceGroupPolicyAttachmentRead(ctx, d, meta)...)
+	return diags
 }
 
 func resourceGroupPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -78,9 +80,7 @@ func resourceGroupPolicyAttachmentRead(ctx context.Context, d *schema.ResourceDa
 	// Human friendly ID for error messages since d.Id() is non-descriptive.
 	id := fmt.Sprintf("%s:%s", group, policyARN)
 
-	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
-		return findAttachedGroupPolicyByTwoPartKey(ctx, conn, group, policyARN)
-	}, d.IsNewResource())
+	_, err := findAttachedGroupPolicyByTwoPartKey(ctx, conn, group, policyARN)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] IAM Group Policy Attachment (%s) not found, removing from state", id)
@@ -106,6 +106,15 @@ func resourceGroupPolicyAttachmentDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+// groupPolicyAttachmentFound returns an error for which tfresource.NotFound is true until a new attachment is visible.
+func groupPolicyAttachmentFound(ctx context.Context, d types.SDKResourceData, meta any) error {
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	_, err := findAttachedGroupPolicyByTwoPartKey(ctx, conn, d.Get("group").(string), d.Get("policy_arn").(string))
+
+	return err
+}
+
 func resourceGroupPolicyAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.SplitN(d.Id(), "/", 2)
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -63,6 +64,11 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"minify": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
 				"override_json": {
 					Type:         schema.TypeString,
@@ -89,6 +95,10 @@ func dataSourcePolicyDocument() *schema.Resource {
 					ValidateFunc: validation.StringIsEmpty,
 					Deprecated:   "Not used",
 				},
+				"size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
 				"source_policy_documents": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -126,6 +136,18 @@ func dataSourcePolicyDocument() *schema.Resource {
 									},
 								},
 							},
+							"context": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"principal_org_ids": setOfStringSchema(),
+										"source_accounts":   setOfStringSchema(),
+										"source_arns":       setOfStringSchema(),
+									},
+								},
+							},
 							"effect": {
 								Type:         schema.TypeString,
 								Optional:     true,
@@ -144,6 +166,11 @@ func dataSourcePolicyDocument() *schema.Resource {
 						},
 					},
 				},
+				"validate": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[policyDocumentValidationTarget](),
+				},
 				"version": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -276,6 +303,14 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				}
 			}
 
+			if v, ok := cfgStmt["context"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				conditions, err := dataSourcePolicyDocumentMakeContextConditions(v[0].(map[string]interface{}), doc.Version)
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading context: %s", err)
+				}
+				stmt.Conditions = append(stmt.Conditions, conditions...)
+			}
+
 			stmts[i] = stmt
 		}
 
@@ -296,11 +331,30 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
 			}
 
+			// Statements with duplicate Sids in an override document would silently override each other.
+			if _, ok := d.GetOk("validate"); ok {
+				if err := validatePolicyDocumentSids(overrideDoc); err != nil {
+					return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
+				}
+			}
+
 			mergedDoc.Merge(overrideDoc)
 		}
 	}
 
-	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
+	if v, ok := d.GetOk("validate"); ok {
+		if err := validatePolicyDocument(mergedDoc, policyDocumentValidationTarget(v.(string))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: %s", err)
+		}
+	}
+
+	var jsonDoc []byte
+	var err error
+	if d.Get("minify").(bool) {
+		jsonDoc, err = json.Marshal(mergedDoc)
+	} else {
+		jsonDoc, err = json.MarshalIndent(mergedDoc, "", "  ")
+	}
 	if err != nil {
 		// should never happen if the above code is correct
 		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
@@ -308,6 +362,7 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	jsonString := string(jsonDoc)
 
 	d.Set("json", jsonString)
+	d.Set("size", len(jsonDoc))
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
@@ -358,6 +413,38 @@ func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (I
 	return IAMPolicyStatementConditionSet(out), nil
 }
 
+// dataSourcePolicyDocumentMakeContextConditions returns the conditions for the common request context patterns
+// configured in a statement's context block.
+func dataSourcePolicyDocumentMakeContextConditions(tfMap map[string]interface{}, version string) (IAMPolicyStatementConditionSet, error) {
+	var out IAMPolicyStatementConditionSet
+	for _, v := range []struct {
+		key      string
+		test     string
+		variable string
+	}{
+		{"principal_org_ids", "StringEquals", "aws:PrincipalOrgID"},
+		{"source_accounts", "StringEquals", "aws:SourceAccount"},
+		{"source_arns", "ArnLike", "aws:SourceArn"},
+	} {
+		values := tfMap[v.key].(*schema.Set).List()
+		if len(values) == 0 {
+			continue
+		}
+
+		condition := IAMPolicyStatementCondition{
+			Test:     v.test,
+			Variable: v.variable,
+		}
+		var err error
+		condition.Values, err = dataSourcePolicyDocumentReplaceVarsInList(policyDecodeConfigStringList(values), version)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", v.key, err)
+		}
+		out = append(out, condition)
+	}
+	return out, nil
+}
+
 func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (IAMPolicyStatementPrincipalSet, error) {
 	out := make([]IAMPolicyStatementPrincipal, len(in))
 	for i, itemI := range in {
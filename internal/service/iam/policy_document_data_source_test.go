@@ -438,6 +438,65 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_minify(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_minify,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"Version":"2012-10-17","Statement":[{"Sid":"AssumeRole","Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com"}}]}`),
+					resource.TestCheckResourceAttr(dataSourceName, "size", "146"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_validate(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_validateDuplicateSid,
+				ExpectError: regexache.MustCompile(`duplicate Sid \(Override\)`),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_validateSize,
+				ExpectError: regexache.MustCompile(`exceeds the iam_user_inline_policy limit of 2048 bytes`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_context(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_context,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccPolicyDocumentContextExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
 var testAccPolicyDocumentDataSourceConfig_basic = `
 data "aws_partition" "current" {}
 
@@ -1642,3 +1701,109 @@ data "aws_iam_policy_document" "partial" {
   }
 }
 `
+
+var testAccPolicyDocumentDataSourceConfig_minify = `
+data "aws_iam_policy_document" "test" {
+  minify   = true
+  validate = "iam_role_trust_policy"
+
+  statement {
+    sid     = "AssumeRole"
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ec2.amazonaws.com"]
+    }
+  }
+}
+`
+
+var testAccPolicyDocumentDataSourceConfig_validateDuplicateSid = `
+data "aws_iam_policy_document" "test" {
+  validate = "iam_managed_policy"
+
+  override_policy_documents = [
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        {
+          Sid      = "Override"
+          Effect   = "Allow"
+          Action   = "s3:GetObject"
+          Resource = "*"
+        },
+        {
+          Sid      = "Override"
+          Effect   = "Allow"
+          Action   = "s3:PutObject"
+          Resource = "*"
+        },
+      ]
+    }),
+  ]
+}
+`
+
+var testAccPolicyDocumentDataSourceConfig_validateSize = `
+data "aws_iam_policy_document" "test" {
+  validate = "iam_user_inline_policy"
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = [for i in range(100) : "arn:aws:s3:::example-bucket-${i}/*"] # lintignore:AWSAT005
+  }
+}
+`
+
+var testAccPolicyDocumentDataSourceConfig_context = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["sns:Publish"]
+    resources = ["*"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["events.amazonaws.com"]
+    }
+
+    context {
+      principal_org_ids = ["o-123456"]
+      source_accounts   = ["123456789012"]
+      source_arns       = ["arn:aws:events:*:123456789012:rule/*"] # lintignore:AWSAT003,AWSAT005
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:SourceAccount"
+      values   = ["210987654321"]
+    }
+  }
+}
+`
+
+const testAccPolicyDocumentContextExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "sns:Publish",
+      "Resource": "*",
+      "Principal": {
+        "Service": "events.amazonaws.com"
+      },
+      "Condition": {
+        "ArnLike": {
+          "aws:SourceArn": "arn:aws:events:*:123456789012:rule/*"
+        },
+        "StringEquals": {
+          "aws:PrincipalOrgID": "o-123456",
+          "aws:SourceAccount": [
+            "210987654321",
+            "123456789012"
+          ]
+        }
+      }
+    }
+  ]
+}`
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// policyDocumentValidationTarget is the kind of policy a generated policy document is validated for.
+type policyDocumentValidationTarget string
+
+const (
+	policyDocumentValidationTargetECRRepositoryPolicy  policyDocumentValidationTarget = "ecr_repository_policy"
+	policyDocumentValidationTargetGroupInlinePolicy    policyDocumentValidationTarget = "iam_group_inline_policy"
+	policyDocumentValidationTargetKMSKeyPolicy         policyDocumentValidationTarget = "kms_key_policy"
+	policyDocumentValidationTargetLambdaFunctionPolicy policyDocumentValidationTarget = "lambda_function_policy"
+	policyDocumentValidationTargetManagedPolicy        policyDocumentValidationTarget = "iam_managed_policy"
+	policyDocumentValidationTargetRoleInlinePolicy     policyDocumentValidationTarget = "iam_role_inline_policy"
+	policyDocumentValidationTargetRoleTrustPolicy      policyDocumentValidationTarget = "iam_role_trust_policy"
+	policyDocumentValidationTargetS3BucketPolicy       policyDocumentValidationTarget = "s3_bucket_policy"
+	policyDocumentValidationTargetSecretsManagerPolicy policyDocumentValidationTarget = "secretsmanager_secret_policy"
+	policyDocumentValidationTargetSNSTopicPolicy       policyDocumentValidationTarget = "sns_topic_policy"
+	policyDocumentValidationTargetSQSQueuePolicy       policyDocumentValidationTarget = "sqs_queue_policy"
+	policyDocumentValidationTargetUserInlinePolicy     policyDocumentValidationTarget = "iam_user_inline_policy"
+)
+
+func (policyDocumentValidationTarget) Values() []policyDocumentValidationTarget {
+	return []policyDocumentValidationTarget{
+		policyDocumentValidationTargetECRRepositoryPolicy,
+		policyDocumentValidationTargetGroupInlinePolicy,
+		policyDocumentValidationTargetKMSKeyPolicy,
+		policyDocumentValidationTargetLambdaFunctionPolicy,
+		policyDocumentValidationTargetManagedPolicy,
+		policyDocumentValidationTargetRoleInlinePolicy,
+		policyDocumentValidationTargetRoleTrustPolicy,
+		policyDocumentValidationTargetS3BucketPolicy,
+		policyDocumentValidationTargetSecretsManagerPolicy,
+		policyDocumentValidationTargetSNSTopicPolicy,
+		policyDocumentValidationTargetSQSQueuePolicy,
+		policyDocumentValidationTargetUserInlinePolicy,
+	}
+}
+
+// sizeLimit returns the maximum size in bytes, excluding whitespace, of a policy document of this kind.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html and each service's quotas.
+func (t policyDocumentValidationTarget) sizeLimit() int {
+	switch t {
+	case policyDocumentValidationTargetECRRepositoryPolicy:
+		return 10_240
+	case policyDocumentValidationTargetGroupInlinePolicy:
+		return 5_120
+	case policyDocumentValidationTargetKMSKeyPolicy:
+		return 32_768
+	case policyDocumentValidationTargetLambdaFunctionPolicy:
+		return 20_480
+	case policyDocumentValidationTargetManagedPolicy:
+		return 6_144
+	case policyDocumentValidationTargetRoleInlinePolicy:
+		return 10_240
+	case policyDocumentValidationTargetRoleTrustPolicy:
+		return 2_048
+	case policyDocumentValidationTargetS3BucketPolicy:
+		return 20_480
+	case policyDocumentValidationTargetSecretsManagerPolicy:
+		return 20_480
+	case policyDocumentValidationTargetSNSTopicPolicy:
+		return 30_720
+	case policyDocumentValidationTargetSQSQueuePolicy:
+		return 8_192
+	case policyDocumentValidationTargetUserInlinePolicy:
+		return 2_048
+	default:
+		return 0
+	}
+}
+
+// validatePolicyDocument checks a policy document for common problems that would otherwise only be reported
+// when the policy is attached: duplicate statement IDs and exceeding the target's policy size limit.
+func validatePolicyDocument(doc *IAMPolicyDoc, target policyDocumentValidationTarget) error {
+	if err := validatePolicyDocumentSids(doc); err != nil {
+		return err
+	}
+
+	// AWS doesn't count whitespace towards policy size limits.
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	if size, limit := len(b), target.sizeLimit(); limit > 0 && size > limit {
+		return fmt.Errorf("policy size (%d bytes, excluding whitespace) exceeds the %s limit of %d bytes", size, target, limit)
+	}
+
+	return nil
+}
+
+// validatePolicyDocumentSids checks that a policy document's statement IDs are unique.
+func validatePolicyDocumentSids(doc *IAMPolicyDoc) error {
+	sids := make(map[string]struct{})
+	for i, stmt := range doc.Statements {
+		if stmt == nil || stmt.Sid == "" {
+			continue
+		}
+
+		if _, ok := sids[stmt.Sid]; ok {
+			return fmt.Errorf("duplicate Sid (%s) in statement %d; remove the Sid or ensure Sids are unique", stmt.Sid, i)
+		}
+		sids[stmt.Sid] = struct{}{}
+	}
+
+	return nil
+}
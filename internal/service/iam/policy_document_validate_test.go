@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"strings"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestValidatePolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		doc         *tfiam.IAMPolicyDoc
+		expectedErr string
+	}{
+		"valid": {
+			doc: &tfiam.IAMPolicyDoc{
+				Version: "2012-10-17",
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Sid: "One", Effect: "Allow", Actions: "sts:AssumeRole"},
+					{Effect: "Allow", Actions: "sts:TagSession"},
+					{Effect: "Allow", Actions: "sts:SetSourceIdentity"},
+				},
+			},
+		},
+		"duplicate Sid": {
+			doc: &tfiam.IAMPolicyDoc{
+				Version: "2012-10-17",
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Sid: "One", Effect: "Allow", Actions: "sts:AssumeRole"},
+					{Sid: "One", Effect: "Allow", Actions: "sts:TagSession"},
+				},
+			},
+			expectedErr: "duplicate Sid (One)",
+		},
+		"too large": {
+			doc: &tfiam.IAMPolicyDoc{
+				Version: "2012-10-17",
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Allow", Actions: "sts:AssumeRole", Resources: strings.Repeat("a", 2048)},
+				},
+			},
+			expectedErr: "exceeds the iam_role_trust_policy limit of 2048 bytes",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfiam.ValidatePolicyDocument(testCase.doc, "iam_role_trust_policy")
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Errorf("error = %v, want error containing %q", err, testCase.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IAM quotas enforced at plan time.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html.
+const (
+	managedPolicySizeMax     = 6144
+	groupInlinePolicySizeMax = 5120
+	roleInlinePolicySizeMax  = 10240
+	userInlinePolicySizeMax  = 2048
+	roleManagedPoliciesMax   = 20
+	pathLenMax               = 512
+)
+
+// policySize returns the size of a policy document as counted against IAM quotas.
+// IAM does not count white space when calculating the size of a policy.
+func policySize(policy string) int {
+	n := 0
+
+	for _, r := range policy {
+		if !unicode.IsSpace(r) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func checkPolicySize(attr, quota string, size, max int) error {
+	if size > max {
+		return fmt.Errorf("%s: policy size %d characters (excluding white space) exceeds the IAM %s quota of %d characters", attr, size, quota, max)
+	}
+
+	return nil
+}
+
+// customizeDiffPolicySize returns a CustomizeDiff function verifying that the size of the
+// policy document in the specified attribute does not exceed the specified IAM quota.
+func customizeDiffPolicySize(attr, quota string, max int) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(attr) {
+			return nil
+		}
+
+		return checkPolicySize(attr, quota, policySize(d.Get(attr).(string)), max)
+	}
+}
+
+// customizeDiffPathLen verifies that a known path does not exceed the IAM quota.
+func customizeDiffPathLen(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const attr = "path"
+
+	if !d.NewValueKnown(attr) {
+		return nil
+	}
+
+	if n := utf8.RuneCountInString(d.Get(attr).(string)); n > pathLenMax {
+		return fmt.Errorf("%s: length %d exceeds the IAM path length quota of %d characters", attr, n, pathLenMax)
+	}
+
+	return nil
+}
+
+// customizeDiffRoleQuotas verifies that a role's name, path, inline policies and managed policy attachments
+// do not exceed IAM quotas. Values only known at plan time (e.g. interpolated names) are checked here.
+func customizeDiffRoleQuotas(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("name") {
+		if n := utf8.RuneCountInString(d.Get("name").(string)); n > roleNameMaxLen {
+			return fmt.Errorf("name: length %d exceeds the IAM role name length quota of %d characters", n, roleNameMaxLen)
+		}
+	}
+
+	if err := customizeDiffPathLen(ctx, d, meta); err != nil {
+		return err
+	}
+
+	if d.NewValueKnown("inline_policy") {
+		size := 0
+
+		for _, tfMapRaw := range d.Get("inline_policy").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap["policy"].(string); ok {
+				size += policySize(v)
+			}
+		}
+
+		if err := checkPolicySize("inline_policy", "role aggregate inline policy size", size, roleInlinePolicySizeMax); err != nil {
+			return err
+		}
+	}
+
+	if d.NewValueKnown("managed_policy_arns") {
+		if n := d.Get("managed_policy_arns").(*schema.Set).Len(); n > roleManagedPoliciesMax {
+			return fmt.Errorf("managed_policy_arns: %d managed policies exceeds the IAM quota of %d managed policies attached to a role", n, roleManagedPoliciesMax)
+		}
+	}
+
+	return nil
+}
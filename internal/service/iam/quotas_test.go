@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPolicySize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		policy   string
+		expected int
+	}{
+		{``, 0},
+		{`{"Version":"2012-10-17"}`, 24},
+		{"{\n  \"Version\": \"2012-10-17\"\n}\n", 24},
+		{"{\t\"Sid\": \"Ünïcödé\"}", 17},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := policySize(testCase.policy), testCase.expected; got != want {
+			t.Errorf("policySize(%q) = %d, want %d", testCase.policy, got, want)
+		}
+	}
+}
+
+func TestCheckPolicySize(t *testing.T) {
+	t.Parallel()
+
+	if err := checkPolicySize("policy", "managed policy size", managedPolicySizeMax, managedPolicySizeMax); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	err := checkPolicySize("policy", "managed policy size", managedPolicySizeMax+1, managedPolicySizeMax)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := err.Error(), "policy: policy size 6145 characters (excluding white space) exceeds the IAM managed policy size quota of 6144 characters"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want %q", got, want)
+	}
+}
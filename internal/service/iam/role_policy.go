@@ -77,6 +77,8 @@ func resourceRolePolicy() *schema.Resource {
 				ValidateFunc: validRolePolicyRole,
 			},
 		},
+
+		CustomizeDiff: customizeDiffPolicySize("policy", "role aggregate inline policy size", roleInlinePolicySizeMax),
 	}
 }
 
@@ -21,10 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_iam_role_policy_attachment", name="Role Policy Attachment")
+// @Found(func="rolePolicyAttachmentFound", timeout="propagationTimeout")
 func resourceRolePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRolePolicyAttachmentCreate,
@@ -65,7 +67,7 @@ func resourceRolePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceD
 	//lintignore:R016 // Allow legacy unstable ID usage in managed resource
 	d.SetId(id.PrefixedUniqueId(fmt.Sprintf("%s-", role)))
 
-	return append(diags, resourceRolePolicyAttachmentRead(ctx, d, meta)...)
+	return diags
 }
 
 func resourceRolePolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -77,9 +79,7 @@ func resourceRolePolicyAttachmentRead(ctx context.Context, d *schema.ResourceDat
 	// Human friendly ID for error messages since d.Id() is non-descriptive.
 	id := fmt.Sprintf("%s:%s", role, policyARN)
 
-	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
-		return findAttachedRolePolicyByTwoPartKey(ctx, conn, role, policyARN)
-	}, d.IsNewResource())
+	_, err := findAttachedRolePolicyByTwoPartKey(ctx, conn, role, policyARN)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] IAM Role Policy Attachment (%s) not found, removing from state", id)
@@ -105,6 +105,15 @@ func resourceRolePolicyAttachmentDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+// rolePolicyAttachmentFound returns an error for which tfresource.NotFound is true until a new attachment is visible.
+func rolePolicyAttachmentFound(ctx context.Context, d types.SDKResourceData, meta any) error {
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	_, err := findAttachedRolePolicyByTwoPartKey(ctx, conn, d.Get("role").(string), d.Get("policy_arn").(string))
+
+	return err
+}
+
 func resourceRolePolicyAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.SplitN(d.Id(), "/", 2)
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
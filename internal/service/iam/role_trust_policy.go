@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+var accountIDRegexp = regexache.MustCompile(`^\d{12}$`)
+
+// AssumeRolePolicyHasBroadPrincipals returns whether a role's trust policy allows any AWS principal without
+// conditions or trusts another account without an sts:ExternalId condition.
+// Policies that cannot be parsed, e.g. because they are not yet known, are not reported.
+func AssumeRolePolicyHasBroadPrincipals(policy, accountID string) bool {
+	warnings, err := analyzeAssumeRolePolicy(policy, accountID)
+
+	return err == nil && len(warnings) > 0
+}
+
+// analyzeAssumeRolePolicy returns descriptions of overly broad principals in a role's trust policy:
+// statements allowing any AWS principal without conditions and cross-account trust without an sts:ExternalId condition.
+func analyzeAssumeRolePolicy(policy, accountID string) ([]string, error) {
+	var doc IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	var warnings []string
+
+	for i, statement := range doc.Statements {
+		if statement == nil || !strings.EqualFold(statement.Effect, "Allow") {
+			continue
+		}
+
+		name := statement.Sid
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+
+		for _, principal := range statement.Principals {
+			if principal.Type != "*" && principal.Type != "AWS" {
+				continue
+			}
+
+			for _, identifier := range policyIdentifiers(principal.Identifiers) {
+				switch {
+				case identifier == "*":
+					if len(statement.Conditions) == 0 {
+						warnings = append(warnings, fmt.Sprintf("statement %s allows any AWS principal to assume the role without conditions", name))
+					}
+				case !hasConditionKey(statement.Conditions, "sts:ExternalId"):
+					if v := principalAccountID(identifier); v != "" && v != accountID {
+						warnings = append(warnings, fmt.Sprintf("statement %s trusts account %s without an sts:ExternalId condition", name, v))
+					}
+				}
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+// principalAccountID returns the account ID of an AWS principal identifier, an account ID or an ARN.
+func principalAccountID(identifier string) string {
+	if accountIDRegexp.MatchString(identifier) {
+		return identifier
+	}
+
+	if v, err := arn.Parse(identifier); err == nil {
+		return v.AccountID
+	}
+
+	return ""
+}
+
+func hasConditionKey(conditions IAMPolicyStatementConditionSet, key string) bool {
+	for _, condition := range conditions {
+		if strings.EqualFold(condition.Variable, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyIdentifiers(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	default:
+		return nil
+	}
+}
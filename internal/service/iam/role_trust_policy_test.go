@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAnalyzeAssumeRolePolicy(t *testing.T) {
+	t.Parallel()
+
+	const accountID = "123456789012"
+
+	testCases := map[string]struct {
+		policy      string
+		expected    []string
+		expectError bool
+	}{
+		"service principal": {
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		"same account": {
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+		},
+		"wildcard principal": {
+			policy:   `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"sts:AssumeRole"}]}`,
+			expected: []string{"statement #1 allows any AWS principal to assume the role without conditions"},
+		},
+		"wildcard AWS principal": {
+			policy:   `{"Statement":[{"Sid":"Any","Effect":"Allow","Principal":{"AWS":"*"},"Action":"sts:AssumeRole"}]}`,
+			expected: []string{"statement Any allows any AWS principal to assume the role without conditions"},
+		},
+		"wildcard AWS principal with condition": {
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"sts:AssumeRole","Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-1234567890"}}}]}`,
+		},
+		"cross-account": {
+			policy:   `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::210987654321:root","111122223333"]},"Action":"sts:AssumeRole"}]}`,
+			expected: []string{"statement #1 trusts account 111122223333 without an sts:ExternalId condition", "statement #1 trusts account 210987654321 without an sts:ExternalId condition"},
+		},
+		"cross-account with external ID": {
+			policy: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"sts:AssumeRole","Condition":{"StringEquals":{"sts:ExternalId":"secret"}}}]}`,
+		},
+		"deny": {
+			policy: `{"Statement":[{"Effect":"Deny","Principal":"*","Action":"sts:AssumeRole"}]}`,
+		},
+		"invalid JSON": {
+			policy:      `{`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := analyzeAssumeRolePolicy(testCase.policy, accountID)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("analyzeAssumeRolePolicy() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
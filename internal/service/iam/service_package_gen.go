@@ -148,6 +148,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceGroupPolicyAttachment,
 			TypeName: "aws_iam_group_policy_attachment",
 			Name:     "Group Policy Attachment",
+			Found: &types.ServicePackageResourceFound{
+				Func:    groupPolicyAttachmentFound,
+				Timeout: propagationTimeout,
+			},
 		},
 		{
 			Factory:  resourceInstanceProfile,
@@ -199,6 +203,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceRolePolicyAttachment,
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
+			Found: &types.ServicePackageResourceFound{
+				Func:    rolePolicyAttachmentFound,
+				Timeout: propagationTimeout,
+			},
 		},
 		{
 			Factory:  resourceSAMLProvider,
@@ -270,6 +278,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceUserPolicyAttachment,
 			TypeName: "aws_iam_user_policy_attachment",
 			Name:     "User Policy Attachment",
+			Found: &types.ServicePackageResourceFound{
+				Func:    userPolicyAttachmentFound,
+				Timeout: propagationTimeout,
+			},
 		},
 		{
 			Factory:  resourceUserSSHKey,
@@ -68,6 +68,8 @@ func resourceUserPolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffPolicySize("policy", "user aggregate inline policy size", userInlinePolicySizeMax),
 	}
 }
 
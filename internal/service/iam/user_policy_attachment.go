@@ -21,10 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_iam_user_policy_attachment", name="User Policy Attachment")
+// @Found(func="userPolicyAttachmentFound", timeout="propagationTimeout")
 func resourceUserPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPolicyAttachmentCreate,
@@ -65,7 +67,7 @@ func resourceUserPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceD
 	//lintignore:R016 // Allow legacy unstable ID usage in managed resource
 	d.SetId(id.PrefixedUniqueId(fmt.Sprintf("%s-", user)))
 
-	return append(diags, resourceUserPolicyAttachmentRead(ctx, d, meta)...)
+	return diags
 }
 
 func resourceUserPolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -77,9 +79,7 @@ func resourceUserPolicyAttachmentRead(ctx context.Context, d *schema.ResourceDat
 	// Human friendly ID for error messages since d.Id() is non-descriptive.
 	id := fmt.Sprintf("%s:%s", user, policyARN)
 
-	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
-		return findAttachedUserPolicyByTwoPartKey(ctx, conn, user, policyARN)
-	}, d.IsNewResource())
+	_, err := findAttachedUserPolicyByTwoPartKey(ctx, conn, user, policyARN)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] IAM User Policy Attachment (%s) not found, removing from state", id)
@@ -105,6 +105,15 @@ func resourceUserPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+// userPolicyAttachmentFound returns an error for which tfresource.NotFound is true until a new attachment is visible.
+func userPolicyAttachmentFound(ctx context.Context, d types.SDKResourceData, meta any) error {
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	_, err := findAttachedUserPolicyByTwoPartKey(ctx, conn, d.Get("user").(string), d.Get("policy_arn").(string))
+
+	return err
+}
+
 func resourceUserPolicyAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.SplitN(d.Id(), "/", 2)
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Group Memberships Exclusive")
+func newResourceGroupMembershipsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceGroupMembershipsExclusive{}, nil
+}
+
+const (
+	ResNameGroupMembershipsExclusive = "Group Memberships Exclusive"
+
+	groupMembershipsExclusiveIDPartCount = 2
+)
+
+type resourceGroupMembershipsExclusive struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceGroupMembershipsExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_identitystore_group_memberships_exclusive"
+}
+
+func (r *resourceGroupMembershipsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 47),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"identity_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 36),
+				},
+			},
+			"member_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *resourceGroupMembershipsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().IdentityStoreClient(ctx)
+
+	var plan resourceGroupMembershipsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityStoreID, groupID := plan.IdentityStoreID.ValueString(), plan.GroupID.ValueString()
+	id, _ := intflex.FlattenResourceId([]string{identityStoreID, groupID}, groupMembershipsExclusiveIDPartCount, false)
+	plan.ID = types.StringValue(id)
+
+	if err := syncGroupMemberships(ctx, conn, identityStoreID, groupID, flex.ExpandFrameworkStringValueSet(ctx, plan.MemberIDs)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IdentityStore, create.ErrActionCreating, ResNameGroupMembershipsExclusive, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceGroupMembershipsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().IdentityStoreClient(ctx)
+
+	var state resourceGroupMembershipsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), groupMembershipsExclusiveIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IdentityStore, create.ErrActionReading, ResNameGroupMembershipsExclusive, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	identityStoreID, groupID := parts[0], parts[1]
+
+	memberships, err := findGroupMembershipsByTwoPartKey(ctx, conn, identityStoreID, groupID)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IdentityStore, create.ErrActionReading, ResNameGroupMembershipsExclusive, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	memberIDs := make([]string, 0, len(memberships))
+	for memberID := range memberships {
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	state.GroupID = types.StringValue(groupID)
+	state.IdentityStoreID = types.StringValue(identityStoreID)
+	state.MemberIDs = flex.FlattenFrameworkStringValueSet(ctx, memberIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceGroupMembershipsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().IdentityStoreClient(ctx)
+
+	var plan, state resourceGroupMembershipsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.MemberIDs.Equal(state.MemberIDs) {
+		if err := syncGroupMemberships(ctx, conn, plan.IdentityStoreID.ValueString(), plan.GroupID.ValueString(), flex.ExpandFrameworkStringValueSet(ctx, plan.MemberIDs)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.IdentityStore, create.ErrActionUpdating, ResNameGroupMembershipsExclusive, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is a no-op. Removing the resource stops Terraform from managing the
+// group's membership but leaves the current members in place.
+func (r *resourceGroupMembershipsExclusive) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *resourceGroupMembershipsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// syncGroupMemberships makes the group's user members exactly match memberIDs.
+func syncGroupMemberships(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string, memberIDs []string) error {
+	memberships, err := findGroupMembershipsByTwoPartKey(ctx, conn, identityStoreID, groupID)
+	if err != nil {
+		return err
+	}
+
+	want := make(map[string]struct{}, len(memberIDs))
+	for _, memberID := range memberIDs {
+		want[memberID] = struct{}{}
+	}
+
+	var errList []error
+
+	for _, memberID := range memberIDs {
+		if _, ok := memberships[memberID]; ok {
+			continue
+		}
+
+		input := &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(groupID),
+			IdentityStoreId: aws.String(identityStoreID),
+			MemberId:        &awstypes.MemberIdMemberUserId{Value: memberID},
+		}
+
+		if _, err := conn.CreateGroupMembership(ctx, input); err != nil {
+			errList = append(errList, create.Error(names.IdentityStore, create.ErrActionCreating, ResNameGroupMembership, memberID, err))
+		}
+	}
+
+	for memberID, membershipID := range memberships {
+		if _, ok := want[memberID]; ok {
+			continue
+		}
+
+		input := &identitystore.DeleteGroupMembershipInput{
+			IdentityStoreId: aws.String(identityStoreID),
+			MembershipId:    aws.String(membershipID),
+		}
+
+		_, err := conn.DeleteGroupMembership(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			errList = append(errList, create.Error(names.IdentityStore, create.ErrActionDeleting, ResNameGroupMembership, memberID, err))
+		}
+	}
+
+	return errors.Join(errList...)
+}
+
+// findGroupMembershipsByTwoPartKey returns a map of user member ID to membership ID for the group.
+func findGroupMembershipsByTwoPartKey(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) (map[string]string, error) {
+	input := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	output := make(map[string]string)
+
+	pages := identitystore.NewListGroupMembershipsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GroupMemberships {
+			if memberID, ok := v.MemberId.(*awstypes.MemberIdMemberUserId); ok {
+				output[memberID.Value] = aws.ToString(v.MembershipId)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type resourceGroupMembershipsExclusiveData struct {
+	GroupID         types.String `tfsdk:"group_id"`
+	ID              types.String `tfsdk:"id"`
+	IdentityStoreID types.String `tfsdk:"identity_store_id"`
+	MemberIDs       types.Set    `tfsdk:"member_ids"`
+}
@@ -6,9 +6,13 @@ package identitystore
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -42,6 +46,9 @@ func (d *groupsDataSource) Schema(ctx context.Context, request datasource.Schema
 				Required: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock(ctx, groupFilterAttributePaths),
+		},
 	}
 }
 
@@ -58,7 +65,13 @@ func (d *groupsDataSource) Read(ctx context.Context, request datasource.ReadRequ
 		IdentityStoreId: fwflex.StringFromFramework(ctx, data.IdentityStoreID),
 	}
 
-	var output *identitystore.ListGroupsOutput
+	filters, diags := data.Filters.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output := &identitystore.ListGroupsOutput{}
 	pages := identitystore.NewListGroupsPaginator(conn, input)
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
@@ -68,10 +81,10 @@ func (d *groupsDataSource) Read(ctx context.Context, request datasource.ReadRequ
 			return
 		}
 
-		if output == nil {
-			output = page
-		} else {
-			output.Groups = append(output.Groups, page.Groups...)
+		for _, v := range page.Groups {
+			if matchesFilters(filters, groupFilterAttributes(v)) {
+				output.Groups = append(output.Groups, v)
+			}
 		}
 	}
 
@@ -84,8 +97,9 @@ func (d *groupsDataSource) Read(ctx context.Context, request datasource.ReadRequ
 }
 
 type groupsDataSourceModel struct {
-	IdentityStoreID types.String                                `tfsdk:"identity_store_id"`
-	Groups          fwtypes.ListNestedObjectValueOf[groupModel] `tfsdk:"groups"`
+	Filters         fwtypes.ListNestedObjectValueOf[filterModel] `tfsdk:"filter"`
+	IdentityStoreID types.String                                 `tfsdk:"identity_store_id"`
+	Groups          fwtypes.ListNestedObjectValueOf[groupModel]  `tfsdk:"groups"`
 }
 
 type groupModel struct {
@@ -100,3 +114,52 @@ type externalIDModel struct {
 	ID     types.String `tfsdk:"id"`
 	Issuer types.String `tfsdk:"issuer"`
 }
+
+type filterModel struct {
+	AttributePath  types.String `tfsdk:"attribute_path"`
+	AttributeValue types.String `tfsdk:"attribute_value"`
+}
+
+// filterBlock returns the schema of a plural data source's client-side filters.
+// The ListUsers and ListGroups API filters are deprecated, so filtering is done after listing.
+func filterBlock(ctx context.Context, attributePaths []string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[filterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"attribute_path": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(attributePaths...),
+					},
+				},
+				"attribute_value": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// matchesFilters returns whether the attributes match all filters.
+func matchesFilters(filters []*filterModel, attributes map[string]*string) bool {
+	for _, filter := range filters {
+		if aws.ToString(attributes[filter.AttributePath.ValueString()]) != filter.AttributeValue.ValueString() {
+			return false
+		}
+	}
+
+	return true
+}
+
+var groupFilterAttributePaths = []string{
+	"Description",
+	"DisplayName",
+}
+
+func groupFilterAttributes(group awstypes.Group) map[string]*string {
+	return map[string]*string{
+		"Description": group.Description,
+		"DisplayName": group.DisplayName,
+	}
+}
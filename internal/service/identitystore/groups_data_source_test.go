@@ -36,6 +36,32 @@ func TestAccIdentityStoreGroupsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccIdentityStoreGroupsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_identitystore_groups.test"
+	resourceName := "aws_identitystore_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigGroups_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.group_id", resourceName, "group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.display_name", resourceName, "display_name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConfigGroups_basic(groupName string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -53,3 +79,24 @@ data "aws_identitystore_groups" "test" {
 }
 `, groupName)
 }
+
+func testAccConfigGroups_filter(groupName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = data.aws_ssoadmin_instances.test.identity_store_ids[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+
+data "aws_identitystore_groups" "test" {
+  identity_store_id = data.aws_ssoadmin_instances.test.identity_store_ids[0]
+
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = aws_identitystore_group.test.display_name
+  }
+}
+`, groupName)
+}
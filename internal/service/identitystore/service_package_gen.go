@@ -20,11 +20,20 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newGroupsDataSource,
 			Name:    "Groups",
 		},
+		{
+			Factory: newUsersDataSource,
+			Name:    "Users",
+		},
 	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceGroupMembershipsExclusive,
+			Name:    "Group Memberships Exclusive",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
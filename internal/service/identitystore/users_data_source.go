@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource(name="Users")
+func newUsersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &usersDataSource{}, nil
+}
+
+type usersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*usersDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_identitystore_users"
+}
+
+func (d *usersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"identity_store_id": schema.StringAttribute{
+				Required: true,
+			},
+			"users": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[userModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[userModel](ctx),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock(ctx, userFilterAttributePaths),
+		},
+	}
+}
+
+func (d *usersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data usersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().IdentityStoreClient(ctx)
+
+	input := &identitystore.ListUsersInput{
+		IdentityStoreId: fwflex.StringFromFramework(ctx, data.IdentityStoreID),
+	}
+
+	filters, diags := data.Filters.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output := &identitystore.ListUsersOutput{}
+	pages := identitystore.NewListUsersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			response.Diagnostics.AddError("listing IdentityStore Users", err.Error())
+
+			return
+		}
+
+		for _, v := range page.Users {
+			if matchesFilters(filters, userFilterAttributes(v)) {
+				output.Users = append(output.Users, v)
+			}
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+var userFilterAttributePaths = []string{
+	"DisplayName",
+	"Locale",
+	"NickName",
+	"PreferredLanguage",
+	"Timezone",
+	"Title",
+	"UserName",
+	"UserType",
+}
+
+func userFilterAttributes(user awstypes.User) map[string]*string {
+	return map[string]*string{
+		"DisplayName":       user.DisplayName,
+		"Locale":            user.Locale,
+		"NickName":          user.NickName,
+		"PreferredLanguage": user.PreferredLanguage,
+		"Timezone":          user.Timezone,
+		"Title":             user.Title,
+		"UserName":          user.UserName,
+		"UserType":          user.UserType,
+	}
+}
+
+type usersDataSourceModel struct {
+	Filters         fwtypes.ListNestedObjectValueOf[filterModel] `tfsdk:"filter"`
+	IdentityStoreID types.String                                 `tfsdk:"identity_store_id"`
+	Users           fwtypes.ListNestedObjectValueOf[userModel]   `tfsdk:"users"`
+}
+
+type userModel struct {
+	DisplayName       types.String                                     `tfsdk:"display_name"`
+	Emails            fwtypes.ListNestedObjectValueOf[emailModel]      `tfsdk:"emails"`
+	ExternalIDs       fwtypes.ListNestedObjectValueOf[externalIDModel] `tfsdk:"external_ids"`
+	IdentityStoreID   types.String                                     `tfsdk:"identity_store_id"`
+	Locale            types.String                                     `tfsdk:"locale"`
+	Name              fwtypes.ListNestedObjectValueOf[nameModel]       `tfsdk:"name"`
+	NickName          types.String                                     `tfsdk:"nickname"`
+	PreferredLanguage types.String                                     `tfsdk:"preferred_language"`
+	ProfileURL        types.String                                     `tfsdk:"profile_url"`
+	Timezone          types.String                                     `tfsdk:"timezone"`
+	Title             types.String                                     `tfsdk:"title"`
+	UserID            types.String                                     `tfsdk:"user_id"`
+	UserName          types.String                                     `tfsdk:"user_name"`
+	UserType          types.String                                     `tfsdk:"user_type"`
+}
+
+type emailModel struct {
+	Primary types.Bool   `tfsdk:"primary"`
+	Type    types.String `tfsdk:"type"`
+	Value   types.String `tfsdk:"value"`
+}
+
+type nameModel struct {
+	FamilyName      types.String `tfsdk:"family_name"`
+	Formatted       types.String `tfsdk:"formatted"`
+	GivenName       types.String `tfsdk:"given_name"`
+	HonorificPrefix types.String `tfsdk:"honorific_prefix"`
+	HonorificSuffix types.String `tfsdk:"honorific_suffix"`
+	MiddleName      types.String `tfsdk:"middle_name"`
+}
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_package_version", name="Package Version")
+// @Tags(identifierAttribute="arn")
+func ResourcePackageVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageVersionCreate,
+		ReadWithoutTimeout:   resourcePackageVersionRead,
+		UpdateWithoutTimeout: resourcePackageVersionUpdate,
+		DeleteWithoutTimeout: resourcePackageVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"error_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					iot.PackageVersionStatusPublished,
+					iot.PackageVersionStatusDeprecated,
+				}, false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePackageVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName := d.Get("package_name").(string), d.Get("version_name").(string)
+	id := PackageVersionCreateResourceID(packageName, versionName)
+	input := &iot.CreatePackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	if v, ok := d.GetOk("attributes"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Attributes = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if tags := KeyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 {
+		input.Tags = aws.StringMap(tags.Map())
+	}
+
+	_, err := conn.CreatePackageVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Package Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	// New versions are always created in the DRAFT state.
+	if v, ok := d.GetOk("status"); ok {
+		if err := updatePackageVersionStatus(ctx, conn, packageName, versionName, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IoT Package Version (%s): %s", id, err)
+		}
+	}
+
+	return append(diags, resourcePackageVersionRead(ctx, d, meta)...)
+}
+
+func resourcePackageVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName, err := PackageVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindPackageVersionByTwoPartKey(ctx, conn, packageName, versionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Package Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Package Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.PackageVersionArn)
+	d.Set("attributes", aws.StringValueMap(output.Attributes))
+	d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("error_reason", output.ErrorReason)
+	d.Set("last_modified_date", aws.TimeValue(output.LastModifiedDate).Format(time.RFC3339))
+	d.Set("package_name", output.PackageName)
+	d.Set("status", output.Status)
+	d.Set("version_name", output.VersionName)
+
+	return diags
+}
+
+func resourcePackageVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName, err := PackageVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges("attributes", "description") {
+		input := &iot.UpdatePackageVersionInput{
+			Attributes:  flex.ExpandStringMap(d.Get("attributes").(map[string]interface{})),
+			Description: aws.String(d.Get("description").(string)),
+			PackageName: aws.String(packageName),
+			VersionName: aws.String(versionName),
+		}
+
+		_, err := conn.UpdatePackageVersionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("status") {
+		if err := updatePackageVersionStatus(ctx, conn, packageName, versionName, d.Get("status").(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageVersionRead(ctx, d, meta)...)
+}
+
+func resourcePackageVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	packageName, versionName, err := PackageVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Package Version: %s", d.Id())
+	_, err = conn.DeletePackageVersionWithContext(ctx, &iot.DeletePackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Package Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updatePackageVersionStatus(ctx context.Context, conn *iot.IoT, packageName, versionName, status string) error {
+	var action string
+
+	switch status {
+	case iot.PackageVersionStatusPublished:
+		action = iot.PackageVersionActionPublish
+	case iot.PackageVersionStatusDeprecated:
+		action = iot.PackageVersionActionDeprecate
+	default:
+		return nil
+	}
+
+	_, err := conn.UpdatePackageVersionWithContext(ctx, &iot.UpdatePackageVersionInput{
+		Action:      aws.String(action),
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("setting status to %s: %w", status, err)
+	}
+
+	return nil
+}
+
+const packageVersionResourceIDSeparator = "/"
+
+func PackageVersionCreateResourceID(packageName, versionName string) string {
+	parts := []string{packageName, versionName}
+	id := strings.Join(parts, packageVersionResourceIDSeparator)
+
+	return id
+}
+
+func PackageVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, packageVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected package-name%[2]sversion-name", id, packageVersionResourceIDSeparator)
+}
+
+func FindPackageVersionByTwoPartKey(ctx context.Context, conn *iot.IoT, packageName, versionName string) (*iot.GetPackageVersionOutput, error) {
+	input := &iot.GetPackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	output, err := conn.GetPackageVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
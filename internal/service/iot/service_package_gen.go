@@ -85,6 +85,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLoggingOptions,
 			TypeName: "aws_iot_logging_options",
 		},
+		{
+			Factory:  ResourcePackage,
+			TypeName: "aws_iot_package",
+			Name:     "Package",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourcePackageVersion,
+			TypeName: "aws_iot_package_version",
+			Name:     "Package Version",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourcePolicy,
 			TypeName: "aws_iot_policy",
@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotdeviceadvisor
+
+// Exports for use in tests only.
+var (
+	ResourceSuiteDefinition = resourceSuiteDefinition
+
+	FindSuiteDefinitionByID = findSuiteDefinitionByID
+)
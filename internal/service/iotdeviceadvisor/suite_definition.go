@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotdeviceadvisor
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotdeviceadvisor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotdeviceadvisor/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotdeviceadvisor_suite_definition", name="Suite Definition")
+// @Tags(identifierAttribute="arn")
+func resourceSuiteDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSuiteDefinitionCreate,
+		ReadWithoutTimeout:   resourceSuiteDefinitionRead,
+		UpdateWithoutTimeout: resourceSuiteDefinitionUpdate,
+		DeleteWithoutTimeout: resourceSuiteDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"suite_definition_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"device_role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"thing_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"device_permission_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"intended_for_qualification": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"is_long_duration_test": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"protocol": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+						},
+						"root_group": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"suite_definition_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"suite_definition_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"suite_definition_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSuiteDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTDeviceAdvisorClient(ctx)
+
+	input := &iotdeviceadvisor.CreateSuiteDefinitionInput{
+		SuiteDefinitionConfiguration: expandSuiteDefinitionConfiguration(d.Get("suite_definition_configuration").([]interface{})),
+		Tags:                         getTagsIn(ctx),
+	}
+
+	name := aws.ToString(input.SuiteDefinitionConfiguration.SuiteDefinitionName)
+	output, err := conn.CreateSuiteDefinition(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Device Advisor Suite Definition (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.SuiteDefinitionId))
+
+	return append(diags, resourceSuiteDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceSuiteDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTDeviceAdvisorClient(ctx)
+
+	output, err := findSuiteDefinitionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Device Advisor Suite Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Device Advisor Suite Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.SuiteDefinitionArn)
+	if err := d.Set("suite_definition_configuration", flattenSuiteDefinitionConfiguration(output.SuiteDefinitionConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting suite_definition_configuration: %s", err)
+	}
+	d.Set("suite_definition_id", output.SuiteDefinitionId)
+	d.Set("suite_definition_version", output.SuiteDefinitionVersion)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceSuiteDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTDeviceAdvisorClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotdeviceadvisor.UpdateSuiteDefinitionInput{
+			SuiteDefinitionConfiguration: expandSuiteDefinitionConfiguration(d.Get("suite_definition_configuration").([]interface{})),
+			SuiteDefinitionId:            aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateSuiteDefinition(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Device Advisor Suite Definition (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSuiteDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceSuiteDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTDeviceAdvisorClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Device Advisor Suite Definition: %s", d.Id())
+	_, err := conn.DeleteSuiteDefinition(ctx, &iotdeviceadvisor.DeleteSuiteDefinitionInput{
+		SuiteDefinitionId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Device Advisor Suite Definition (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSuiteDefinitionByID(ctx context.Context, conn *iotdeviceadvisor.Client, id string) (*iotdeviceadvisor.GetSuiteDefinitionOutput, error) {
+	input := &iotdeviceadvisor.GetSuiteDefinitionInput{
+		SuiteDefinitionId: aws.String(id),
+	}
+
+	output, err := conn.GetSuiteDefinition(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SuiteDefinitionConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandSuiteDefinitionConfiguration(tfList []interface{}) *awstypes.SuiteDefinitionConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.SuiteDefinitionConfiguration{}
+
+	if v, ok := tfMap["device"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Devices = expandDevicesUnderTest(v)
+	}
+
+	if v, ok := tfMap["device_permission_role_arn"].(string); ok && v != "" {
+		apiObject.DevicePermissionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["intended_for_qualification"].(bool); ok {
+		apiObject.IntendedForQualification = v
+	}
+
+	if v, ok := tfMap["is_long_duration_test"].(bool); ok {
+		apiObject.IsLongDurationTest = v
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = awstypes.Protocol(v)
+	}
+
+	// Empty root group is allowed for qualification suites.
+	if v, ok := tfMap["root_group"].(string); ok {
+		apiObject.RootGroup = aws.String(v)
+	}
+
+	if v, ok := tfMap["suite_definition_name"].(string); ok && v != "" {
+		apiObject.SuiteDefinitionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDevicesUnderTest(tfList []interface{}) []awstypes.DeviceUnderTest {
+	var apiObjects []awstypes.DeviceUnderTest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.DeviceUnderTest{}
+
+		if v, ok := tfMap["certificate_arn"].(string); ok && v != "" {
+			apiObject.CertificateArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["device_role_arn"].(string); ok && v != "" {
+			apiObject.DeviceRoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["thing_arn"].(string); ok && v != "" {
+			apiObject.ThingArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSuiteDefinitionConfiguration(apiObject *awstypes.SuiteDefinitionConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"device":                     flattenDevicesUnderTest(apiObject.Devices),
+		"device_permission_role_arn": aws.ToString(apiObject.DevicePermissionRoleArn),
+		"intended_for_qualification": apiObject.IntendedForQualification,
+		"is_long_duration_test":      apiObject.IsLongDurationTest,
+		"protocol":                   string(apiObject.Protocol),
+		"root_group":                 aws.ToString(apiObject.RootGroup),
+		"suite_definition_name":      aws.ToString(apiObject.SuiteDefinitionName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDevicesUnderTest(apiObjects []awstypes.DeviceUnderTest) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"certificate_arn": aws.ToString(apiObject.CertificateArn),
+			"device_role_arn": aws.ToString(apiObject.DeviceRoleArn),
+			"thing_arn":       aws.ToString(apiObject.ThingArn),
+		})
+	}
+
+	return tfList
+}
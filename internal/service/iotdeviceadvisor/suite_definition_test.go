@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotdeviceadvisor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/iotdeviceadvisor"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotdeviceadvisor "github.com/hashicorp/terraform-provider-aws/internal/service/iotdeviceadvisor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTDeviceAdvisorSuiteDefinition_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotdeviceadvisor.GetSuiteDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotdeviceadvisor_suite_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTDeviceAdvisorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSuiteDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSuiteDefinitionConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSuiteDefinitionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotdeviceadvisor", regexache.MustCompile(`suitedefinition/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "suite_definition_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "suite_definition_configuration.0.device_permission_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "suite_definition_configuration.0.device.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "suite_definition_configuration.0.device.0.thing_arn", "aws_iot_thing.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "suite_definition_configuration.0.intended_for_qualification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suite_definition_configuration.0.protocol", "MqttV5"),
+					resource.TestCheckResourceAttr(resourceName, "suite_definition_configuration.0.suite_definition_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "suite_definition_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "suite_definition_version"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSuiteDefinitionConfig_basic(rName, rName+"-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSuiteDefinitionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "suite_definition_configuration.0.suite_definition_name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTDeviceAdvisorSuiteDefinition_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotdeviceadvisor.GetSuiteDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotdeviceadvisor_suite_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTDeviceAdvisorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSuiteDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSuiteDefinitionConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSuiteDefinitionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotdeviceadvisor.ResourceSuiteDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTDeviceAdvisorSuiteDefinition_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotdeviceadvisor.GetSuiteDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotdeviceadvisor_suite_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTDeviceAdvisorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSuiteDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSuiteDefinitionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSuiteDefinitionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSuiteDefinitionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSuiteDefinitionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSuiteDefinitionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSuiteDefinitionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSuiteDefinitionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTDeviceAdvisorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotdeviceadvisor_suite_definition" {
+				continue
+			}
+
+			_, err := tfiotdeviceadvisor.FindSuiteDefinitionByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Device Advisor Suite Definition %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSuiteDefinitionExists(ctx context.Context, n string, v *iotdeviceadvisor.GetSuiteDefinitionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTDeviceAdvisorClient(ctx)
+
+		output, err := tfiotdeviceadvisor.FindSuiteDefinitionByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSuiteDefinitionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iot_thing" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotdeviceadvisor.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccSuiteDefinitionConfig_basic(rName, suiteName string) string {
+	return acctest.ConfigCompose(testAccSuiteDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotdeviceadvisor_suite_definition" "test" {
+  suite_definition_configuration {
+    device_permission_role_arn = aws_iam_role.test.arn
+    intended_for_qualification = true
+    protocol                   = "MqttV5"
+    root_group                 = ""
+    suite_definition_name      = %[1]q
+
+    device {
+      thing_arn = aws_iot_thing.test.arn
+    }
+  }
+}
+`, suiteName))
+}
+
+func testAccSuiteDefinitionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSuiteDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotdeviceadvisor_suite_definition" "test" {
+  suite_definition_configuration {
+    device_permission_role_arn = aws_iam_role.test.arn
+    intended_for_qualification = true
+    root_group                 = ""
+    suite_definition_name      = %[1]q
+
+    device {
+      thing_arn = aws_iot_thing.test.arn
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccSuiteDefinitionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccSuiteDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotdeviceadvisor_suite_definition" "test" {
+  suite_definition_configuration {
+    device_permission_role_arn = aws_iam_role.test.arn
+    intended_for_qualification = true
+    root_group                 = ""
+    suite_definition_name      = %[1]q
+
+    device {
+      thing_arn = aws_iot_thing.test.arn
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -216,6 +216,39 @@ func TestAccIVSChannel_recordingConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccIVSChannel_playbackRestrictionPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel ivs.Channel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_channel.test"
+	policyResourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccChannelPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_playbackRestrictionPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttrPair(resourceName, "playback_restriction_policy_arn", policyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckChannelDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
@@ -334,6 +367,20 @@ resource "aws_ivs_channel" "test" {
 `, bucketName)
 }
 
+func testAccChannelConfig_playbackRestrictionPolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  name              = %[1]q
+  allowed_countries = ["US"]
+}
+
+resource "aws_ivs_channel" "test" {
+  name                            = %[1]q
+  playback_restriction_policy_arn = aws_ivs_playback_restriction_policy.test.arn
+}
+`, rName)
+}
+
 func testAccChannelConfig_tags1(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ivs_channel" "test" {
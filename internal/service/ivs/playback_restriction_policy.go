@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivs
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ivs_playback_restriction_policy", name="Playback Restriction Policy")
+// @Tags(identifierAttribute="id")
+func ResourcePlaybackRestrictionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePlaybackRestrictionPolicyCreate,
+		ReadWithoutTimeout:   resourcePlaybackRestrictionPolicyRead,
+		UpdateWithoutTimeout: resourcePlaybackRestrictionPolicyUpdate,
+		DeleteWithoutTimeout: resourcePlaybackRestrictionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_countries": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([A-Z]{2}|\*)$`), "must be an ISO 3166-1 alpha-2 country code or \"*\""),
+				},
+			},
+			"allowed_origins": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enable_strict_origin_enforcement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]{0,128}$`), "must contain only alphanumeric characters, hyphen, or underscore and at most 128 characters"),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNamePlaybackRestrictionPolicy = "Playback Restriction Policy"
+)
+
+func resourcePlaybackRestrictionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSConn(ctx)
+
+	in := &ivs.CreatePlaybackRestrictionPolicyInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("allowed_countries"); ok && v.(*schema.Set).Len() > 0 {
+		in.AllowedCountries = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_origins"); ok && v.(*schema.Set).Len() > 0 {
+		in.AllowedOrigins = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("enable_strict_origin_enforcement"); ok {
+		in.EnableStrictOriginEnforcement = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	out, err := conn.CreatePlaybackRestrictionPolicyWithContext(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.IVS, create.ErrActionCreating, ResNamePlaybackRestrictionPolicy, d.Get("name").(string), err)
+	}
+
+	if out == nil || out.PlaybackRestrictionPolicy == nil {
+		return create.AppendDiagError(diags, names.IVS, create.ErrActionCreating, ResNamePlaybackRestrictionPolicy, d.Get("name").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.PlaybackRestrictionPolicy.Arn))
+
+	return append(diags, resourcePlaybackRestrictionPolicyRead(ctx, d, meta)...)
+}
+
+func resourcePlaybackRestrictionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSConn(ctx)
+
+	out, err := FindPlaybackRestrictionPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS PlaybackRestrictionPolicy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IVS, create.ErrActionReading, ResNamePlaybackRestrictionPolicy, d.Id(), err)
+	}
+
+	d.Set("allowed_countries", aws.StringValueSlice(out.AllowedCountries))
+	d.Set("allowed_origins", aws.StringValueSlice(out.AllowedOrigins))
+	d.Set("arn", out.Arn)
+	d.Set("enable_strict_origin_enforcement", out.EnableStrictOriginEnforcement)
+	d.Set("name", out.Name)
+
+	return diags
+}
+
+func resourcePlaybackRestrictionPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &ivs.UpdatePlaybackRestrictionPolicyInput{
+			Arn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("allowed_countries") {
+			in.AllowedCountries = flex.ExpandStringSet(d.Get("allowed_countries").(*schema.Set))
+		}
+
+		if d.HasChange("allowed_origins") {
+			in.AllowedOrigins = flex.ExpandStringSet(d.Get("allowed_origins").(*schema.Set))
+		}
+
+		if d.HasChange("enable_strict_origin_enforcement") {
+			in.EnableStrictOriginEnforcement = aws.Bool(d.Get("enable_strict_origin_enforcement").(bool))
+		}
+
+		if d.HasChange("name") {
+			in.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdatePlaybackRestrictionPolicyWithContext(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.IVS, create.ErrActionUpdating, ResNamePlaybackRestrictionPolicy, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePlaybackRestrictionPolicyRead(ctx, d, meta)...)
+}
+
+func resourcePlaybackRestrictionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSConn(ctx)
+
+	log.Printf("[INFO] Deleting IVS PlaybackRestrictionPolicy %s", d.Id())
+
+	_, err := conn.DeletePlaybackRestrictionPolicyWithContext(ctx, &ivs.DeletePlaybackRestrictionPolicyInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ivs.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IVS, create.ErrActionDeleting, ResNamePlaybackRestrictionPolicy, d.Id(), err)
+	}
+
+	return diags
+}
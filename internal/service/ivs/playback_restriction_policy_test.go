@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfivs "github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSPlaybackRestrictionPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy ivs.PlaybackRestrictionPolicy
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "allowed_countries.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "enable_strict_origin_enforcement", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ivs", regexache.MustCompile(`playback-restriction-policy/.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ivs.PlaybackRestrictionPolicy
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_update(rName1, "US", "https://example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_countries.*", "US"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "enable_strict_origin_enforcement", "false"),
+				),
+			},
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_update(rName2, "GB", "https://example.org", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &v2),
+					testAccCheckPlaybackRestrictionPolicyNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_countries.*", "GB"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://example.org"),
+					resource.TestCheckResourceAttr(resourceName, "enable_strict_origin_enforcement", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy ivs.PlaybackRestrictionPolicy
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSPlaybackRestrictionPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy ivs.PlaybackRestrictionPolicy
+	resourceName := "aws_ivs_playback_restriction_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlaybackRestrictionPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlaybackRestrictionPolicyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlaybackRestrictionPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfivs.ResourcePlaybackRestrictionPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPlaybackRestrictionPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ivs_playback_restriction_policy" {
+				continue
+			}
+
+			_, err := tfivs.FindPlaybackRestrictionPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.IVS, create.ErrActionCheckingDestroyed, tfivs.ResNamePlaybackRestrictionPolicy, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPlaybackRestrictionPolicyExists(ctx context.Context, name string, policy *ivs.PlaybackRestrictionPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.IVS, create.ErrActionCheckingExistence, tfivs.ResNamePlaybackRestrictionPolicy, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.IVS, create.ErrActionCheckingExistence, tfivs.ResNamePlaybackRestrictionPolicy, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
+
+		resp, err := tfivs.FindPlaybackRestrictionPolicyByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.IVS, create.ErrActionCheckingExistence, tfivs.ResNamePlaybackRestrictionPolicy, rs.Primary.ID, err)
+		}
+
+		*policy = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckPlaybackRestrictionPolicyNotRecreated(before, after *ivs.PlaybackRestrictionPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Arn), aws.StringValue(after.Arn); before != after {
+			return create.Error(names.IVS, create.ErrActionCheckingNotRecreated, tfivs.ResNamePlaybackRestrictionPolicy, before, errors.New("recreated"))
+		}
+
+		return nil
+	}
+}
+
+func testAccPlaybackRestrictionPolicyConfig_basic() string {
+	return `
+resource "aws_ivs_playback_restriction_policy" "test" {
+}
+`
+}
+
+func testAccPlaybackRestrictionPolicyConfig_update(rName, country, origin string, strict bool) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  name                             = %[1]q
+  allowed_countries                = [%[2]q]
+  allowed_origins                  = [%[3]q]
+  enable_strict_origin_enforcement = %[4]t
+}
+`, rName, country, origin, strict)
+}
+
+func testAccPlaybackRestrictionPolicyConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccPlaybackRestrictionPolicyConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_playback_restriction_policy" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -111,6 +111,47 @@ func TestAccIVSRecordingConfiguration_update(t *testing.T) {
 	})
 }
 
+func TestAccIVSRecordingConfiguration_renditionAndThumbnail(t *testing.T) {
+	ctx := acctest.Context(t)
+	var recordingConfiguration ivs.RecordingConfiguration
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_recording_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccRecordingConfigurationPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordingConfigurationConfig_renditionAndThumbnail(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &recordingConfiguration),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.rendition_selection", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.renditions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rendition_configuration.0.renditions.*", "HD"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rendition_configuration.0.renditions.*", "SD"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.recording_mode", "INTERVAL"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.resolution", "HD"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.storage.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thumbnail_configuration.0.storage.*", "SEQUENTIAL"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thumbnail_configuration.0.storage.*", "LATEST"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.target_interval_seconds", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccIVSRecordingConfiguration_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var recordingconfiguration ivs.RecordingConfiguration
@@ -353,6 +394,32 @@ resource "aws_ivs_recording_configuration" "test" {
 `, rName, recordingReconnectWindowSeconds, recordingMode, targetIntervalSeconds))
 }
 
+func testAccRecordingConfigurationConfig_renditionAndThumbnail(bucketName string) string {
+	return acctest.ConfigCompose(
+		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
+		`
+resource "aws_ivs_recording_configuration" "test" {
+  destination_configuration {
+    s3 {
+      bucket_name = aws_s3_bucket.test.id
+    }
+  }
+
+  rendition_configuration {
+    rendition_selection = "CUSTOM"
+    renditions          = ["HD", "SD"]
+  }
+
+  thumbnail_configuration {
+    recording_mode          = "INTERVAL"
+    resolution              = "HD"
+    storage                 = ["SEQUENTIAL", "LATEST"]
+    target_interval_seconds = 30
+  }
+}
+`)
+}
+
 func testAccRecordingConfigurationConfig_tags1(bucketName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kendra_featured_results_set", name="Featured Results Set")
+// @Tags(identifierAttribute="arn")
+func ResourceFeaturedResultsSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeaturedResultsSetCreate,
+		ReadWithoutTimeout:   resourceFeaturedResultsSetRead,
+		UpdateWithoutTimeout: resourceFeaturedResultsSetUpdate,
+		DeleteWithoutTimeout: resourceFeaturedResultsSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"featured_documents": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 4,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+			},
+			"featured_results_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"query_texts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 49,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.FeaturedResultsSetStatus](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFeaturedResultsSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	name := d.Get("name").(string)
+	indexId := d.Get("index_id").(string)
+	input := &kendra.CreateFeaturedResultsSetInput{
+		ClientToken:            aws.String(id.UniqueId()),
+		FeaturedResultsSetName: aws.String(name),
+		IndexId:                aws.String(indexId),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("featured_documents"); ok && v.(*schema.Set).Len() > 0 {
+		input.FeaturedDocuments = expandFeaturedDocuments(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("query_texts"); ok && v.(*schema.Set).Len() > 0 {
+		input.QueryTexts = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = types.FeaturedResultsSetStatus(v.(string))
+	}
+
+	output, err := conn.CreateFeaturedResultsSet(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Kendra Featured Results Set (%s): %s", name, err)
+	}
+
+	if output == nil || output.FeaturedResultsSet == nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Kendra Featured Results Set (%s): empty output", name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", aws.ToString(output.FeaturedResultsSet.FeaturedResultsSetId), indexId))
+
+	return append(diags, resourceFeaturedResultsSetRead(ctx, d, meta)...)
+}
+
+func resourceFeaturedResultsSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Featured Results Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "kendra",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/featured-results-set/%s", indexId, id),
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("created_at", time.UnixMilli(aws.ToInt64(out.CreationTimestamp)).UTC().Format(time.RFC3339))
+	d.Set("description", out.Description)
+	d.Set("featured_results_set_id", out.FeaturedResultsSetId)
+	d.Set("index_id", indexId)
+	d.Set("name", out.FeaturedResultsSetName)
+	d.Set("query_texts", out.QueryTexts)
+	d.Set("status", out.Status)
+	d.Set("updated_at", time.UnixMilli(aws.ToInt64(out.LastUpdatedTimestamp)).UTC().Format(time.RFC3339))
+
+	if err := d.Set("featured_documents", flattenFeaturedDocuments(out.FeaturedDocumentsWithMetadata, out.FeaturedDocumentsMissing)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting featured_documents: %s", err)
+	}
+
+	return diags
+}
+
+func resourceFeaturedResultsSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// The update replaces the whole featured results set, so every argument is sent.
+		input := &kendra.UpdateFeaturedResultsSetInput{
+			Description:            aws.String(d.Get("description").(string)),
+			FeaturedDocuments:      expandFeaturedDocuments(d.Get("featured_documents").(*schema.Set).List()),
+			FeaturedResultsSetId:   aws.String(id),
+			FeaturedResultsSetName: aws.String(d.Get("name").(string)),
+			IndexId:                aws.String(indexId),
+			QueryTexts:             flex.ExpandStringValueSet(d.Get("query_texts").(*schema.Set)),
+		}
+
+		if v, ok := d.GetOk("status"); ok {
+			input.Status = types.FeaturedResultsSetStatus(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Featured Results Set (%s): %#v", d.Id(), input)
+
+		_, err = conn.UpdateFeaturedResultsSet(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Kendra Featured Results Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFeaturedResultsSetRead(ctx, d, meta)...)
+}
+
+func resourceFeaturedResultsSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	log.Printf("[INFO] Deleting Kendra Featured Results Set %s", d.Id())
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := conn.BatchDeleteFeaturedResultsSet(ctx, &kendra.BatchDeleteFeaturedResultsSetInput{
+		FeaturedResultsSetIds: []string{id},
+		IndexId:               aws.String(indexId),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	// Failures for individual sets are returned in the response body rather than as an error.
+	for _, v := range output.Errors {
+		if aws.ToString(v.Id) != id {
+			continue
+		}
+
+		if _, err := FindFeaturedResultsSetByID(ctx, conn, id, indexId); tfresource.NotFound(err) {
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting Kendra Featured Results Set (%s): %s: %s", d.Id(), v.ErrorCode, aws.ToString(v.ErrorMessage))
+	}
+
+	return diags
+}
+
+func expandFeaturedDocuments(tfList []interface{}) []types.FeaturedDocument {
+	apiObjects := make([]types.FeaturedDocument, 0, len(tfList))
+
+	for _, v := range tfList {
+		v, ok := v.(string)
+
+		if !ok || v == "" {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.FeaturedDocument{
+			Id: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenFeaturedDocuments(withMetadata []types.FeaturedDocumentWithMetadata, missing []types.FeaturedDocumentMissing) []string {
+	// Documents that are not (or not yet) in the index are reported separately.
+	tfList := make([]string, 0, len(withMetadata)+len(missing))
+
+	for _, v := range withMetadata {
+		tfList = append(tfList, aws.ToString(v.Id))
+	}
+
+	for _, v := range missing {
+		tfList = append(tfList, aws.ToString(v.Id))
+	}
+
+	return tfList
+}
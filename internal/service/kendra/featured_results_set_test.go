@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKendraFeaturedResultsSet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KendraEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexache.MustCompile(`index/.+/featured-results-set/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "featured_documents.*", "doc1"),
+					resource.TestCheckResourceAttrSet(resourceName, "featured_results_set_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_kendra_index.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "query_texts.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "query_texts.*", "how do I reset my password"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFeaturedResultsSet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KendraEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkendra.ResourceFeaturedResultsSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFeaturedResultsSet_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_featured_results_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KendraEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeaturedResultsSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeaturedResultsSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccFeaturedResultsSetConfig_updated(rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeaturedResultsSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "featured_documents.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "query_texts.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFeaturedResultsSetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kendra_featured_results_set" {
+				continue
+			}
+
+			id, indexId, err := tfkendra.FeaturedResultsSetParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfkendra.FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kendra Featured Results Set %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFeaturedResultsSetExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Featured Results Set is set")
+		}
+
+		id, indexId, err := tfkendra.FeaturedResultsSetParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient(ctx)
+
+		_, err = tfkendra.FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+		if err != nil {
+			return fmt.Errorf("Error describing Kendra Featured Results Set: %s", err.Error())
+		}
+
+		return nil
+	}
+}
+
+func testAccFeaturedResultsSetBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    effect  = "Allow"
+    principals {
+      type        = "Service"
+      identifiers = ["kendra.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  path               = "/"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+resource "aws_kendra_index" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+}
+`, rName)
+}
+
+func testAccFeaturedResultsSetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFeaturedResultsSetBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  index_id           = aws_kendra_index.test.id
+  name               = %[1]q
+  featured_documents = ["doc1"]
+  query_texts        = ["how do I reset my password"]
+}
+`, rName))
+}
+
+func testAccFeaturedResultsSetConfig_updated(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFeaturedResultsSetBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_featured_results_set" "test" {
+  index_id           = aws_kendra_index.test.id
+  name               = %[1]q
+  description        = "Updated"
+  featured_documents = ["doc1", "doc2"]
+  query_texts        = ["how do I reset my password", "password reset"]
+  status             = "INACTIVE"
+}
+`, rName))
+}
@@ -69,6 +69,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceFeaturedResultsSet,
+			TypeName: "aws_kendra_featured_results_set",
+			Name:     "Featured Results Set",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceIndex,
 			TypeName: "aws_kendra_index",
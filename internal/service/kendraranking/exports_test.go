@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendraranking
+
+// Exports for use in tests only.
+var (
+	ResourceRescoreExecutionPlan = resourceRescoreExecutionPlan
+
+	FindRescoreExecutionPlanByID = findRescoreExecutionPlanByID
+)
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendraranking
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kendraranking"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kendraranking/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kendra_ranking_rescore_execution_plan", name="Rescore Execution Plan")
+// @Tags(identifierAttribute="arn")
+func resourceRescoreExecutionPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRescoreExecutionPlanCreate,
+		ReadWithoutTimeout:   resourceRescoreExecutionPlanRead,
+		UpdateWithoutTimeout: resourceRescoreExecutionPlanUpdate,
+		DeleteWithoutTimeout: resourceRescoreExecutionPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_units": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rescore_capacity_units": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRescoreExecutionPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KendraRankingClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &kendraranking.CreateRescoreExecutionPlanInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Name:        aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("capacity_units"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CapacityUnits = expandCapacityUnitsConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateRescoreExecutionPlan(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Kendra Intelligent Ranking Rescore Execution Plan (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	if _, err := waitRescoreExecutionPlanActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Kendra Intelligent Ranking Rescore Execution Plan (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRescoreExecutionPlanRead(ctx, d, meta)...)
+}
+
+func resourceRescoreExecutionPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KendraRankingClient(ctx)
+
+	output, err := findRescoreExecutionPlanByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Intelligent Ranking Rescore Execution Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kendra Intelligent Ranking Rescore Execution Plan (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("capacity_units", flattenCapacityUnitsConfiguration(output.CapacityUnits)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting capacity_units: %s", err)
+	}
+	d.Set("created_at", aws.ToTime(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("updated_at", aws.ToTime(output.UpdatedAt).Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceRescoreExecutionPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KendraRankingClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &kendraranking.UpdateRescoreExecutionPlanInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("capacity_units") {
+			if v, ok := d.GetOk("capacity_units"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CapacityUnits = expandCapacityUnitsConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdateRescoreExecutionPlan(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Kendra Intelligent Ranking Rescore Execution Plan (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitRescoreExecutionPlanActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Kendra Intelligent Ranking Rescore Execution Plan (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRescoreExecutionPlanRead(ctx, d, meta)...)
+}
+
+func resourceRescoreExecutionPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KendraRankingClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Kendra Intelligent Ranking Rescore Execution Plan: %s", d.Id())
+	_, err := conn.DeleteRescoreExecutionPlan(ctx, &kendraranking.DeleteRescoreExecutionPlanInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kendra Intelligent Ranking Rescore Execution Plan (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitRescoreExecutionPlanDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Kendra Intelligent Ranking Rescore Execution Plan (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findRescoreExecutionPlanByID(ctx context.Context, conn *kendraranking.Client, id string) (*kendraranking.DescribeRescoreExecutionPlanOutput, error) {
+	input := &kendraranking.DescribeRescoreExecutionPlanInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeRescoreExecutionPlan(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRescoreExecutionPlan(ctx context.Context, conn *kendraranking.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findRescoreExecutionPlanByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitRescoreExecutionPlanActive(ctx context.Context, conn *kendraranking.Client, id string, timeout time.Duration) (*kendraranking.DescribeRescoreExecutionPlanOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.RescoreExecutionPlanStatusCreating, awstypes.RescoreExecutionPlanStatusUpdating),
+		Target:  enum.Slice(awstypes.RescoreExecutionPlanStatusActive),
+		Refresh: statusRescoreExecutionPlan(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendraranking.DescribeRescoreExecutionPlanOutput); ok {
+		if output.Status == awstypes.RescoreExecutionPlanStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitRescoreExecutionPlanDeleted(ctx context.Context, conn *kendraranking.Client, id string, timeout time.Duration) (*kendraranking.DescribeRescoreExecutionPlanOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.RescoreExecutionPlanStatusDeleting),
+		Target:  []string{},
+		Refresh: statusRescoreExecutionPlan(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendraranking.DescribeRescoreExecutionPlanOutput); ok {
+		if output.Status == awstypes.RescoreExecutionPlanStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCapacityUnitsConfiguration(tfMap map[string]interface{}) *awstypes.CapacityUnitsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.CapacityUnitsConfiguration{}
+
+	if v, ok := tfMap["rescore_capacity_units"].(int); ok {
+		apiObject.RescoreCapacityUnits = int32(v)
+	}
+
+	return apiObject
+}
+
+func flattenCapacityUnitsConfiguration(apiObject *awstypes.CapacityUnitsConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"rescore_capacity_units": apiObject.RescoreCapacityUnits,
+	}
+
+	return []interface{}{tfMap}
+}
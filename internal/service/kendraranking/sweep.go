@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendraranking
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kendraranking"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_kendra_ranking_rescore_execution_plan", &resource.Sweeper{
+		Name: "aws_kendra_ranking_rescore_execution_plan",
+		F:    sweepRescoreExecutionPlans,
+	})
+}
+
+func sweepRescoreExecutionPlans(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.KendraRankingClient(ctx)
+	input := &kendraranking.ListRescoreExecutionPlansInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := kendraranking.NewListRescoreExecutionPlansPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Kendra Intelligent Ranking Rescore Execution Plan sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Kendra Intelligent Ranking Rescore Execution Plans (%s): %w", region, err)
+		}
+
+		for _, v := range page.SummaryItems {
+			r := resourceRescoreExecutionPlan()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Kendra Intelligent Ranking Rescore Execution Plans (%s): %w", region, err)
+	}
+
+	return nil
+}
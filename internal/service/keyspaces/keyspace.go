@@ -17,8 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -59,6 +61,36 @@ func resourceKeyspace() *schema.Resource {
 					"The name can have up to 48 characters. It must begin with an alpha-numeric character and can only contain alpha-numeric characters and underscores.",
 				),
 			},
+			"replication_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 6,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"replication_strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.Rs](),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -76,6 +108,10 @@ func resourceKeyspaceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		Tags:         getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("replication_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ReplicationSpecification = expandReplicationSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.CreateKeyspace(ctx, input)
 
 	if err != nil {
@@ -114,6 +150,9 @@ func resourceKeyspaceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set("arn", keyspace.ResourceArn)
 	d.Set("name", keyspace.KeyspaceName)
+	if err := d.Set("replication_specification", []interface{}{flattenReplicationSpecification(keyspace)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replication_specification: %s", err)
+	}
 
 	return diags
 }
@@ -180,3 +219,34 @@ func findKeyspaceByName(ctx context.Context, conn *keyspaces.Client, name string
 
 	return output, nil
 }
+
+func expandReplicationSpecification(tfMap map[string]interface{}) *types.ReplicationSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ReplicationSpecification{}
+
+	if v, ok := tfMap["region_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RegionList = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["replication_strategy"].(string); ok && v != "" {
+		apiObject.ReplicationStrategy = types.Rs(v)
+	}
+
+	return apiObject
+}
+
+func flattenReplicationSpecification(apiObject *keyspaces.GetKeyspaceOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"region_list":          apiObject.ReplicationRegions,
+		"replication_strategy": apiObject.ReplicationStrategy,
+	}
+
+	return tfMap
+}
@@ -40,6 +40,8 @@ func TestAccKeyspacesKeyspace_basic(t *testing.T) {
 					testAccCheckKeyspaceExists(ctx, resourceName),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "cassandra", "/keyspace/"+rName+"/"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replication_strategy", "SINGLE_REGION"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -120,6 +122,41 @@ func TestAccKeyspacesKeyspace_tags(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesKeyspace_replicationSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_keyspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyspaceConfig_replicationSpecification(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyspaceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.region_list.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replication_strategy", "MULTI_REGION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckKeyspaceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).KeyspacesClient(ctx)
@@ -173,6 +210,19 @@ resource "aws_keyspaces_keyspace" "test" {
 `, rName)
 }
 
+func testAccKeyspaceConfig_replicationSpecification(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  replication_specification {
+    replication_strategy = "MULTI_REGION"
+    region_list          = [%[2]q, %[3]q]
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion())
+}
+
 func testAccKeyspaceConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_keyspaces_keyspace" "test" {
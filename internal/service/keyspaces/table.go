@@ -73,6 +73,18 @@ func resourceTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_scaling_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_auto_scaling":  autoScalingSettingsSchema(),
+						"write_capacity_auto_scaling": autoScalingSettingsSchema(),
+					},
+				},
+			},
 			"capacity_specification": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -181,6 +193,26 @@ func resourceTable() *schema.Resource {
 					},
 				},
 			},
+			"replica_specification": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_auto_scaling": autoScalingSettingsSchema(),
+						"read_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+					},
+				},
+			},
 			"schema_definition": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -317,6 +349,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Tags:         getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("auto_scaling_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoScalingSpecification = expandAutoScalingSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("capacity_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.CapacitySpecification = expandCapacitySpecification(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -341,6 +377,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.PointInTimeRecovery = expandPointInTimeRecovery(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("replica_specification"); ok && v.(*schema.Set).Len() > 0 {
+		input.ReplicaSpecifications = expandReplicaSpecifications(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("schema_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.SchemaDefinition = expandSchemaDefinition(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -387,7 +427,24 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "reading Keyspaces Table (%s): %s", d.Id(), err)
 	}
 
+	// Auto scaling settings are only available for tables in provisioned capacity mode.
+	var autoScalingSettings *keyspaces.GetTableAutoScalingSettingsOutput
+	if table.CapacitySpecification != nil && table.CapacitySpecification.ThroughputMode == types.ThroughputModeProvisioned {
+		autoScalingSettings, err = findTableAutoScalingSettingsByTwoPartKey(ctx, conn, keyspaceName, tableName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Keyspaces Table (%s) auto scaling settings: %s", d.Id(), err)
+		}
+	}
+
 	d.Set("arn", table.ResourceArn)
+	if autoScalingSettings != nil && autoScalingSettings.AutoScalingSpecification != nil {
+		if err := d.Set("auto_scaling_specification", []interface{}{flattenAutoScalingSpecification(autoScalingSettings.AutoScalingSpecification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting auto_scaling_specification: %s", err)
+		}
+	} else {
+		d.Set("auto_scaling_specification", nil)
+	}
 	if table.CapacitySpecification != nil {
 		if err := d.Set("capacity_specification", []interface{}{flattenCapacitySpecificationSummary(table.CapacitySpecification)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting capacity_specification: %s", err)
@@ -425,6 +482,13 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	} else {
 		d.Set("point_in_time_recovery", nil)
 	}
+	var replicaAutoScalingSpecifications []types.ReplicaAutoScalingSpecification
+	if autoScalingSettings != nil {
+		replicaAutoScalingSpecifications = autoScalingSettings.ReplicaSpecifications
+	}
+	if err := d.Set("replica_specification", flattenReplicaSpecificationSummaries(table.ReplicaSpecifications, replicaAutoScalingSpecifications)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replica_specification: %s", err)
+	}
 	if table.SchemaDefinition != nil {
 		if err := d.Set("schema_definition", []interface{}{flattenSchemaDefinition(table.SchemaDefinition)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting schema_definition: %s", err)
@@ -478,6 +542,26 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 		}
 
+		if d.HasChange("auto_scaling_specification") {
+			if v, ok := d.GetOk("auto_scaling_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input := &keyspaces.UpdateTableInput{
+					AutoScalingSpecification: expandAutoScalingSpecification(v.([]interface{})[0].(map[string]interface{})),
+					KeyspaceName:             aws.String(keyspaceName),
+					TableName:                aws.String(tableName),
+				}
+
+				_, err := conn.UpdateTable(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating Keyspaces Table (%s) AutoScalingSpecification: %s", d.Id(), err)
+				}
+
+				if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Table (%s) AutoScalingSpecification update: %s", d.Id(), err)
+				}
+			}
+		}
+
 		if d.HasChange("client_side_timestamps") {
 			if v, ok := d.GetOk("client_side_timestamps"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input := &keyspaces.UpdateTableInput{
@@ -556,6 +640,26 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 		}
 
+		if d.HasChange("replica_specification") {
+			if v, ok := d.GetOk("replica_specification"); ok && v.(*schema.Set).Len() > 0 {
+				input := &keyspaces.UpdateTableInput{
+					KeyspaceName:          aws.String(keyspaceName),
+					ReplicaSpecifications: expandReplicaSpecifications(v.(*schema.Set).List()),
+					TableName:             aws.String(tableName),
+				}
+
+				_, err := conn.UpdateTable(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating Keyspaces Table (%s) ReplicaSpecifications: %s", d.Id(), err)
+				}
+
+				if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Keyspaces Table (%s) ReplicaSpecifications update: %s", d.Id(), err)
+				}
+			}
+		}
+
 		if d.HasChange("ttl") {
 			if v, ok := d.GetOk("ttl"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input := &keyspaces.UpdateTableInput{
@@ -700,6 +804,32 @@ func findTableByTwoPartKey(ctx context.Context, conn *keyspaces.Client, keyspace
 	return output, nil
 }
 
+func findTableAutoScalingSettingsByTwoPartKey(ctx context.Context, conn *keyspaces.Client, keyspaceName, tableName string) (*keyspaces.GetTableAutoScalingSettingsOutput, error) {
+	input := keyspaces.GetTableAutoScalingSettingsInput{
+		KeyspaceName: aws.String(keyspaceName),
+		TableName:    aws.String(tableName),
+	}
+
+	output, err := conn.GetTableAutoScalingSettings(ctx, &input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func statusTable(ctx context.Context, conn *keyspaces.Client, keyspaceName, tableName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findTableByTwoPartKey(ctx, conn, keyspaceName, tableName)
@@ -768,6 +898,161 @@ func waitTableUpdated(ctx context.Context, conn *keyspaces.Client, keyspaceName,
 	return nil, err
 }
 
+func autoScalingSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auto_scaling_disabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"maximum_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"minimum_units": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"scaling_policy": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"target_tracking_scaling_policy_configuration": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"disable_scale_in": {
+											Type:     schema.TypeBool,
+											Optional: true,
+											Default:  false,
+										},
+										"scale_in_cooldown": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      0,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+										"scale_out_cooldown": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      0,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+										"target_value": {
+											Type:         schema.TypeFloat,
+											Required:     true,
+											ValidateFunc: validation.FloatBetween(20, 90),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandAutoScalingSpecification(tfMap map[string]interface{}) *types.AutoScalingSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AutoScalingSpecification{}
+
+	if v, ok := tfMap["read_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ReadCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["write_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WriteCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoScalingSettings(tfMap map[string]interface{}) *types.AutoScalingSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AutoScalingSettings{}
+
+	if v, ok := tfMap["auto_scaling_disabled"].(bool); ok {
+		apiObject.AutoScalingDisabled = v
+	}
+
+	if v, ok := tfMap["maximum_units"].(int); ok && v != 0 {
+		apiObject.MaximumUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minimum_units"].(int); ok && v != 0 {
+		apiObject.MinimumUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scaling_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ScalingPolicy = expandAutoScalingPolicy(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoScalingPolicy(tfMap map[string]interface{}) *types.AutoScalingPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.AutoScalingPolicy{}
+
+	if v, ok := tfMap["target_tracking_scaling_policy_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TargetTrackingScalingPolicyConfiguration = expandTargetTrackingScalingPolicyConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTargetTrackingScalingPolicyConfiguration(tfMap map[string]interface{}) *types.TargetTrackingScalingPolicyConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.TargetTrackingScalingPolicyConfiguration{}
+
+	if v, ok := tfMap["disable_scale_in"].(bool); ok {
+		apiObject.DisableScaleIn = v
+	}
+
+	if v, ok := tfMap["scale_in_cooldown"].(int); ok {
+		apiObject.ScaleInCooldown = int32(v)
+	}
+
+	if v, ok := tfMap["scale_out_cooldown"].(int); ok {
+		apiObject.ScaleOutCooldown = int32(v)
+	}
+
+	if v, ok := tfMap["target_value"].(float64); ok {
+		apiObject.TargetValue = v
+	}
+
+	return apiObject
+}
+
 func expandCapacitySpecification(tfMap map[string]interface{}) *types.CapacitySpecification {
 	if tfMap == nil {
 		return nil
@@ -850,6 +1135,54 @@ func expandPointInTimeRecovery(tfMap map[string]interface{}) *types.PointInTimeR
 	return apiObject
 }
 
+func expandReplicaSpecification(tfMap map[string]interface{}) *types.ReplicaSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ReplicaSpecification{}
+
+	if v, ok := tfMap["read_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ReadCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["read_capacity_units"].(int); ok && v != 0 {
+		apiObject.ReadCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandReplicaSpecifications(tfList []interface{}) []types.ReplicaSpecification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.ReplicaSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandReplicaSpecification(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandSchemaDefinition(tfMap map[string]interface{}) *types.SchemaDefinition {
 	if tfMap == nil {
 		return nil
@@ -1058,6 +1391,77 @@ func expandStaticColumns(tfList []interface{}) []types.StaticColumn {
 	return apiObjects
 }
 
+func flattenAutoScalingSpecification(apiObject *types.AutoScalingSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ReadCapacityAutoScaling; v != nil {
+		tfMap["read_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v)}
+	}
+
+	if v := apiObject.WriteCapacityAutoScaling; v != nil {
+		tfMap["write_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAutoScalingSettings(apiObject *types.AutoScalingSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"auto_scaling_disabled": apiObject.AutoScalingDisabled,
+	}
+
+	if v := apiObject.MaximumUnits; v != nil {
+		tfMap["maximum_units"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.MinimumUnits; v != nil {
+		tfMap["minimum_units"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.ScalingPolicy; v != nil {
+		tfMap["scaling_policy"] = []interface{}{flattenAutoScalingPolicy(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAutoScalingPolicy(apiObject *types.AutoScalingPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TargetTrackingScalingPolicyConfiguration; v != nil {
+		tfMap["target_tracking_scaling_policy_configuration"] = []interface{}{flattenTargetTrackingScalingPolicyConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenTargetTrackingScalingPolicyConfiguration(apiObject *types.TargetTrackingScalingPolicyConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"disable_scale_in":   apiObject.DisableScaleIn,
+		"scale_in_cooldown":  apiObject.ScaleInCooldown,
+		"scale_out_cooldown": apiObject.ScaleOutCooldown,
+		"target_value":       apiObject.TargetValue,
+	}
+
+	return tfMap
+}
+
 func flattenCapacitySpecificationSummary(apiObject *types.CapacitySpecificationSummary) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -1132,6 +1536,40 @@ func flattenPointInTimeRecoverySummary(apiObject *types.PointInTimeRecoverySumma
 	return tfMap
 }
 
+// flattenReplicaSpecificationSummaries merges the per-Region capacity settings returned by GetTable
+// with the per-Region auto scaling settings returned by GetTableAutoScalingSettings.
+func flattenReplicaSpecificationSummaries(apiObjects []types.ReplicaSpecificationSummary, autoScalingApiObjects []types.ReplicaAutoScalingSpecification) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	autoScalingByRegion := make(map[string]*types.AutoScalingSpecification)
+	for _, v := range autoScalingApiObjects {
+		autoScalingByRegion[aws.ToString(v.Region)] = v.AutoScalingSpecification
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		region := aws.ToString(apiObject.Region)
+		tfMap := map[string]interface{}{
+			"region": region,
+		}
+
+		if v := apiObject.CapacitySpecification; v != nil && v.ReadCapacityUnits != nil {
+			tfMap["read_capacity_units"] = aws.ToInt64(v.ReadCapacityUnits)
+		}
+
+		if v, ok := autoScalingByRegion[region]; ok && v != nil && v.ReadCapacityAutoScaling != nil {
+			tfMap["read_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v.ReadCapacityAutoScaling)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenSchemaDefinition(apiObject *types.SchemaDefinition) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -178,6 +178,96 @@ func TestAccKeyspacesTable_clientSideTimestamps(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesTable_autoScalingSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_autoScalingSpecification(rName1, rName2, 5, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.auto_scaling_disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.maximum_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.minimum_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.scaling_policy.0.target_tracking_scaling_policy_configuration.0.target_value", "50"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.minimum_units", "5"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.scaling_policy.0.target_tracking_scaling_policy_configuration.0.target_value", "50"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_specification.0.throughput_mode", "PROVISIONED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_autoScalingSpecification(rName1, rName2, 2, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v2),
+					testAccCheckTableNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.minimum_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.read_capacity_auto_scaling.0.scaling_policy.0.target_tracking_scaling_policy_configuration.0.target_value", "70"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.minimum_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_specification.0.write_capacity_auto_scaling.0.scaling_policy.0.target_tracking_scaling_policy_configuration.0.target_value", "70"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyspacesTable_replicaSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v keyspaces.GetTableOutput
+	rName1 := "tf_acc_test_" + sdkacctest.RandString(20)
+	rName2 := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KeyspacesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_replicaSpecification(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "replica_specification.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica_specification.*", map[string]string{
+						"region":              acctest.Region(),
+						"read_capacity_units": "5",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica_specification.*", map[string]string{
+						"region":              acctest.AlternateRegion(),
+						"read_capacity_units": "10",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccKeyspacesTable_multipleColumns(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v keyspaces.GetTableOutput
@@ -672,6 +762,109 @@ resource "aws_keyspaces_table" "test" {
 `, rName1, rName2)
 }
 
+func testAccTableConfig_autoScalingSpecification(rName1, rName2 string, minimumUnits int, targetValue float64) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  capacity_specification {
+    read_capacity_units  = 5
+    throughput_mode      = "PROVISIONED"
+    write_capacity_units = 5
+  }
+
+  auto_scaling_specification {
+    read_capacity_auto_scaling {
+      maximum_units = 10
+      minimum_units = %[3]d
+
+      scaling_policy {
+        target_tracking_scaling_policy_configuration {
+          target_value = %[4]g
+        }
+      }
+    }
+
+    write_capacity_auto_scaling {
+      maximum_units = 10
+      minimum_units = %[3]d
+
+      scaling_policy {
+        target_tracking_scaling_policy_configuration {
+          target_value = %[4]g
+        }
+      }
+    }
+  }
+}
+`, rName1, rName2, minimumUnits, targetValue)
+}
+
+func testAccTableConfig_replicaSpecification(rName1, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  replication_specification {
+    replication_strategy = "MULTI_REGION"
+    region_list          = [%[3]q, %[4]q]
+  }
+}
+
+resource "aws_keyspaces_table" "test" {
+  keyspace_name = aws_keyspaces_keyspace.test.name
+  table_name    = %[2]q
+
+  schema_definition {
+    column {
+      name = "message"
+      type = "ascii"
+    }
+
+    partition_key {
+      name = "message"
+    }
+  }
+
+  capacity_specification {
+    read_capacity_units  = 5
+    throughput_mode      = "PROVISIONED"
+    write_capacity_units = 5
+  }
+
+  client_side_timestamps {
+    status = "ENABLED"
+  }
+
+  replica_specification {
+    region              = %[3]q
+    read_capacity_units = 5
+  }
+
+  replica_specification {
+    region              = %[4]q
+    read_capacity_units = 10
+  }
+}
+`, rName1, rName2, acctest.Region(), acctest.AlternateRegion())
+}
+
 func testAccTableConfig_multipleColumns(rName1, rName2 string) string {
 	return fmt.Sprintf(`
 resource "aws_keyspaces_keyspace" "test" {
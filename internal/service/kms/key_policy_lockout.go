@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+)
+
+const (
+	actionPutKeyPolicy = "kms:PutKeyPolicy"
+)
+
+// customizeDiffKeyPolicyLockout fails the plan if a changed key policy would remove the calling principal's
+// kms:PutKeyPolicy permission and bypass_policy_lockout_safety_check is not set, as KMS rejects such a policy.
+func customizeDiffKeyPolicyLockout(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("policy") || !d.NewValueKnown("policy") {
+		return nil
+	}
+
+	policy := d.Get("policy").(string)
+
+	if policy == "" {
+		return nil
+	}
+
+	output, err := tfsts.FindCallerIdentity(ctx, meta.(*conns.AWSClient).STSClient(ctx))
+
+	if err != nil {
+		log.Printf("[WARN] Skipping KMS Key policy lockout analysis, reading caller identity: %s", err)
+		return nil
+	}
+
+	callerARN := aws.ToString(output.Arn)
+	allowed, err := policyAllowsPutKeyPolicy(policy, callerARN, aws.ToString(output.Account))
+
+	if err != nil {
+		log.Printf("[WARN] Skipping KMS Key policy lockout analysis: %s", err)
+		return nil
+	}
+
+	if allowed {
+		return nil
+	}
+
+	if d.Get("bypass_policy_lockout_safety_check").(bool) {
+		log.Printf("[WARN] KMS Key policy does not allow the calling principal (%s) to call %s. Once applied, the key policy may no longer be manageable by this principal", callerARN, actionPutKeyPolicy)
+		return nil
+	}
+
+	return fmt.Errorf("KMS Key policy does not allow the calling principal (%s) to call %s. KMS rejects such policies unless bypass_policy_lockout_safety_check is set", callerARN, actionPutKeyPolicy)
+}
+
+// policyAllowsPutKeyPolicy returns whether the specified key policy allows the principal to call kms:PutKeyPolicy.
+// Statement conditions are not evaluated. A statement allowing the account root principal is treated as allowing
+// any principal in the account, as access is then delegated to IAM policies.
+func policyAllowsPutKeyPolicy(policy, principalARN, accountID string) (bool, error) {
+	var doc tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	principals := keyPolicyLockoutPrincipals(principalARN, accountID)
+	allowed := false
+
+	for _, statement := range doc.Statements {
+		if statement == nil {
+			continue
+		}
+
+		if !statementMatchesAction(statement, actionPutKeyPolicy) || !statementMatchesPrincipal(statement, principals) {
+			continue
+		}
+
+		switch {
+		case strings.EqualFold(statement.Effect, "Deny"):
+			return false, nil
+		case strings.EqualFold(statement.Effect, "Allow"):
+			allowed = true
+		}
+	}
+
+	return allowed, nil
+}
+
+// keyPolicyLockoutPrincipals returns the key policy principal identifiers that identify the specified principal.
+func keyPolicyLockoutPrincipals(principalARN, accountID string) []string {
+	principals := []string{"*", accountID, principalARN}
+
+	if v, err := arn.Parse(principalARN); err == nil {
+		principals = append(principals, arn.ARN{
+			Partition: v.Partition,
+			Service:   "iam",
+			AccountID: v.AccountID,
+			Resource:  "root",
+		}.String())
+
+		// An assumed role session is identified in key policies by its role.
+		if v.Service == "sts" && strings.HasPrefix(v.Resource, "assumed-role/") {
+			parts := strings.Split(v.Resource, "/")
+			if len(parts) >= 2 {
+				principals = append(principals, arn.ARN{
+					Partition: v.Partition,
+					Service:   "iam",
+					AccountID: v.AccountID,
+					Resource:  "role/" + parts[1],
+				}.String())
+			}
+		}
+	}
+
+	return principals
+}
+
+func statementMatchesAction(statement *tfiam.IAMPolicyStatement, action string) bool {
+	if statement.NotActions != nil {
+		return !anyMatch(policyStrings(statement.NotActions), action)
+	}
+
+	return anyMatch(policyStrings(statement.Actions), action)
+}
+
+func statementMatchesPrincipal(statement *tfiam.IAMPolicyStatement, principals []string) bool {
+	for _, principal := range statement.Principals {
+		if principal.Type != "*" && principal.Type != "AWS" {
+			continue
+		}
+
+		for _, identifier := range policyStrings(principal.Identifiers) {
+			for _, v := range principals {
+				if identifier == v || roleARNMatches(identifier, v) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// roleARNMatches returns whether the key policy identifier is the specified IAM role ARN, ignoring any role path.
+func roleARNMatches(identifier, roleARN string) bool {
+	i, err := arn.Parse(identifier)
+	if err != nil {
+		return false
+	}
+
+	r, err := arn.Parse(roleARN)
+	if err != nil {
+		return false
+	}
+
+	if i.Service != "iam" || r.Service != "iam" || i.AccountID != r.AccountID || !strings.HasPrefix(i.Resource, "role/") || !strings.HasPrefix(r.Resource, "role/") {
+		return false
+	}
+
+	return path.Base(i.Resource) == path.Base(r.Resource)
+}
+
+func anyMatch(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(value)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"testing"
+)
+
+func TestPolicyAllowsPutKeyPolicy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID = "123456789012"
+		userARN   = "arn:aws:iam::123456789012:user/admin"
+		roleARN   = "arn:aws:sts::123456789012:assumed-role/deployer/session"
+	)
+
+	testCases := map[string]struct {
+		policy       string
+		principalARN string
+		expected     bool
+		expectError  bool
+	}{
+		"account root": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`,
+			principalARN: userARN,
+			expected:     true,
+		},
+		"caller is account root": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:PutKeyPolicy","Resource":"*"}]}`,
+			principalARN: "arn:aws:iam::123456789012:root",
+			expected:     true,
+		},
+		"account ID": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*"}]}`,
+			principalARN: userARN,
+			expected:     true,
+		},
+		"wildcard principal": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"*","Resource":"*"}]}`,
+			principalARN: userARN,
+			expected:     true,
+		},
+		"user": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:user/other","arn:aws:iam::123456789012:user/admin"]},"Action":["kms:Describe*","kms:PutKeyPolicy"],"Resource":"*"}]}`,
+			principalARN: userARN,
+			expected:     true,
+		},
+		"assumed role": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/ci/deployer"},"Action":"kms:Put*","Resource":"*"}]}`,
+			principalARN: roleARN,
+			expected:     true,
+		},
+		"other principal": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:user/other"},"Action":"kms:*","Resource":"*"}]}`,
+			principalARN: userARN,
+			expected:     false,
+		},
+		"other action": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["kms:Encrypt","kms:Decrypt"],"Resource":"*"}]}`,
+			principalARN: userARN,
+			expected:     false,
+		},
+		"not action": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"NotAction":"kms:PutKeyPolicy","Resource":"*"}]}`,
+			principalARN: userARN,
+			expected:     false,
+		},
+		"explicit deny": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"},{"Effect":"Deny","Principal":{"AWS":"arn:aws:iam::123456789012:user/admin"},"Action":"kms:PutKeyPolicy","Resource":"*"}]}`,
+			principalARN: userARN,
+			expected:     false,
+		},
+		"service principal": {
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":{"Service":"logs.amazonaws.com"},"Action":"kms:*","Resource":"*"}]}`,
+			principalARN: userARN,
+			expected:     false,
+		},
+		"invalid JSON": {
+			policy:       `{`,
+			principalARN: userARN,
+			expectError:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := policyAllowsPutKeyPolicy(testCase.policy, testCase.principalARN, accountID)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("policyAllowsPutKeyPolicy() err %t, want %t: %v", got, want, err)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("policyAllowsPutKeyPolicy() = %t, want %t", got, want)
+			}
+		})
+	}
+}
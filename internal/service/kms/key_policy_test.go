@@ -91,7 +91,7 @@ func TestAccKMSKeyPolicy_bypass(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccKeyPolicyConfig_policyBypass(rName, false),
-				ExpectError: regexache.MustCompile(`The new key policy will not allow you to update the key policy in the future`),
+				ExpectError: regexache.MustCompile(`KMS Key policy does not allow the calling principal .* to call kms:PutKeyPolicy`),
 			},
 			{
 				Config: testAccKeyPolicyConfig_policyBypass(rName, true),
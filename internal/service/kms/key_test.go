@@ -211,7 +211,7 @@ func TestAccKMSKey_Policy_bypass(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccKeyConfig_policyBypass(rName, false),
-				ExpectError: regexache.MustCompile(`The new key policy will not allow you to update the key policy in the future`),
+				ExpectError: regexache.MustCompile(`KMS Key policy does not allow the calling principal .* to call kms:PutKeyPolicy`),
 			},
 			{
 				Config: testAccKeyConfig_policyBypass(rName, true),
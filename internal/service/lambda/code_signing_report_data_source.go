@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	DSNameCodeSigningReport = "Code Signing Report Data Source"
+)
+
+const (
+	codeSigningViolationUnsigned           = "unsigned"
+	codeSigningViolationUntrustedPublisher = "untrusted_publisher"
+)
+
+// @SDKDataSource("aws_lambda_code_signing_report", name="Code Signing Report")
+func dataSourceCodeSigningReport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCodeSigningReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"compliant_function_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"uncovered_function_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"violations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_signing_config_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"function_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCodeSigningReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
+
+	// Map each function covered by a code signing config to that config.
+	codeSigningConfigs := make(map[string]*lambda.CodeSigningConfig)
+
+	var configs []*lambda.CodeSigningConfig
+	err := conn.ListCodeSigningConfigsPagesWithContext(ctx, &lambda.ListCodeSigningConfigsInput{}, func(page *lambda.ListCodeSigningConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CodeSigningConfigs {
+			if v != nil {
+				configs = append(configs, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lambda, create.ErrActionReading, DSNameCodeSigningReport, "", err)
+	}
+
+	for _, config := range configs {
+		input := &lambda.ListFunctionsByCodeSigningConfigInput{
+			CodeSigningConfigArn: config.CodeSigningConfigArn,
+		}
+
+		err := conn.ListFunctionsByCodeSigningConfigPagesWithContext(ctx, input, func(page *lambda.ListFunctionsByCodeSigningConfigOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.FunctionArns {
+				codeSigningConfigs[aws.StringValue(v)] = config
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.Lambda, create.ErrActionReading, DSNameCodeSigningReport, aws.StringValue(config.CodeSigningConfigArn), err)
+		}
+	}
+
+	var compliantFunctionNames, uncoveredFunctionNames []string
+	var violations []interface{}
+
+	err = conn.ListFunctionsPagesWithContext(ctx, &lambda.ListFunctionsInput{}, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, function := range page.Functions {
+			// Code signing is not supported for container image functions.
+			if function == nil || aws.StringValue(function.PackageType) == lambda.PackageTypeImage {
+				continue
+			}
+
+			functionName := aws.StringValue(function.FunctionName)
+
+			config, ok := codeSigningConfigs[aws.StringValue(function.FunctionArn)]
+			if !ok {
+				uncoveredFunctionNames = append(uncoveredFunctionNames, functionName)
+				continue
+			}
+
+			if reason := codeSigningViolation(function, config); reason != "" {
+				violations = append(violations, map[string]interface{}{
+					"code_signing_config_arn": aws.StringValue(config.CodeSigningConfigArn),
+					"function_name":           functionName,
+					"reason":                  reason,
+				})
+				continue
+			}
+
+			compliantFunctionNames = append(compliantFunctionNames, functionName)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lambda, create.ErrActionReading, DSNameCodeSigningReport, "", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("compliant_function_names", compliantFunctionNames)
+	d.Set("uncovered_function_names", uncoveredFunctionNames)
+	if err := d.Set("violations", violations); err != nil {
+		return create.AppendDiagError(diags, names.Lambda, create.ErrActionSetting, DSNameCodeSigningReport, "violations", err)
+	}
+
+	return diags
+}
+
+// codeSigningViolation returns the reason that the function's deployed code does not satisfy its code signing config.
+// Code that violates a config can be deployed if the config only warns on untrusted artifacts, or if the config was
+// attached after the code was deployed.
+func codeSigningViolation(function *lambda.FunctionConfiguration, config *lambda.CodeSigningConfig) string {
+	signingProfileVersionARN := aws.StringValue(function.SigningProfileVersionArn)
+
+	if signingProfileVersionARN == "" {
+		return codeSigningViolationUnsigned
+	}
+
+	if config.AllowedPublishers == nil || !slices.Contains(aws.StringValueSlice(config.AllowedPublishers.SigningProfileVersionArns), signingProfileVersionARN) {
+		return codeSigningViolationUntrustedPublisher
+	}
+
+	return ""
+}
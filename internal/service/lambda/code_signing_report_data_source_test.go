@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaCodeSigningReportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_code_signing_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeSigningReportDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "uncovered_function_names.*", rName+"-uncovered"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "violations.*", map[string]string{
+						"function_name": rName,
+						"reason":        "unsigned",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "violations.*.code_signing_config_arn", "aws_lambda_code_signing_config.code_signing_config_1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCodeSigningReportDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionConfig_cscCreate(rName), `
+resource "aws_lambda_function" "uncovered" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "${aws_lambda_function.test.function_name}-uncovered"
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+}
+
+data "aws_lambda_code_signing_report" "test" {
+  depends_on = [aws_lambda_function.test, aws_lambda_function.uncovered]
+}
+`)
+}
@@ -12,6 +12,9 @@ import (
 	"strings"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	lambda_sdkv2 "github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lambda"
@@ -21,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -201,6 +206,11 @@ func ResourceEventSourceMapping() *schema.Resource {
 					ValidateFunc: validation.StringInSlice(lambda.FunctionResponseType_Values(), false),
 				},
 			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"last_modified": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -228,12 +238,50 @@ func ResourceEventSourceMapping() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.IntBetween(-1, 10_000),
 			},
+			"metrics_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metrics": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.EventSourceMappingMetric](),
+							},
+						},
+					},
+				},
+			},
 			"parallelization_factor": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(1, 10),
 				Computed:     true,
 			},
+			"provisioned_poller_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_pollers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 2000),
+						},
+						"minimum_pollers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 200),
+						},
+					},
+				},
+			},
 			"queues": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -490,6 +538,16 @@ func resourceEventSourceMappingCreate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for Lambda Event Source Mapping (%s) create: %s", d.Id(), err)
 	}
 
+	if d.HasChanges(eventSourceMappingSDKV2Settings...) {
+		if err := updateEventSourceMappingSDKV2Settings(ctx, meta.(*conns.AWSClient).LambdaClient(ctx), d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Event Source Mapping (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEventSourceMappingUpdate(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Event Source Mapping (%s) update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceEventSourceMappingRead(ctx, d, meta)...)
 }
 
@@ -590,6 +648,29 @@ func resourceEventSourceMappingRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("tumbling_window_in_seconds", eventSourceMappingConfiguration.TumblingWindowInSeconds)
 	d.Set("uuid", eventSourceMappingConfiguration.UUID)
 
+	// kms_key_arn, metrics_config and provisioned_poller_config are only modeled by the SDK v2 Lambda client.
+	output, err := findEventSourceMappingByIDV2(ctx, meta.(*conns.AWSClient).LambdaClient(ctx), d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Event Source Mapping (%s): %s", d.Id(), err)
+	}
+
+	d.Set("kms_key_arn", output.KMSKeyArn)
+	if v := output.MetricsConfig; v != nil {
+		if err := d.Set("metrics_config", []interface{}{flattenEventSourceMappingMetricsConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting metrics_config: %s", err)
+		}
+	} else {
+		d.Set("metrics_config", nil)
+	}
+	if v := output.ProvisionedPollerConfig; v != nil {
+		if err := d.Set("provisioned_poller_config", []interface{}{flattenProvisionedPollerConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting provisioned_poller_config: %s", err)
+		}
+	} else {
+		d.Set("provisioned_poller_config", nil)
+	}
+
 	switch state := d.Get("state").(string); state {
 	case eventSourceMappingStateEnabled, eventSourceMappingStateEnabling:
 		d.Set("enabled", true)
@@ -687,16 +768,28 @@ func resourceEventSourceMappingUpdate(ctx context.Context, d *schema.ResourceDat
 		input.TumblingWindowInSeconds = aws.Int64(int64(d.Get("tumbling_window_in_seconds").(int)))
 	}
 
-	_, err := retryEventSourceMapping(ctx, func() (*lambda.EventSourceMappingConfiguration, error) {
-		return conn.UpdateEventSourceMappingWithContext(ctx, input)
-	})
+	if d.HasChangesExcept(eventSourceMappingSDKV2Settings...) {
+		_, err := retryEventSourceMapping(ctx, func() (*lambda.EventSourceMappingConfiguration, error) {
+			return conn.UpdateEventSourceMappingWithContext(ctx, input)
+		})
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Lambda Event Source Mapping (%s): %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Event Source Mapping (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEventSourceMappingUpdate(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Event Source Mapping (%s) update: %s", d.Id(), err)
+		}
 	}
 
-	if _, err := waitEventSourceMappingUpdate(ctx, conn, d.Id()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Lambda Event Source Mapping (%s) update: %s", d.Id(), err)
+	if d.HasChanges(eventSourceMappingSDKV2Settings...) {
+		if err := updateEventSourceMappingSDKV2Settings(ctx, meta.(*conns.AWSClient).LambdaClient(ctx), d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Event Source Mapping (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEventSourceMappingUpdate(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Event Source Mapping (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceEventSourceMappingRead(ctx, d, meta)...)
@@ -728,6 +821,42 @@ func resourceEventSourceMappingDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+// eventSourceMappingSDKV2Settings are the arguments that are only available in the SDK v2 Lambda API.
+var eventSourceMappingSDKV2Settings = []string{"kms_key_arn", "metrics_config", "provisioned_poller_config"}
+
+func updateEventSourceMappingSDKV2Settings(ctx context.Context, conn *lambda_sdkv2.Client, d *schema.ResourceData) error {
+	input := &lambda_sdkv2.UpdateEventSourceMappingInput{
+		UUID: aws_sdkv2.String(d.Id()),
+	}
+
+	if d.HasChange("kms_key_arn") {
+		// An empty string removes the customer managed key.
+		input.KMSKeyArn = aws_sdkv2.String(d.Get("kms_key_arn").(string))
+	}
+
+	if d.HasChange("metrics_config") {
+		if v, ok := d.GetOk("metrics_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MetricsConfig = expandEventSourceMappingMetricsConfig(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			// AWS ignores the removal if this is left as nil.
+			input.MetricsConfig = &awstypes.EventSourceMappingMetricsConfig{}
+		}
+	}
+
+	if d.HasChange("provisioned_poller_config") {
+		if v, ok := d.GetOk("provisioned_poller_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ProvisionedPollerConfig = expandProvisionedPollerConfig(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			// AWS ignores the removal if this is left as nil.
+			input.ProvisionedPollerConfig = &awstypes.ProvisionedPollerConfig{}
+		}
+	}
+
+	_, err := conn.UpdateEventSourceMapping(ctx, input)
+
+	return err
+}
+
 func expandDestinationConfig(tfMap map[string]interface{}) *lambda.DestinationConfig {
 	if tfMap == nil {
 		return nil
@@ -1133,6 +1262,70 @@ func flattenScalingConfig(apiObject *lambda.ScalingConfig) map[string]interface{
 	return tfMap
 }
 
+func expandEventSourceMappingMetricsConfig(tfMap map[string]interface{}) *awstypes.EventSourceMappingMetricsConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.EventSourceMappingMetricsConfig{}
+
+	if v, ok := tfMap["metrics"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Metrics = flex.ExpandStringyValueSet[awstypes.EventSourceMappingMetric](v)
+	}
+
+	return apiObject
+}
+
+func flattenEventSourceMappingMetricsConfig(apiObject *awstypes.EventSourceMappingMetricsConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Metrics; v != nil {
+		tfMap["metrics"] = flex.FlattenStringyValueSet(v)
+	}
+
+	return tfMap
+}
+
+func expandProvisionedPollerConfig(tfMap map[string]interface{}) *awstypes.ProvisionedPollerConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ProvisionedPollerConfig{}
+
+	if v, ok := tfMap["maximum_pollers"].(int); ok && v != 0 {
+		apiObject.MaximumPollers = aws_sdkv2.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["minimum_pollers"].(int); ok && v != 0 {
+		apiObject.MinimumPollers = aws_sdkv2.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenProvisionedPollerConfig(apiObject *awstypes.ProvisionedPollerConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaximumPollers; v != nil {
+		tfMap["maximum_pollers"] = aws_sdkv2.ToInt32(v)
+	}
+
+	if v := apiObject.MinimumPollers; v != nil {
+		tfMap["minimum_pollers"] = aws_sdkv2.ToInt32(v)
+	}
+
+	return tfMap
+}
+
 func findEventSourceMappingConfiguration(ctx context.Context, conn *lambda.Lambda, input *lambda.GetEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
 	output, err := conn.GetEventSourceMappingWithContext(ctx, input)
 
@@ -1162,6 +1355,31 @@ func FindEventSourceMappingConfigurationByID(ctx context.Context, conn *lambda.L
 	return findEventSourceMappingConfiguration(ctx, conn, input)
 }
 
+func findEventSourceMappingByIDV2(ctx context.Context, conn *lambda_sdkv2.Client, uuid string) (*lambda_sdkv2.GetEventSourceMappingOutput, error) {
+	input := &lambda_sdkv2.GetEventSourceMappingInput{
+		UUID: aws_sdkv2.String(uuid),
+	}
+
+	output, err := conn.GetEventSourceMapping(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func statusEventSourceMappingState(ctx context.Context, conn *lambda.Lambda, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		eventSourceMappingConfiguration, err := FindEventSourceMappingConfigurationByID(ctx, conn, id)
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	apprunnertypes "github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	DSNameHyperplaneENIUsage = "Hyperplane ENI Usage Data Source"
+)
+
+const (
+	hyperplaneENIServiceAppRunner = "apprunner"
+	hyperplaneENIServiceLambda    = "lambda"
+)
+
+// @SDKDataSource("aws_lambda_hyperplane_eni_usage", name="Hyperplane ENI Usage")
+func dataSourceHyperplaneENIUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceHyperplaneENIUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"apprunner_eni_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"combinations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"consumers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"lambda_eni_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"total_eni_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceHyperplaneENIUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	usage := newHyperplaneENIUsage()
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		usage.subnetIDs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	lambdaConn := meta.(*conns.AWSClient).LambdaConn(ctx)
+
+	err := lambdaConn.ListFunctionsPagesWithContext(ctx, &lambda.ListFunctionsInput{}, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, function := range page.Functions {
+			if function == nil || function.VpcConfig == nil {
+				continue
+			}
+
+			usage.add(hyperplaneENIServiceLambda, aws.StringValue(function.FunctionName), aws.StringValueSlice(function.VpcConfig.SubnetIds), aws.StringValueSlice(function.VpcConfig.SecurityGroupIds))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lambda, create.ErrActionReading, DSNameHyperplaneENIUsage, "", err)
+	}
+
+	appRunnerConn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+
+	pages := apprunner.NewListVpcConnectorsPaginator(appRunnerConn, &apprunner.ListVpcConnectorsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.Lambda, create.ErrActionReading, DSNameHyperplaneENIUsage, "", err)
+		}
+
+		for _, v := range page.VpcConnectors {
+			// Only active VPC connectors hold network interfaces.
+			if v.Status != apprunnertypes.VpcConnectorStatusActive {
+				continue
+			}
+
+			usage.add(hyperplaneENIServiceAppRunner, aws.StringValue(v.VpcConnectorArn), v.Subnets, v.SecurityGroups)
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("apprunner_eni_count", usage.count(hyperplaneENIServiceAppRunner))
+	if err := d.Set("combinations", usage.flatten()); err != nil {
+		return create.AppendDiagError(diags, names.Lambda, create.ErrActionSetting, DSNameHyperplaneENIUsage, "combinations", err)
+	}
+	d.Set("lambda_eni_count", usage.count(hyperplaneENIServiceLambda))
+	d.Set("total_eni_count", len(usage.combinations))
+
+	return diags
+}
+
+// hyperplaneENIUsage groups the consumers of Hyperplane elastic network interfaces.
+// Lambda and App Runner each create a Hyperplane ENI for every unique combination of subnet and security groups
+// that a function or VPC connector is attached to. ENIs are not shared between services.
+type hyperplaneENIUsage struct {
+	combinations map[string]*hyperplaneENICombination
+	// subnetIDs optionally restricts usage to the specified subnets.
+	subnetIDs []string
+}
+
+type hyperplaneENICombination struct {
+	consumers        []string
+	securityGroupIDs []string
+	service          string
+	subnetID         string
+}
+
+func newHyperplaneENIUsage() *hyperplaneENIUsage {
+	return &hyperplaneENIUsage{
+		combinations: make(map[string]*hyperplaneENICombination),
+	}
+}
+
+// add records a consumer attached to the specified subnets and security groups.
+func (u *hyperplaneENIUsage) add(service, consumer string, subnetIDs, securityGroupIDs []string) {
+	securityGroupIDs = slices.Clone(securityGroupIDs)
+	slices.Sort(securityGroupIDs)
+	securityGroupIDs = slices.Compact(securityGroupIDs)
+
+	for _, subnetID := range subnetIDs {
+		if len(u.subnetIDs) > 0 && !slices.Contains(u.subnetIDs, subnetID) {
+			continue
+		}
+
+		key := strings.Join(append([]string{service, subnetID}, securityGroupIDs...), ",")
+		combination, ok := u.combinations[key]
+		if !ok {
+			combination = &hyperplaneENICombination{
+				securityGroupIDs: securityGroupIDs,
+				service:          service,
+				subnetID:         subnetID,
+			}
+			u.combinations[key] = combination
+		}
+
+		if !slices.Contains(combination.consumers, consumer) {
+			combination.consumers = append(combination.consumers, consumer)
+		}
+	}
+}
+
+// count returns the number of Hyperplane ENIs used by the specified service.
+func (u *hyperplaneENIUsage) count(service string) int {
+	var n int
+
+	for _, v := range u.combinations {
+		if v.service == service {
+			n++
+		}
+	}
+
+	return n
+}
+
+// flatten returns the combinations ordered by service, subnet and security groups.
+func (u *hyperplaneENIUsage) flatten() []interface{} {
+	keys := make([]string, 0, len(u.combinations))
+	for k := range u.combinations {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	tfList := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		v := u.combinations[k]
+		tfList = append(tfList, map[string]interface{}{
+			"consumers":          v.consumers,
+			"security_group_ids": v.securityGroupIDs,
+			"service":            v.service,
+			"subnet_id":          v.subnetID,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaHyperplaneENIUsageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_hyperplane_eni_usage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHyperplaneENIUsageDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "apprunner_eni_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "combinations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "combinations.0.consumers.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "combinations.0.security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "combinations.0.security_group_ids.*", "aws_security_group.sg_for_lambda", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "combinations.0.service", "lambda"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "combinations.0.subnet_id", "aws_subnet.subnet_for_lambda", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "lambda_eni_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "total_eni_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHyperplaneENIUsageDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  count = 2
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s-${count.index}"
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+
+  vpc_config {
+    subnet_ids         = [aws_subnet.subnet_for_lambda.id]
+    security_group_ids = [aws_security_group.sg_for_lambda.id]
+  }
+}
+
+data "aws_lambda_hyperplane_eni_usage" "test" {
+  subnet_ids = [aws_subnet.subnet_for_lambda.id]
+
+  depends_on = [aws_lambda_function.test]
+}
+`, rName))
+}
@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCodeSigningConfig,
 			TypeName: "aws_lambda_code_signing_config",
 		},
+		{
+			Factory:  dataSourceCodeSigningReport,
+			TypeName: "aws_lambda_code_signing_report",
+			Name:     "Code Signing Report",
+		},
 		{
 			Factory:  DataSourceFunction,
 			TypeName: "aws_lambda_function",
@@ -47,6 +52,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceFunctions,
 			TypeName: "aws_lambda_functions",
 		},
+		{
+			Factory:  dataSourceHyperplaneENIUsage,
+			TypeName: "aws_lambda_hyperplane_eni_usage",
+			Name:     "Hyperplane ENI Usage",
+		},
 		{
 			Factory:  DataSourceInvocation,
 			TypeName: "aws_lambda_invocation",
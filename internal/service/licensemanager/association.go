@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -32,6 +33,12 @@ func ResourceAssociation() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"ami_association_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(amiAssociationScope_Values(), false),
+			},
 			"license_configuration_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -56,11 +63,17 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 	licenseConfigurationARN := d.Get("license_configuration_arn").(string)
 	resourceARN := d.Get("resource_arn").(string)
 
+	licenseSpecification := &licensemanager.LicenseSpecification{
+		LicenseConfigurationArn: aws.String(licenseConfigurationARN),
+	}
+
+	if v, ok := d.GetOk("ami_association_scope"); ok {
+		licenseSpecification.AmiAssociationScope = aws.String(v.(string))
+	}
+
 	input := &licensemanager.UpdateLicenseSpecificationsForResourceInput{
-		AddLicenseSpecifications: []*licensemanager.LicenseSpecification{{
-			LicenseConfigurationArn: aws.String(licenseConfigurationARN),
-		}},
-		ResourceArn: aws.String(resourceARN),
+		AddLicenseSpecifications: []*licensemanager.LicenseSpecification{licenseSpecification},
+		ResourceArn:              aws.String(resourceARN),
 	}
 
 	log.Printf("[DEBUG] Creating License Manager Association: %s", input)
@@ -86,7 +99,7 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	err = FindAssociation(ctx, conn, resourceARN, licenseConfigurationARN)
+	output, err := FindAssociation(ctx, conn, resourceARN, licenseConfigurationARN)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] License Manager Association %s not found, removing from state", d.Id())
@@ -98,6 +111,7 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "reading License Manager Association (%s): %s", d.Id(), err)
 	}
 
+	d.Set("ami_association_scope", output.AmiAssociationScope)
 	d.Set("license_configuration_arn", licenseConfigurationARN)
 	d.Set("resource_arn", resourceARN)
 
@@ -131,7 +145,7 @@ func resourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
-func FindAssociation(ctx context.Context, conn *licensemanager.LicenseManager, resourceARN, licenseConfigurationARN string) error {
+func FindAssociation(ctx context.Context, conn *licensemanager.LicenseManager, resourceARN, licenseConfigurationARN string) (*licensemanager.LicenseSpecification, error) {
 	input := &licensemanager.ListLicenseSpecificationsForResourceInput{
 		ResourceArn: aws.String(resourceARN),
 	}
@@ -152,16 +166,27 @@ func FindAssociation(ctx context.Context, conn *licensemanager.LicenseManager, r
 	})
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, v := range output {
 		if aws.StringValue(v.LicenseConfigurationArn) == licenseConfigurationARN {
-			return nil
+			return v, nil
 		}
 	}
 
-	return &retry.NotFoundError{}
+	return nil, &retry.NotFoundError{}
+}
+
+const (
+	// amiAssociationScopeCrossAccount applies the association to AMIs shared with other accounts.
+	amiAssociationScopeCrossAccount = "cross-account"
+)
+
+func amiAssociationScope_Values() []string {
+	return []string{
+		amiAssociationScopeCrossAccount,
+	}
 }
 
 const associationResourceIDSeparator = ","
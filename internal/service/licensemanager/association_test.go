@@ -69,6 +69,35 @@ func TestAccLicenseManagerAssociation_disappears(t *testing.T) {
 	})
 }
 
+func TestAccLicenseManagerAssociation_amiAssociationScope(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_licensemanager_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_amiAssociationScope(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ami_association_scope", "cross-account"),
+					resource.TestCheckResourceAttrPair(resourceName, "license_configuration_arn", "aws_licensemanager_license_configuration.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_ami_copy.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -88,7 +117,9 @@ func testAccCheckAssociationExists(ctx context.Context, n string) resource.TestC
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn(ctx)
 
-		return tflicensemanager.FindAssociation(ctx, conn, resourceARN, licenseConfigurationARN)
+		_, err = tflicensemanager.FindAssociation(ctx, conn, resourceARN, licenseConfigurationARN)
+
+		return err
 	}
 }
 
@@ -107,7 +138,7 @@ func testAccCheckAssociationDestroy(ctx context.Context) resource.TestCheckFunc
 				return err
 			}
 
-			err = tflicensemanager.FindAssociation(ctx, conn, resourceARN, licenseConfigurationARN)
+			_, err = tflicensemanager.FindAssociation(ctx, conn, resourceARN, licenseConfigurationARN)
 
 			if tfresource.NotFound(err) {
 				continue
@@ -146,3 +177,30 @@ resource "aws_licensemanager_association" "test" {
 }
 `, rName))
 }
+
+func testAccAssociationConfig_amiAssociationScope(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_ami_copy" "test" {
+  name              = %[1]q
+  source_ami_id     = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  source_ami_region = data.aws_region.current.name
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_licensemanager_license_configuration" "test" {
+  name                  = %[1]q
+  license_counting_type = "vCPU"
+}
+
+resource "aws_licensemanager_association" "test" {
+  ami_association_scope     = "cross-account"
+  license_configuration_arn = aws_licensemanager_license_configuration.test.id
+  resource_arn              = aws_ami_copy.test.arn
+}
+`, rName))
+}
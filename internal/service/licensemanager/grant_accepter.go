@@ -5,13 +5,17 @@ package licensemanager
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -28,13 +32,31 @@ func ResourceGrantAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGrantAccepterCreate,
 		ReadWithoutTimeout:   resourceGrantAccepterRead,
+		UpdateWithoutTimeout: resourceGrantAccepterUpdate,
 		DeleteWithoutTimeout: resourceGrantAccepterDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"activation_override_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(licensemanager.ActivationOverrideBehavior_Values(), false),
+				Description:  "Activation option for a grant of an AWS Marketplace license. Only used when the grant is activated.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the accepted grant is activated.",
+			},
 			"allowed_operations": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -106,6 +128,12 @@ func resourceGrantAccepterCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(aws.StringValue(out.GrantArn))
 
+	if v := d.GetRawConfig().GetAttr("active"); v.IsKnown() && !v.IsNull() {
+		if err := updateGrantAccepterStatus(ctx, conn, d.Id(), v.True(), d.Get("activation_override_behavior").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionCreating, ResGrantAccepter, d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceGrantAccepterRead(ctx, d, meta)...)
 }
 
@@ -126,6 +154,10 @@ func resourceGrantAccepterRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionReading, ResGrantAccepter, d.Id(), err)
 	}
 
+	if out.Options != nil {
+		d.Set("activation_override_behavior", out.Options.ActivationOverrideBehavior)
+	}
+	d.Set("active", aws.StringValue(out.GrantStatus) == licensemanager.GrantStatusActive)
 	d.Set("allowed_operations", out.GrantedOperations)
 	d.Set("grant_arn", out.GrantArn)
 	d.Set("home_region", out.HomeRegion)
@@ -139,6 +171,20 @@ func resourceGrantAccepterRead(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceGrantAccepterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	if d.HasChanges("active", "activation_override_behavior") {
+		if err := updateGrantAccepterStatus(ctx, conn, d.Id(), d.Get("active").(bool), d.Get("activation_override_behavior").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionUpdating, ResGrantAccepter, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceGrantAccepterRead(ctx, d, meta)...)
+}
+
 func resourceGrantAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -157,6 +203,58 @@ func resourceGrantAccepterDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+// updateGrantAccepterStatus activates or deactivates an accepted grant by creating a new grant version.
+func updateGrantAccepterStatus(ctx context.Context, conn *licensemanager.LicenseManager, arn string, active bool, activationOverrideBehavior string, timeout time.Duration) error {
+	grant, err := FindGrantAccepterByGrantARN(ctx, conn, arn)
+
+	if err != nil {
+		return err
+	}
+
+	status := licensemanager.GrantStatusDisabled
+	if active {
+		status = licensemanager.GrantStatusActive
+	}
+
+	in := &licensemanager.CreateGrantVersionInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		GrantArn:      aws.String(arn),
+		SourceVersion: grant.Version,
+		Status:        aws.String(status),
+	}
+
+	if active && activationOverrideBehavior != "" {
+		in.Options = &licensemanager.Options{
+			ActivationOverrideBehavior: aws.String(activationOverrideBehavior),
+		}
+	}
+
+	if _, err := conn.CreateGrantVersionWithContext(ctx, in); err != nil {
+		return err
+	}
+
+	_, err = tfresource.RetryUntilEqual(ctx, timeout, status, func() (string, error) {
+		grant, err := FindGrantAccepterByGrantARN(ctx, conn, arn)
+
+		// The grant is transiently in another status while the new version is processed.
+		if tfresource.NotFound(err) {
+			return "", nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		return aws.StringValue(grant.GrantStatus), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for status (%s): %w", status, err)
+	}
+
+	return nil
+}
+
 func FindGrantAccepterByGrantARN(ctx context.Context, conn *licensemanager.LicenseManager, arn string) (*licensemanager.Grant, error) {
 	in := &licensemanager.ListReceivedGrantsInput{
 		GrantArns: aws.StringSlice([]string{arn}),
@@ -171,6 +269,10 @@ func FindGrantAccepterByGrantARN(ctx context.Context, conn *licensemanager.Licen
 		}
 	}
 
+	if err != nil {
+		return nil, err
+	}
+
 	var entry *licensemanager.Grant
 	entryExists := false
 
@@ -95,6 +95,50 @@ func testAccGrantAccepter_disappears(t *testing.T) {
 	})
 }
 
+func testAccGrantAccepter_active(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	licenseARN := envvar.SkipIfEmpty(t, licenseARNKey, envVarLicenseARNKeyError)
+	principal := envvar.SkipIfEmpty(t, principalKey, envVarPrincipalKeyError)
+	homeRegion := envvar.SkipIfEmpty(t, homeRegionKey, envVarHomeRegionError)
+	resourceName := "aws_licensemanager_grant_accepter.test"
+
+	providers := make(map[string]*schema.Provider)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             acctest.CheckWithNamedProviders(testAccCheckGrantAccepterDestroyWithProvider(ctx), providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantAccepterConfig_active(licenseARN, rName, principal, homeRegion, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantAccepterExists(ctx, resourceName, acctest.NamedProviderFunc(acctest.ProviderName, providers)),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config:            testAccGrantAccepterConfig_active(licenseARN, rName, principal, homeRegion, true),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGrantAccepterConfig_active(licenseARN, rName, principal, homeRegion, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantAccepterExists(ctx, resourceName, acctest.NamedProviderFunc(acctest.ProviderName, providers)),
+					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGrantAccepterExists(ctx context.Context, n string, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -148,7 +192,7 @@ func testAccCheckGrantAccepterDestroyWithProvider(ctx context.Context) acctest.T
 	}
 }
 
-func testAccGrantAccepterConfig_basic(licenseARN, rName, principal, homeRegion string) string {
+func testAccGrantAccepterConfig_base(licenseARN, rName, principal, homeRegion string) string {
 	principalArn, _ := arn.Parse(principal)
 	roleARN := arn.ARN{
 		Partition: principalArn.Partition,
@@ -165,10 +209,6 @@ provider %[1]q {
 	}
 }`, acctest.ProviderName, roleARN),
 		fmt.Sprintf(`
-resource "aws_licensemanager_grant_accepter" "test" {
-  grant_arn = aws_licensemanager_grant.test.arn
-}
-
 data "aws_licensemanager_received_license" "test" {
   provider    = awsalternate
   license_arn = %[1]q
@@ -189,3 +229,20 @@ resource "aws_licensemanager_grant" "test" {
 `, licenseARN, rName, principal),
 	)
 }
+
+func testAccGrantAccepterConfig_basic(licenseARN, rName, principal, homeRegion string) string {
+	return acctest.ConfigCompose(testAccGrantAccepterConfig_base(licenseARN, rName, principal, homeRegion), `
+resource "aws_licensemanager_grant_accepter" "test" {
+  grant_arn = aws_licensemanager_grant.test.arn
+}
+`)
+}
+
+func testAccGrantAccepterConfig_active(licenseARN, rName, principal, homeRegion string, active bool) string {
+	return acctest.ConfigCompose(testAccGrantAccepterConfig_base(licenseARN, rName, principal, homeRegion), fmt.Sprintf(`
+resource "aws_licensemanager_grant_accepter" "test" {
+  grant_arn = aws_licensemanager_grant.test.arn
+  active    = %[1]t
+}
+`, active))
+}
@@ -42,6 +42,7 @@ func TestAccLicenseManagerGrant_serial(t *testing.T) {
 			"name":       testAccGrant_name,
 		},
 		"grant_accepter": {
+			"active":     testAccGrantAccepter_active,
 			"basic":      testAccGrantAccepter_basic,
 			"disappears": testAccGrantAccepter_disappears,
 		},
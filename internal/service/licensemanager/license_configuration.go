@@ -46,6 +46,11 @@ func ResourceLicenseConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"disassociate_when_not_found": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"license_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -102,6 +107,10 @@ func resourceLicenseConfigurationCreate(ctx context.Context, d *schema.ResourceD
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("disassociate_when_not_found"); ok {
+		input.DisassociateWhenNotFound = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("license_count"); ok {
 		input.LicenseCount = aws.Int64(int64(v.(int)))
 	}
@@ -144,6 +153,7 @@ func resourceLicenseConfigurationRead(ctx context.Context, d *schema.ResourceDat
 
 	d.Set("arn", output.LicenseConfigurationArn)
 	d.Set("description", output.Description)
+	d.Set("disassociate_when_not_found", output.DisassociateWhenNotFound)
 	d.Set("license_count", output.LicenseCount)
 	d.Set("license_count_hard_limit", output.LicenseCountHardLimit)
 	d.Set("license_counting_type", output.LicenseCountingType)
@@ -163,10 +173,11 @@ func resourceLicenseConfigurationUpdate(ctx context.Context, d *schema.ResourceD
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &licensemanager.UpdateLicenseConfigurationInput{
-			Description:             aws.String(d.Get("description").(string)),
-			LicenseConfigurationArn: aws.String(d.Id()),
-			LicenseCountHardLimit:   aws.Bool(d.Get("license_count_hard_limit").(bool)),
-			Name:                    aws.String(d.Get("name").(string)),
+			Description:              aws.String(d.Get("description").(string)),
+			DisassociateWhenNotFound: aws.Bool(d.Get("disassociate_when_not_found").(bool)),
+			LicenseConfigurationArn:  aws.String(d.Id()),
+			LicenseCountHardLimit:    aws.Bool(d.Get("license_count_hard_limit").(bool)),
+			Name:                     aws.String(d.Get("name").(string)),
 		}
 
 		if v, ok := d.GetOk("license_count"); ok {
@@ -48,6 +48,7 @@ func TestAccLicenseManagerLicenseConfiguration_basic(t *testing.T) {
 					testAccCheckLicenseConfigurationExists(ctx, resourceName, &licenseConfiguration),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "license-manager", regexache.MustCompile(`license-configuration:lic-.+`)),
 					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "disassociate_when_not_found", "false"),
 					resource.TestCheckResourceAttr(resourceName, "license_count", "0"),
 					resource.TestCheckResourceAttr(resourceName, "license_count_hard_limit", "false"),
 					resource.TestCheckResourceAttr(resourceName, "license_counting_type", "Instance"),
@@ -155,6 +156,7 @@ func TestAccLicenseManagerLicenseConfiguration_update(t *testing.T) {
 					testAccCheckLicenseConfigurationExists(ctx, resourceName, &licenseConfiguration),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "license-manager", regexache.MustCompile(`license-configuration:lic-.+`)),
 					resource.TestCheckResourceAttr(resourceName, "description", "test1"),
+					resource.TestCheckResourceAttr(resourceName, "disassociate_when_not_found", "true"),
 					resource.TestCheckResourceAttr(resourceName, "license_count", "10"),
 					resource.TestCheckResourceAttr(resourceName, "license_count_hard_limit", "true"),
 					resource.TestCheckResourceAttr(resourceName, "license_counting_type", "Socket"),
@@ -176,6 +178,7 @@ func TestAccLicenseManagerLicenseConfiguration_update(t *testing.T) {
 					testAccCheckLicenseConfigurationExists(ctx, resourceName, &licenseConfiguration),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "license-manager", regexache.MustCompile(`license-configuration:lic-.+`)),
 					resource.TestCheckResourceAttr(resourceName, "description", "test2"),
+					resource.TestCheckResourceAttr(resourceName, "disassociate_when_not_found", "false"),
 					resource.TestCheckResourceAttr(resourceName, "license_count", "99"),
 					resource.TestCheckResourceAttr(resourceName, "license_count_hard_limit", "false"),
 					resource.TestCheckResourceAttr(resourceName, "license_counting_type", "Socket"),
@@ -280,11 +283,12 @@ resource "aws_licensemanager_license_configuration" "test" {
 func testAccLicenseConfigurationConfig_allAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_licensemanager_license_configuration" "test" {
-  name                     = %[1]q
-  description              = "test1"
-  license_count            = 10
-  license_count_hard_limit = true
-  license_counting_type    = "Socket"
+  name                        = %[1]q
+  description                 = "test1"
+  disassociate_when_not_found = true
+  license_count               = 10
+  license_count_hard_limit    = true
+  license_counting_type       = "Socket"
 
   license_rules = [
     "#minimumSockets=3"
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_lightsail_container_service_deployment_versions", name="Container Service Deployment Versions")
+func DataSourceContainerServiceDeploymentVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContainerServiceDeploymentVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"deployments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"container_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"environment": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"image": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ports": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_endpoint": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"container_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"health_check": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"healthy_threshold": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"interval_seconds": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"path": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"success_codes": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"timeout_seconds": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"unhealthy_threshold": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceContainerServiceDeploymentVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	serviceName := d.Get("service_name").(string)
+	deployments, err := findContainerServiceDeployments(ctx, conn, serviceName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lightsail Container Service (%s) deployments: %s", serviceName, err)
+	}
+
+	d.SetId(serviceName)
+	if err := d.Set("deployments", flattenContainerServiceDeployments(deployments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting deployments: %s", err)
+	}
+
+	return diags
+}
+
+func findContainerServiceDeployments(ctx context.Context, conn *lightsail.Client, serviceName string) ([]types.ContainerServiceDeployment, error) {
+	input := &lightsail.GetContainerServiceDeploymentsInput{
+		ServiceName: aws.String(serviceName),
+	}
+
+	output, err := conn.GetContainerServiceDeployments(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Deployments, nil
+}
+
+func flattenContainerServiceDeployments(apiObjects []types.ContainerServiceDeployment) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"container":       flattenContainerServiceDeploymentContainers(apiObject.Containers),
+			"created_at":      aws.ToTime(apiObject.CreatedAt).Format(time.RFC3339),
+			"public_endpoint": flattenContainerServiceDeploymentPublicEndpoint(apiObject.PublicEndpoint),
+			"state":           string(apiObject.State),
+			"version":         int(aws.ToInt32(apiObject.Version)),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLightsailContainerServiceDeploymentVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	containerName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lightsail_container_service_deployment_versions.test"
+	resourceName := "aws_lightsail_container_service_deployment_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceDeploymentVersionsDataSourceConfig_basic(rName, containerName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_name", "aws_lightsail_container_service.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployments.0.version", resourceName, "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployments.0.created_at", resourceName, "created_at"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.state", string(types.ContainerServiceDeploymentStateActive)),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.container.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.container.0.container_name", containerName),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.container.0.image", helloWorldImage),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerServiceDeploymentVersionsDataSourceConfig_basic(rName, containerName string) string {
+	return acctest.ConfigCompose(
+		testAccContainerServiceDeploymentVersionConfig_Container_basic(rName, containerName, helloWorldImage),
+		`
+data "aws_lightsail_container_service_deployment_versions" "test" {
+  service_name = aws_lightsail_container_service_deployment_version.test.service_name
+}
+`)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -64,6 +65,44 @@ func ResourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"metadata_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_endpoint": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.HttpEndpoint](),
+						},
+						"http_protocol_ipv6": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.HttpProtocolIpv6](),
+						},
+						"http_put_response_hop_limit": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 64),
+						},
+						"http_tokens": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.HttpTokens](),
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -231,6 +270,13 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// Cannot set metadata options with creation request
+	if v, ok := d.GetOk("metadata_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if diags := updateInstanceMetadataOptions(ctx, conn, iName, v.([]interface{})[0].(map[string]interface{})); diags.HasError() {
+			return diags
+		}
+	}
+
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
@@ -256,6 +302,9 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("blueprint_id", out.BlueprintId)
 	d.Set("bundle_id", out.BundleId)
 	d.Set("key_pair_name", out.SshKeyName)
+	if err := d.Set("metadata_options", flattenInstanceMetadataOptions(out.MetadataOptions)); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionSetting, ResInstance, d.Id(), err)
+	}
 	d.Set("name", out.Name)
 
 	// additional attributes
@@ -333,9 +382,73 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("metadata_options") {
+		if v, ok := d.GetOk("metadata_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if diags := updateInstanceMetadataOptions(ctx, conn, d.Id(), v.([]interface{})[0].(map[string]interface{})); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
+func updateInstanceMetadataOptions(ctx context.Context, conn *lightsail.Client, name string, tfMap map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	in := lightsail.UpdateInstanceMetadataOptionsInput{
+		InstanceName: aws.String(name),
+	}
+
+	if v, ok := tfMap["http_endpoint"].(string); ok && v != "" {
+		in.HttpEndpoint = types.HttpEndpoint(v)
+	}
+
+	if v, ok := tfMap["http_protocol_ipv6"].(string); ok && v != "" {
+		in.HttpProtocolIpv6 = types.HttpProtocolIpv6(v)
+	}
+
+	if v, ok := tfMap["http_put_response_hop_limit"].(int); ok && v != 0 {
+		in.HttpPutResponseHopLimit = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["http_tokens"].(string); ok && v != "" {
+		in.HttpTokens = types.HttpTokens(v)
+	}
+
+	out, err := conn.UpdateInstanceMetadataOptions(ctx, &in)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, string(types.OperationTypeUpdateInstanceMetadataOptions), ResInstance, name, err)
+	}
+
+	if diags := expandOperation(ctx, conn, *out.Operation, types.OperationTypeUpdateInstanceMetadataOptions, ResInstance, name); diags != nil {
+		return diags
+	}
+
+	if err := waitInstanceMetadataOptionsApplied(ctx, conn, name); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, string(types.OperationTypeUpdateInstanceMetadataOptions), ResInstance, name, err)
+	}
+
+	return diags
+}
+
+func flattenInstanceMetadataOptions(apiObject *types.InstanceMetadataOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"http_endpoint":               string(apiObject.HttpEndpoint),
+			"http_protocol_ipv6":          string(apiObject.HttpProtocolIpv6),
+			"http_put_response_hop_limit": int(aws.ToInt32(apiObject.HttpPutResponseHopLimit)),
+			"http_tokens":                 string(apiObject.HttpTokens),
+			"state":                       string(apiObject.State),
+		},
+	}
+}
+
 func expandAddOnRequest(addOnListRaw []interface{}) *types.AddOnRequest {
 	if len(addOnListRaw) == 0 {
 		return &types.AddOnRequest{}
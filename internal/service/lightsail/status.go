@@ -168,3 +168,23 @@ func statusInstance(ctx context.Context, conn *lightsail.Client, iName *string)
 		return out, *out.State.Name, nil
 	}
 }
+
+func statusInstanceMetadataOptions(ctx context.Context, conn *lightsail.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := FindInstanceById(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if instance.MetadataOptions == nil {
+			return nil, "", nil
+		}
+
+		return instance.MetadataOptions, string(instance.MetadataOptions.State), nil
+	}
+}
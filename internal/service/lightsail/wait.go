@@ -252,3 +252,18 @@ func waitInstanceState(ctx context.Context, conn *lightsail.Client, id *string)
 
 	return nil, err
 }
+
+func waitInstanceMetadataOptionsApplied(ctx context.Context, conn *lightsail.Client, name string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.InstanceMetadataStatePending),
+		Target:     enum.Slice(types.InstanceMetadataStateApplied),
+		Refresh:    statusInstanceMetadataOptions(ctx, conn, name),
+		Timeout:    OperationTimeout,
+		Delay:      OperationDelay,
+		MinTimeout: OperationMinTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_location_key", name="Key")
+// @Tags(identifierAttribute="key_arn")
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyCreate,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyUpdate,
+		DeleteWithoutTimeout: resourceKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"expire_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				ExactlyOneOf: []string{"expire_time", "no_expiry"},
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"no_expiry": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ExactlyOneOf: []string{"expire_time", "no_expiry"},
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(5, 200),
+							},
+						},
+						"allow_referers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 253),
+							},
+						},
+						"allow_resources": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameKey = "Key"
+)
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	name := d.Get("key_name").(string)
+	in := &locationservice.CreateKeyInput{
+		KeyName:      aws.String(name),
+		Restrictions: expandAPIKeyRestrictions(d.Get("restrictions").([]interface{})[0].(map[string]interface{})),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expire_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		in.ExpireTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("no_expiry"); ok {
+		in.NoExpiry = aws.Bool(v.(bool))
+	}
+
+	out, err := conn.CreateKeyWithContext(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionCreating, ResNameKey, name, err)
+	}
+
+	if out == nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionCreating, ResNameKey, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.KeyName))
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	out, err := findKeyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionReading, ResNameKey, d.Id(), err)
+	}
+
+	d.Set("create_time", aws.TimeValue(out.CreateTime).Format(time.RFC3339))
+	d.Set("description", out.Description)
+	d.Set("expire_time", aws.TimeValue(out.ExpireTime).Format(time.RFC3339))
+	d.Set("key", out.Key)
+	d.Set("key_arn", out.KeyArn)
+	d.Set("key_name", out.KeyName)
+	if out.Restrictions != nil {
+		if err := d.Set("restrictions", []interface{}{flattenAPIKeyRestrictions(out.Restrictions)}); err != nil {
+			return create.AppendDiagError(diags, names.Location, create.ErrActionSetting, ResNameKey, d.Id(), err)
+		}
+	} else {
+		d.Set("restrictions", nil)
+	}
+	d.Set("update_time", aws.TimeValue(out.UpdateTime).Format(time.RFC3339))
+
+	setTagsOut(ctx, out.Tags)
+
+	return diags
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &locationservice.UpdateKeyInput{
+			// Required to update keys that have been used in the last 7 days.
+			ForceUpdate: aws.Bool(true),
+			KeyName:     aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			in.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChanges("expire_time", "no_expiry") {
+			if v, ok := d.GetOk("no_expiry"); ok && v.(bool) {
+				in.NoExpiry = aws.Bool(true)
+			} else if v, ok := d.GetOk("expire_time"); ok {
+				v, _ := time.Parse(time.RFC3339, v.(string))
+				in.ExpireTime = aws.Time(v)
+			}
+		}
+
+		if d.HasChange("restrictions") {
+			in.Restrictions = expandAPIKeyRestrictions(d.Get("restrictions").([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Location Key (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateKeyWithContext(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameKey, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	log.Printf("[INFO] Deleting Location Key %s", d.Id())
+
+	_, err := conn.DeleteKeyWithContext(ctx, &locationservice.DeleteKeyInput{
+		// Active keys, and keys deactivated less than 90 days ago, can only be force deleted.
+		ForceDelete: aws.Bool(true),
+		KeyName:     aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionDeleting, ResNameKey, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findKeyByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeKeyOutput, error) {
+	in := &locationservice.DescribeKeyInput{
+		KeyName: aws.String(name),
+	}
+
+	out, err := conn.DescribeKeyWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandAPIKeyRestrictions(tfMap map[string]interface{}) *locationservice.ApiKeyRestrictions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &locationservice.ApiKeyRestrictions{}
+
+	if v, ok := tfMap["allow_actions"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllowActions = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["allow_referers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllowReferers = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["allow_resources"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllowResources = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenAPIKeyRestrictions(apiObject *locationservice.ApiKeyRestrictions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_actions":   aws.StringValueSlice(apiObject.AllowActions),
+		"allow_referers":  aws.StringValueSlice(apiObject.AllowReferers),
+		"allow_resources": aws.StringValueSlice(apiObject.AllowResources),
+	}
+
+	return tfMap
+}
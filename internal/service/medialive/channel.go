@@ -688,6 +688,10 @@ func ResourceChannel() *schema.Resource {
 					Optional: true,
 					Default:  false,
 				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				"vpc": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -824,6 +828,7 @@ func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("channel_id", out.Id)
 	d.Set("log_level", out.LogLevel)
 	d.Set("role_arn", out.RoleArn)
+	d.Set("state", out.State)
 
 	if err := d.Set("cdi_input_specification", flattenChannelCdiInputSpecification(out.CdiInputSpecification)); err != nil {
 		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionSetting, ResNameChannel, d.Id(), err)
@@ -918,13 +923,8 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.Get("start_channel").(bool) {
-		if err := startChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
-			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Get("name").(string), err)
-		}
-	}
-
-	if d.HasChange("start_channel") {
+	// Any update other than tags leaves the channel IDLE, so reconcile its state with start_channel.
+	if d.HasChangesExcept("tags", "tags_all") {
 		channel, err := FindChannelByID(ctx, conn, d.Id())
 
 		if err != nil {
@@ -50,6 +50,7 @@ func TestAccMediaLiveChannel_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "channel_class", "STANDARD"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttrSet(resourceName, "role_arn"),
+					resource.TestCheckResourceAttr(resourceName, "state", string(types.ChannelStateIdle)),
 					resource.TestCheckResourceAttr(resourceName, "input_specification.0.codec", "AVC"),
 					resource.TestCheckResourceAttr(resourceName, "input_specification.0.input_resolution", "HD"),
 					resource.TestCheckResourceAttr(resourceName, "input_specification.0.maximum_bitrate", "MAX_20_MBPS"),
@@ -715,6 +716,7 @@ func TestAccMediaLiveChannel_status(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckChannelExists(ctx, resourceName, &channel),
 					testAccCheckChannelStatus(ctx, resourceName, types.ChannelStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "state", string(types.ChannelStateRunning)),
 				),
 			},
 			{
@@ -722,6 +724,7 @@ func TestAccMediaLiveChannel_status(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckChannelExists(ctx, resourceName, &channel),
 					testAccCheckChannelStatus(ctx, resourceName, types.ChannelStateIdle),
+					resource.TestCheckResourceAttr(resourceName, "state", string(types.ChannelStateIdle)),
 				),
 			},
 		},
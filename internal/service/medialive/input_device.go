@@ -0,0 +1,490 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_medialive_input_device", name="Input Device")
+// @Tags(identifierAttribute="arn")
+func ResourceInputDevice() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInputDeviceCreate,
+		ReadWithoutTimeout:   resourceInputDeviceRead,
+		UpdateWithoutTimeout: resourceInputDeviceUpdate,
+		DeleteWithoutTimeout: resourceInputDeviceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_settings_sync_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_update_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hd_device_settings": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"uhd_device_settings"},
+				Elem: &schema.Resource{
+					Schema: inputDeviceConfigurableSettingsSchema(),
+				},
+			},
+			"input_device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"medialive_input_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"output_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uhd_device_settings": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"hd_device_settings"},
+				Elem: &schema.Resource{
+					Schema: inputDeviceConfigurableSettingsSchema(),
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func inputDeviceConfigurableSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"audio_channel_pairs": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+					"profile": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: enum.Validate[types.InputDeviceConfigurableAudioChannelPairProfile](),
+					},
+				},
+			},
+		},
+		"codec": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: enum.Validate[types.InputDeviceCodec](),
+		},
+		"configured_input": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: enum.Validate[types.InputDeviceConfiguredInput](),
+		},
+		"latency_ms": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 8000),
+		},
+		"max_bitrate": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"mediaconnect_settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"flow_arn": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+					},
+					"role_arn": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+					},
+					"secret_arn": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+					},
+					"source_name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameInputDevice = "Input Device"
+)
+
+func resourceInputDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	// Input devices are physical appliances that are registered to the account outside of Terraform.
+	// "Creating" the resource adopts the device and applies the configured settings.
+	inputDeviceID := d.Get("input_device_id").(string)
+
+	out, err := FindInputDeviceByID(ctx, conn, inputDeviceID)
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameInputDevice, inputDeviceID, err)
+	}
+
+	d.SetId(inputDeviceID)
+
+	if in := expandUpdateInputDeviceInput(d); in != nil {
+		if _, err := conn.UpdateInputDevice(ctx, in); err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameInputDevice, d.Id(), err)
+		}
+	}
+
+	if err := updateTags(ctx, conn, aws.ToString(out.Arn), nil, getTagsIn(ctx)); err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameInputDevice, d.Id(), err)
+	}
+
+	return append(diags, resourceInputDeviceRead(ctx, d, meta)...)
+}
+
+func resourceInputDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	out, err := FindInputDeviceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Input Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionReading, ResNameInputDevice, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("availability_zone", out.AvailabilityZone)
+	d.Set("connection_state", out.ConnectionState)
+	d.Set("device_settings_sync_state", out.DeviceSettingsSyncState)
+	d.Set("device_update_status", out.DeviceUpdateStatus)
+	d.Set("input_device_id", out.Id)
+	d.Set("mac_address", out.MacAddress)
+	d.Set("medialive_input_arns", out.MedialiveInputArns)
+	d.Set("name", out.Name)
+	d.Set("output_type", out.OutputType)
+	d.Set("serial_number", out.SerialNumber)
+	d.Set("type", out.Type)
+
+	if err := d.Set("hd_device_settings", flattenInputDeviceHdSettings(out.HdDeviceSettings)); err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionSetting, ResNameInputDevice, d.Id(), err)
+	}
+	if err := d.Set("uhd_device_settings", flattenInputDeviceUhdSettings(out.UhdDeviceSettings)); err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionSetting, ResNameInputDevice, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceInputDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		if in := expandUpdateInputDeviceInput(d); in != nil {
+			if _, err := conn.UpdateInputDevice(ctx, in); err != nil {
+				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameInputDevice, d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceInputDeviceRead(ctx, d, meta)...)
+}
+
+func resourceInputDeviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Input devices cannot be deleted, only removed from Terraform management.
+	log.Printf("[WARN] MediaLive Input Device (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func FindInputDeviceByID(ctx context.Context, conn *medialive.Client, id string) (*medialive.DescribeInputDeviceOutput, error) {
+	in := &medialive.DescribeInputDeviceInput{
+		InputDeviceId: aws.String(id),
+	}
+
+	out, err := conn.DescribeInputDevice(ctx, in)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandUpdateInputDeviceInput(d *schema.ResourceData) *medialive.UpdateInputDeviceInput {
+	in := &medialive.UpdateInputDeviceInput{
+		InputDeviceId: aws.String(d.Id()),
+	}
+	var update bool
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		in.AvailabilityZone = aws.String(v.(string))
+		update = true
+	}
+
+	if v, ok := d.GetOk("hd_device_settings"); ok && len(v.([]interface{})) > 0 {
+		in.HdDeviceSettings = expandInputDeviceConfigurableSettings(v.([]interface{}))
+		update = true
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+		update = true
+	}
+
+	if v, ok := d.GetOk("uhd_device_settings"); ok && len(v.([]interface{})) > 0 {
+		in.UhdDeviceSettings = expandInputDeviceConfigurableSettings(v.([]interface{}))
+		update = true
+	}
+
+	if !update {
+		return nil
+	}
+
+	return in
+}
+
+func expandInputDeviceConfigurableSettings(tfList []interface{}) *types.InputDeviceConfigurableSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	var out types.InputDeviceConfigurableSettings
+	if v, ok := m["audio_channel_pairs"].([]interface{}); ok && len(v) > 0 {
+		out.AudioChannelPairs = expandInputDeviceConfigurableAudioChannelPairs(v)
+	}
+	if v, ok := m["codec"].(string); ok && v != "" {
+		out.Codec = types.InputDeviceCodec(v)
+	}
+	if v, ok := m["configured_input"].(string); ok && v != "" {
+		out.ConfiguredInput = types.InputDeviceConfiguredInput(v)
+	}
+	if v, ok := m["latency_ms"].(int); ok && v != 0 {
+		out.LatencyMs = aws.Int32(int32(v))
+	}
+	if v, ok := m["max_bitrate"].(int); ok && v != 0 {
+		out.MaxBitrate = aws.Int32(int32(v))
+	}
+	if v, ok := m["mediaconnect_settings"].([]interface{}); ok && len(v) > 0 {
+		out.MediaconnectSettings = expandInputDeviceMediaConnectConfigurableSettings(v)
+	}
+
+	return &out
+}
+
+func expandInputDeviceConfigurableAudioChannelPairs(tfList []interface{}) []types.InputDeviceConfigurableAudioChannelPairConfig {
+	var out []types.InputDeviceConfigurableAudioChannelPairConfig
+	for _, v := range tfList {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var o types.InputDeviceConfigurableAudioChannelPairConfig
+		if v, ok := m["id"].(int); ok {
+			o.Id = aws.Int32(int32(v))
+		}
+		if v, ok := m["profile"].(string); ok && v != "" {
+			o.Profile = types.InputDeviceConfigurableAudioChannelPairProfile(v)
+		}
+
+		out = append(out, o)
+	}
+
+	return out
+}
+
+func expandInputDeviceMediaConnectConfigurableSettings(tfList []interface{}) *types.InputDeviceMediaConnectConfigurableSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	var out types.InputDeviceMediaConnectConfigurableSettings
+	if v, ok := m["flow_arn"].(string); ok && v != "" {
+		out.FlowArn = aws.String(v)
+	}
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		out.RoleArn = aws.String(v)
+	}
+	if v, ok := m["secret_arn"].(string); ok && v != "" {
+		out.SecretArn = aws.String(v)
+	}
+	if v, ok := m["source_name"].(string); ok && v != "" {
+		out.SourceName = aws.String(v)
+	}
+
+	return &out
+}
+
+func flattenInputDeviceHdSettings(in *types.InputDeviceHdSettings) []interface{} {
+	if in == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"configured_input": string(in.ConfiguredInput),
+		"latency_ms":       int(aws.ToInt32(in.LatencyMs)),
+		"max_bitrate":      int(aws.ToInt32(in.MaxBitrate)),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenInputDeviceUhdSettings(in *types.InputDeviceUhdSettings) []interface{} {
+	if in == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"audio_channel_pairs":   flattenInputDeviceUhdAudioChannelPairs(in.AudioChannelPairs),
+		"codec":                 string(in.Codec),
+		"configured_input":      string(in.ConfiguredInput),
+		"latency_ms":            int(aws.ToInt32(in.LatencyMs)),
+		"max_bitrate":           int(aws.ToInt32(in.MaxBitrate)),
+		"mediaconnect_settings": flattenInputDeviceMediaConnectSettings(in.MediaconnectSettings),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenInputDeviceUhdAudioChannelPairs(tfList []types.InputDeviceUhdAudioChannelPairConfig) []interface{} {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var out []interface{}
+	for _, item := range tfList {
+		m := map[string]interface{}{
+			"id":      int(aws.ToInt32(item.Id)),
+			"profile": string(item.Profile),
+		}
+
+		out = append(out, m)
+	}
+
+	return out
+}
+
+func flattenInputDeviceMediaConnectSettings(in *types.InputDeviceMediaConnectSettings) []interface{} {
+	if in == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"flow_arn":    aws.ToString(in.FlowArn),
+		"role_arn":    aws.ToString(in.RoleArn),
+		"secret_arn":  aws.ToString(in.SecretArn),
+		"source_name": aws.ToString(in.SourceName),
+	}
+
+	return []interface{}{m}
+}
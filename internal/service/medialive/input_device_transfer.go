@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_medialive_input_device_transfer", name="Input Device Transfer")
+func ResourceInputDeviceTransfer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInputDeviceTransferCreate,
+		ReadWithoutTimeout:   resourceInputDeviceTransferRead,
+		DeleteWithoutTimeout: resourceInputDeviceTransferDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"input_device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_customer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"target_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"transfer_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameInputDeviceTransfer = "Input Device Transfer"
+)
+
+func resourceInputDeviceTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	inputDeviceID := d.Get("input_device_id").(string)
+	in := &medialive.TransferInputDeviceInput{
+		InputDeviceId:    aws.String(inputDeviceID),
+		TargetCustomerId: aws.String(d.Get("target_customer_id").(string)),
+	}
+
+	if v, ok := d.GetOk("target_region"); ok {
+		in.TargetRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("transfer_message"); ok {
+		in.TransferMessage = aws.String(v.(string))
+	}
+
+	_, err := conn.TransferInputDevice(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameInputDeviceTransfer, inputDeviceID, err)
+	}
+
+	d.SetId(inputDeviceID)
+
+	return append(diags, resourceInputDeviceTransferRead(ctx, d, meta)...)
+}
+
+func resourceInputDeviceTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	out, err := FindOutgoingInputDeviceTransferByID(ctx, conn, d.Id())
+
+	// Once the transfer has been accepted, rejected or canceled it is no longer listed.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Input Device Transfer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionReading, ResNameInputDeviceTransfer, d.Id(), err)
+	}
+
+	d.Set("input_device_id", out.Id)
+	d.Set("target_customer_id", out.TargetCustomerId)
+	d.Set("transfer_message", out.Message)
+
+	return diags
+}
+
+func resourceInputDeviceTransferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	log.Printf("[INFO] Canceling MediaLive Input Device Transfer %s", d.Id())
+
+	_, err := conn.CancelInputDeviceTransfer(ctx, &medialive.CancelInputDeviceTransferInput{
+		InputDeviceId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionDeleting, ResNameInputDeviceTransfer, d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindOutgoingInputDeviceTransferByID(ctx context.Context, conn *medialive.Client, id string) (*types.TransferringInputDeviceSummary, error) {
+	in := &medialive.ListInputDeviceTransfersInput{
+		TransferType: aws.String(string(types.InputDeviceTransferTypeOutgoing)),
+	}
+
+	pages := medialive.NewListInputDeviceTransfersPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.InputDeviceTransfers {
+			if aws.ToString(v.Id) == id {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
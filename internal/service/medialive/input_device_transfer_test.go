@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveInputDeviceTransfer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	inputDeviceID := acctest.SkipIfEnvVarNotSet(t, "MEDIALIVE_INPUT_DEVICE_ID")
+	resourceName := "aws_medialive_input_device_transfer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckInputDeviceTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputDeviceTransferConfig_basic(inputDeviceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "input_device_id", inputDeviceID),
+					resource.TestCheckResourceAttrPair(resourceName, "target_customer_id", "data.aws_caller_identity.target", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_message", "terraform acceptance test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInputDeviceTransferDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_medialive_input_device_transfer" {
+				continue
+			}
+
+			_, err := tfmedialive.FindOutgoingInputDeviceTransferByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameInputDeviceTransfer, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameInputDeviceTransfer, rs.Primary.ID, fmt.Errorf("still pending"))
+		}
+
+		return nil
+	}
+}
+
+func testAccInputDeviceTransferConfig_basic(inputDeviceID string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "target" {
+  provider = "awsalternate"
+}
+
+resource "aws_medialive_input_device_transfer" "test" {
+  input_device_id    = %[1]q
+  target_customer_id = data.aws_caller_identity.target.account_id
+  transfer_message   = "terraform acceptance test"
+}
+`, inputDeviceID))
+}
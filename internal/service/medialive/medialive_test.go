@@ -25,6 +25,12 @@ func TestAccMediaLive_serial(t *testing.T) {
 			"update":     testAccMultiplexProgram_update,
 			"disappears": testAccMultiplexProgram_disappears,
 		},
+		"MultiplexProgramDataSource": {
+			"basic": testAccMultiplexProgramDataSource_basic,
+		},
+		"MultiplexProgramsDataSource": {
+			"basic": testAccMultiplexProgramsDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Multiplex Program")
+func newDataSourceMultiplexProgram(_ context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceMultiplexProgram{}, nil
+}
+
+const (
+	DSNameMultiplexProgram = "Multiplex Program Data Source"
+)
+
+type dataSourceMultiplexProgram struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceMultiplexProgram) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_medialive_multiplex_program"
+}
+
+func (d *dataSourceMultiplexProgram) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"multiplex_id": schema.StringAttribute{
+				Required: true,
+			},
+			"multiplex_program_settings": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dsMultiplexProgramSettings](ctx),
+				Computed:   true,
+			},
+			"program_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceMultiplexProgram) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().MediaLiveClient(ctx)
+
+	var data dataSourceMultiplexProgramData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	multiplexID, programName := data.MultiplexID.ValueString(), data.ProgramName.ValueString()
+	out, err := FindMultiplexProgramByID(ctx, conn, multiplexID, programName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaLive, create.ErrActionReading, DSNameMultiplexProgram, programName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", programName, multiplexID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceMultiplexProgramData struct {
+	ChannelID                types.String                                                `tfsdk:"channel_id"`
+	ID                       types.String                                                `tfsdk:"id"`
+	MultiplexID              types.String                                                `tfsdk:"multiplex_id"`
+	MultiplexProgramSettings fwtypes.ListNestedObjectValueOf[dsMultiplexProgramSettings] `tfsdk:"multiplex_program_settings"`
+	ProgramName              types.String                                                `tfsdk:"program_name"`
+}
+
+type dsMultiplexProgramSettings struct {
+	PreferredChannelPipeline fwtypes.StringEnum[awstypes.PreferredChannelPipeline]         `tfsdk:"preferred_channel_pipeline"`
+	ProgramNumber            types.Int64                                                   `tfsdk:"program_number"`
+	ServiceDescriptor        fwtypes.ListNestedObjectValueOf[dsMultiplexServiceDescriptor] `tfsdk:"service_descriptor"`
+	VideoSettings            fwtypes.ListNestedObjectValueOf[dsMultiplexVideoSettings]     `tfsdk:"video_settings"`
+}
+
+type dsMultiplexServiceDescriptor struct {
+	ProviderName types.String `tfsdk:"provider_name"`
+	ServiceName  types.String `tfsdk:"service_name"`
+}
+
+type dsMultiplexVideoSettings struct {
+	ConstantBitrate types.Int64                                                      `tfsdk:"constant_bitrate"`
+	StatmuxSettings fwtypes.ListNestedObjectValueOf[dsMultiplexStatmuxVideoSettings] `tfsdk:"statmux_settings"`
+}
+
+type dsMultiplexStatmuxVideoSettings struct {
+	MaximumBitrate types.Int64 `tfsdk:"maximum_bitrate"`
+	MinimumBitrate types.Int64 `tfsdk:"minimum_bitrate"`
+	Priority       types.Int64 `tfsdk:"priority"`
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMultiplexProgramDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := fmt.Sprintf("tf_acc_%s", sdkacctest.RandString(8))
+	resourceName := "aws_medialive_multiplex_program.test"
+	dataSourceName := "data.aws_medialive_multiplex_program.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMultiplexProgramDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiplexProgramDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "multiplex_id", dataSourceName, "multiplex_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "program_name", dataSourceName, "program_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "multiplex_program_settings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "multiplex_program_settings.0.program_number", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "multiplex_program_settings.0.preferred_channel_pipeline", "CURRENTLY_ACTIVE"),
+					resource.TestCheckResourceAttr(dataSourceName, "multiplex_program_settings.0.video_settings.0.constant_bitrate", "100000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMultiplexProgramDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccMultiplexProgramConfig_basic(rName),
+		`
+data "aws_medialive_multiplex_program" "test" {
+  multiplex_id = aws_medialive_multiplex_program.test.multiplex_id
+  program_name = aws_medialive_multiplex_program.test.program_name
+}
+`)
+}
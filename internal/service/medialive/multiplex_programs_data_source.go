@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Multiplex Programs")
+func newDataSourceMultiplexPrograms(_ context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceMultiplexPrograms{}, nil
+}
+
+const (
+	DSNameMultiplexPrograms = "Multiplex Programs Data Source"
+)
+
+type dataSourceMultiplexPrograms struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceMultiplexPrograms) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_medialive_multiplex_programs"
+}
+
+func (d *dataSourceMultiplexPrograms) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"multiplex_id": schema.StringAttribute{
+				Required: true,
+			},
+			"programs": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dsMultiplexProgramSummary](ctx),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceMultiplexPrograms) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().MediaLiveClient(ctx)
+
+	var data dataSourceMultiplexProgramsData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	multiplexID := data.MultiplexID.ValueString()
+	in := &medialive.ListMultiplexProgramsInput{
+		MultiplexId: aws.String(multiplexID),
+	}
+
+	var programs []dsMultiplexProgramSummary
+	pages := medialive.NewListMultiplexProgramsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.MediaLive, create.ErrActionReading, DSNameMultiplexPrograms, multiplexID, err),
+				err.Error(),
+			)
+			return
+		}
+
+		for _, v := range page.MultiplexPrograms {
+			programs = append(programs, dsMultiplexProgramSummary{
+				ChannelID:   fwflex.StringToFramework(ctx, v.ChannelId),
+				ProgramName: fwflex.StringToFramework(ctx, v.ProgramName),
+			})
+		}
+	}
+
+	data.ID = types.StringValue(multiplexID)
+	data.Programs = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, programs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceMultiplexProgramsData struct {
+	ID          types.String                                               `tfsdk:"id"`
+	MultiplexID types.String                                               `tfsdk:"multiplex_id"`
+	Programs    fwtypes.ListNestedObjectValueOf[dsMultiplexProgramSummary] `tfsdk:"programs"`
+}
+
+type dsMultiplexProgramSummary struct {
+	ChannelID   types.String `tfsdk:"channel_id"`
+	ProgramName types.String `tfsdk:"program_name"`
+}
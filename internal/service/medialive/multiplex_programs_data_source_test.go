@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMultiplexProgramsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := fmt.Sprintf("tf_acc_%s", sdkacctest.RandString(8))
+	dataSourceName := "data.aws_medialive_multiplex_programs.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMultiplexProgramDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiplexProgramsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("aws_medialive_multiplex.test", "id", dataSourceName, "multiplex_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "programs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "programs.0.program_name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccMultiplexProgramsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccMultiplexProgramConfig_basic(rName),
+		`
+data "aws_medialive_multiplex_programs" "test" {
+  multiplex_id = aws_medialive_multiplex_program.test.multiplex_id
+}
+`)
+}
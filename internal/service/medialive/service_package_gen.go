@@ -20,6 +20,14 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceInput,
 			Name:    "Input",
 		},
+		{
+			Factory: newDataSourceMultiplexProgram,
+			Name:    "Multiplex Program",
+		},
+		{
+			Factory: newDataSourceMultiplexPrograms,
+			Name:    "Multiplex Programs",
+		},
 	}
 }
 
@@ -53,6 +61,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceInputDevice,
+			TypeName: "aws_medialive_input_device",
+			Name:     "Input Device",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceInputDeviceTransfer,
+			TypeName: "aws_medialive_input_device_transfer",
+			Name:     "Input Device Transfer",
+		},
 		{
 			Factory:  ResourceInputSecurityGroup,
 			TypeName: "aws_medialive_input_security_group",
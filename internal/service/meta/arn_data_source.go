@@ -8,6 +8,7 @@ package meta
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -37,26 +38,32 @@ func (d *dataSourceARN) Schema(ctx context.Context, req datasource.SchemaRequest
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"account": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
 			},
 			"arn": schema.StringAttribute{
 				CustomType: fwtypes.ARNType,
-				Required:   true,
+				Optional:   true,
+				Computed:   true,
 			},
 			"id": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
 			},
 			"partition": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
 			},
 			"region": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
 			},
 			"resource": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
 			},
 			"service": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
 			},
 		},
@@ -74,7 +81,41 @@ func (d *dataSourceARN) Read(ctx context.Context, request datasource.ReadRequest
 		return
 	}
 
-	arn := data.ARN.ValueARN()
+	var arn arn.ARN
+
+	if !data.ARN.IsNull() {
+		if !data.Account.IsNull() || !data.Partition.IsNull() || !data.Region.IsNull() || !data.Resource.IsNull() || !data.Service.IsNull() {
+			response.Diagnostics.AddError("invalid configuration", `"arn" cannot be specified together with "account", "partition", "region", "resource" or "service"`)
+
+			return
+		}
+
+		arn = data.ARN.ValueARN()
+	} else {
+		// Build the ARN from its components, defaulting the partition from the provider.
+		if data.Service.IsNull() || data.Resource.IsNull() {
+			response.Diagnostics.AddError("invalid configuration", `either "arn" or both "service" and "resource" must be specified`)
+
+			return
+		}
+
+		arn.Partition = d.Meta().Partition
+		if !data.Partition.IsNull() {
+			arn.Partition = data.Partition.ValueString()
+		}
+		arn.Service = data.Service.ValueString()
+		arn.Region = data.Region.ValueString()
+		arn.AccountID = data.Account.ValueString()
+		arn.Resource = data.Resource.ValueString()
+
+		v, diags := fwtypes.ARNValue(arn.String())
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		data.ARN = v
+	}
 
 	data.Account = types.StringValue(arn.AccountID)
 	data.ID = types.StringValue(arn.String())
@@ -62,6 +62,37 @@ func TestAccMetaARNDataSource_s3Bucket(t *testing.T) {
 	})
 }
 
+func TestAccMetaARNDataSource_components(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_arn.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNDataSourceConfig_components("s3", "test-bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGlobalARNNoAccount(dataSourceName, "arn", "s3", "test-bucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "account", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+					resource.TestCheckResourceAttr(dataSourceName, "region", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "resource", "test-bucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "service", "s3"),
+				),
+			},
+			{
+				Config: testAccARNDataSourceConfig_allComponents("aws-cn", "rds", "cn-north-1", "123456789012", "db:mysql-db"), // lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arn", "arn:aws-cn:rds:cn-north-1:123456789012:db:mysql-db"), // lintignore:AWSAT003,AWSAT005
+					resource.TestCheckResourceAttr(dataSourceName, "id", "arn:aws-cn:rds:cn-north-1:123456789012:db:mysql-db"),  // lintignore:AWSAT003,AWSAT005
+				),
+			},
+		},
+	})
+}
+
 func testAccARNDataSourceConfig_basic(arn string) string {
 	return fmt.Sprintf(`
 data "aws_arn" "test" {
@@ -69,3 +100,24 @@ data "aws_arn" "test" {
 }
 `, arn)
 }
+
+func testAccARNDataSourceConfig_components(service, resource string) string {
+	return fmt.Sprintf(`
+data "aws_arn" "test" {
+  service  = %[1]q
+  resource = %[2]q
+}
+`, service, resource)
+}
+
+func testAccARNDataSourceConfig_allComponents(partition, service, region, account, resource string) string {
+	return fmt.Sprintf(`
+data "aws_arn" "test" {
+  partition = %[1]q
+  service   = %[2]q
+  region    = %[3]q
+  account   = %[4]q
+  resource  = %[5]q
+}
+`, partition, service, region, account, resource)
+}
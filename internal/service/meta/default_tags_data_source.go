@@ -41,6 +41,14 @@ func (d *dataSourceDefaultTags) Schema(ctx context.Context, req datasource.Schem
 				Optional: true,
 				Computed: true,
 			},
+			"merge": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"merged_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"tags": tftags.TagsAttributeComputedOnly(),
 		},
 	}
@@ -64,10 +72,16 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 	data.ID = types.StringValue(d.Meta().Partition)
 	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
 
+	// Apply the same precedence as a resource's tags_all: passed-in values override default tags.
+	mergedTags := defaultTagsConfig.MergeTags(tftags.New(ctx, data.Merge))
+	data.MergedTags = flex.FlattenFrameworkStringValueMapLegacy(ctx, mergedTags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type dataSourceDefaultTagsData struct {
-	ID   types.String `tfsdk:"id"`
-	Tags types.Map    `tfsdk:"tags"`
+	ID         types.String `tfsdk:"id"`
+	Merge      types.Map    `tfsdk:"merge"`
+	MergedTags types.Map    `tfsdk:"merged_tags"`
+	Tags       types.Map    `tfsdk:"tags"`
 }
@@ -4,6 +4,7 @@
 package meta_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -113,6 +114,55 @@ func TestAccMetaDefaultTagsDataSource_ignore(t *testing.T) {
 	})
 }
 
+func TestAccMetaDefaultTagsDataSource_merge(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_default_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("first", "value", "second", "default"),
+					testAccDefaultTagsDataSourceConfig_merge("second", "override", "third", "value"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "merged_tags.%", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "merged_tags.first", "value"),
+					resource.TestCheckResourceAttr(dataSourceName, "merged_tags.second", "override"),
+					resource.TestCheckResourceAttr(dataSourceName, "merged_tags.third", "value"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeys1("first", "value"),
+					testAccDefaultTagsDataSourceConfig_merge("second", "override", "third", "value"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "merged_tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "merged_tags.second", "override"),
+					resource.TestCheckResourceAttr(dataSourceName, "merged_tags.third", "value"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDefaultTagsDataSourceConfig_basic() string {
 	return `data "aws_default_tags" "test" {}`
 }
+
+func testAccDefaultTagsDataSourceConfig_merge(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_default_tags" "test" {
+  merge = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -5,11 +5,16 @@ package meta
 
 import (
 	"context"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -48,6 +53,15 @@ func (d *dataSourceRegions) Schema(ctx context.Context, req datasource.SchemaReq
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"opt_in_status": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(regionOptInStatusOptInNotRequired, regionOptInStatusOptedIn, regionOptInStatusNotOptedIn),
+					),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"filter": tfec2.CustomFiltersBlock(),
@@ -73,6 +87,18 @@ func (d *dataSourceRegions) Read(ctx context.Context, request datasource.ReadReq
 		Filters:    tfec2.NewCustomFilterListFrameworkV2(ctx, data.Filters),
 	}
 
+	if optInStatuses := flex.ExpandFrameworkStringValueSet(ctx, data.OptInStatus); len(optInStatuses) > 0 {
+		input.Filters = append(input.Filters, awstypes.Filter{
+			Name:   aws.String("opt-in-status"),
+			Values: optInStatuses,
+		})
+
+		// Regions that are not opted in are only returned when all Regions are requested.
+		if data.AllRegions.IsNull() && slices.Contains(optInStatuses, regionOptInStatusNotOptedIn) {
+			input.AllRegions = aws.Bool(true)
+		}
+	}
+
 	output, err := conn.DescribeRegions(ctx, input)
 
 	if err != nil {
@@ -92,9 +118,16 @@ func (d *dataSourceRegions) Read(ctx context.Context, request datasource.ReadReq
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+const (
+	regionOptInStatusNotOptedIn       = "not-opted-in"
+	regionOptInStatusOptInNotRequired = "opt-in-not-required"
+	regionOptInStatusOptedIn          = "opted-in"
+)
+
 type dataSourceRegionsData struct {
-	AllRegions types.Bool   `tfsdk:"all_regions"`
-	Filters    types.Set    `tfsdk:"filter"`
-	ID         types.String `tfsdk:"id"`
-	Names      types.Set    `tfsdk:"names"`
+	AllRegions  types.Bool   `tfsdk:"all_regions"`
+	Filters     types.Set    `tfsdk:"filter"`
+	ID          types.String `tfsdk:"id"`
+	Names       types.Set    `tfsdk:"names"`
+	OptInStatus types.Set    `tfsdk:"opt_in_status"`
 }
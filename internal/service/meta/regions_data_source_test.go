@@ -88,6 +88,31 @@ func TestAccMetaRegionsDataSource_nonExistentRegion(t *testing.T) {
 	})
 }
 
+func TestAccMetaRegionsDataSource_optInStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_regions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionsDataSourceConfig_optInStatus("opt-in-not-required"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "names.#", 0),
+				),
+			},
+			{
+				Config: testAccRegionsDataSourceConfig_optInStatus("not-opted-in"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRegionsDataSourceConfig_empty() string {
 	return `
 data "aws_regions" "test" {}
@@ -123,3 +148,11 @@ data "aws_regions" "test" {
 }
 `
 }
+
+func testAccRegionsDataSourceConfig_optInStatus(status string) string {
+	return fmt.Sprintf(`
+data "aws_regions" "test" {
+  opt_in_status = [%[1]q]
+}
+`, status)
+}
@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Resource Dependents")
+func newDataSourceResourceDependents(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceResourceDependents{}, nil
+}
+
+type dataSourceResourceDependents struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceResourceDependents) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_resource_dependents"
+}
+
+func (d *dataSourceResourceDependents) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"dependents": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[resourceDependentModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[resourceDependentModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *dataSourceResourceDependents) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceResourceDependentsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	arn := data.ARN.ValueARN()
+	resourceType, resourceID, _ := strings.Cut(arn.Resource, "/")
+
+	// Dependents are discovered using the provider's configured Region and account.
+	if region := d.Meta().Region; arn.Region != region {
+		response.Diagnostics.AddError(fmt.Sprintf("reading dependents of %s", arn), fmt.Sprintf("ARN Region (%s) does not match the provider's configured Region (%s)", arn.Region, region))
+
+		return
+	}
+	if accountID := d.Meta().AccountID; arn.AccountID != accountID {
+		response.Diagnostics.AddError(fmt.Sprintf("reading dependents of %s", arn), fmt.Sprintf("ARN account ID (%s) does not match the provider's configured account ID (%s)", arn.AccountID, accountID))
+
+		return
+	}
+
+	var dependents []resourceDependentModel
+	var err error
+
+	switch service := arn.Service; service {
+	case "ec2":
+		conn := d.Meta().EC2Client(ctx)
+
+		switch resourceType {
+		case "security-group":
+			dependents, err = findSecurityGroupDependents(ctx, conn, resourceID)
+		case "subnet":
+			dependents, err = findSubnetDependents(ctx, conn, resourceID)
+		case "vpc":
+			dependents, err = findVPCDependents(ctx, conn, resourceID)
+		default:
+			err = fmt.Errorf("unsupported %s resource type: %s", service, resourceType)
+		}
+	case "kms":
+		conn := d.Meta().KMSConn(ctx)
+
+		switch resourceType {
+		case "key":
+			dependents, err = findKeyDependents(ctx, conn, resourceID)
+		default:
+			err = fmt.Errorf("unsupported %s resource type: %s", service, resourceType)
+		}
+	default:
+		err = fmt.Errorf("unsupported service: %s", service)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading dependents of %s", arn), err.Error())
+
+		return
+	}
+
+	data.Dependents = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, dependents)
+	data.ID = types.StringValue(arn.String())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceResourceDependentsModel struct {
+	ARN        fwtypes.ARN                                             `tfsdk:"arn"`
+	Dependents fwtypes.ListNestedObjectValueOf[resourceDependentModel] `tfsdk:"dependents"`
+	ID         types.String                                            `tfsdk:"id"`
+}
+
+type resourceDependentModel struct {
+	ResourceID   types.String `tfsdk:"resource_id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+}
+
+func newResourceDependent(resourceType, resourceID string) resourceDependentModel {
+	return resourceDependentModel{
+		ResourceID:   types.StringValue(resourceID),
+		ResourceType: types.StringValue(resourceType),
+	}
+}
+
+func newEC2Filter(name string, values ...string) ec2types.Filter {
+	return ec2types.Filter{
+		Name:   aws.String(name),
+		Values: values,
+	}
+}
+
+func findNetworkInterfaceDependents(ctx context.Context, conn *ec2.Client, filters ...ec2types.Filter) ([]resourceDependentModel, error) {
+	var output []resourceDependentModel
+
+	pages := ec2.NewDescribeNetworkInterfacesPaginator(conn, &ec2.DescribeNetworkInterfacesInput{
+		Filters: filters,
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 Network Interfaces: %w", err)
+		}
+
+		for _, v := range page.NetworkInterfaces {
+			output = append(output, newResourceDependent("network-interface", aws.ToString(v.NetworkInterfaceId)))
+		}
+	}
+
+	return output, nil
+}
+
+func findSecurityGroupDependents(ctx context.Context, conn *ec2.Client, id string) ([]resourceDependentModel, error) {
+	output, err := findNetworkInterfaceDependents(ctx, conn, newEC2Filter("group-id", id))
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Other security groups with rules referencing this security group.
+	seen := map[string]bool{id: true}
+	for _, filter := range []string{"ip-permission.group-id", "egress.ip-permission.group-id"} {
+		pages := ec2.NewDescribeSecurityGroupsPaginator(conn, &ec2.DescribeSecurityGroupsInput{
+			Filters: []ec2types.Filter{newEC2Filter(filter, id)},
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("reading EC2 Security Groups: %w", err)
+			}
+
+			for _, v := range page.SecurityGroups {
+				if groupID := aws.ToString(v.GroupId); !seen[groupID] {
+					seen[groupID] = true
+					output = append(output, newResourceDependent("security-group", groupID))
+				}
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findSubnetDependents(ctx context.Context, conn *ec2.Client, id string) ([]resourceDependentModel, error) {
+	return findNetworkInterfaceDependents(ctx, conn, newEC2Filter("subnet-id", id))
+}
+
+func findVPCDependents(ctx context.Context, conn *ec2.Client, id string) ([]resourceDependentModel, error) {
+	var output []resourceDependentModel
+
+	subnets := ec2.NewDescribeSubnetsPaginator(conn, &ec2.DescribeSubnetsInput{
+		Filters: []ec2types.Filter{newEC2Filter("vpc-id", id)},
+	})
+	for subnets.HasMorePages() {
+		page, err := subnets.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 Subnets: %w", err)
+		}
+
+		for _, v := range page.Subnets {
+			output = append(output, newResourceDependent("subnet", aws.ToString(v.SubnetId)))
+		}
+	}
+
+	securityGroups := ec2.NewDescribeSecurityGroupsPaginator(conn, &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{newEC2Filter("vpc-id", id)},
+	})
+	for securityGroups.HasMorePages() {
+		page, err := securityGroups.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 Security Groups: %w", err)
+		}
+
+		for _, v := range page.SecurityGroups {
+			// The VPC's default security group is deleted along with the VPC.
+			if aws.ToString(v.GroupName) == "default" {
+				continue
+			}
+
+			output = append(output, newResourceDependent("security-group", aws.ToString(v.GroupId)))
+		}
+	}
+
+	internetGateways := ec2.NewDescribeInternetGatewaysPaginator(conn, &ec2.DescribeInternetGatewaysInput{
+		Filters: []ec2types.Filter{newEC2Filter("attachment.vpc-id", id)},
+	})
+	for internetGateways.HasMorePages() {
+		page, err := internetGateways.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 Internet Gateways: %w", err)
+		}
+
+		for _, v := range page.InternetGateways {
+			output = append(output, newResourceDependent("internet-gateway", aws.ToString(v.InternetGatewayId)))
+		}
+	}
+
+	natGateways := ec2.NewDescribeNatGatewaysPaginator(conn, &ec2.DescribeNatGatewaysInput{
+		Filter: []ec2types.Filter{
+			newEC2Filter("vpc-id", id),
+			newEC2Filter("state", string(ec2types.NatGatewayStatePending), string(ec2types.NatGatewayStateAvailable), string(ec2types.NatGatewayStateDeleting)),
+		},
+	})
+	for natGateways.HasMorePages() {
+		page, err := natGateways.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 NAT Gateways: %w", err)
+		}
+
+		for _, v := range page.NatGateways {
+			output = append(output, newResourceDependent("natgateway", aws.ToString(v.NatGatewayId)))
+		}
+	}
+
+	networkInterfaces, err := findNetworkInterfaceDependents(ctx, conn, newEC2Filter("vpc-id", id))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(output, networkInterfaces...), nil
+}
+
+// findKeyDependents returns the aliases and grants for a KMS key.
+func findKeyDependents(ctx context.Context, conn kmsiface.KMSAPI, id string) ([]resourceDependentModel, error) {
+	var output []resourceDependentModel
+
+	err := conn.ListAliasesPagesWithContext(ctx, &kms.ListAliasesInput{KeyId: aws_sdkv1.String(id)}, func(page *kms.ListAliasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Aliases {
+			output = append(output, newResourceDependent("alias", aws_sdkv1.StringValue(v.AliasName)))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading KMS Aliases: %w", err)
+	}
+
+	err = conn.ListGrantsPagesWithContext(ctx, &kms.ListGrantsInput{KeyId: aws_sdkv1.String(id)}, func(page *kms.ListGrantsResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Grants {
+			output = append(output, newResourceDependent("grant", aws_sdkv1.StringValue(v.GrantId)))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading KMS Grants: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestAccMetaResourceDependentsDataSource_securityGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_resource_dependents.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDependentsDataSourceConfig_securityGroup(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "dependents.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "dependents.0.resource_type", "security-group"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dependents.0.resource_id", "aws_security_group.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaResourceDependentsDataSource_kmsKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_resource_dependents.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDependentsDataSourceConfig_kmsKey(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "dependents.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "dependents.0.resource_type", "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dependents.0.resource_id", "aws_kms_alias.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "dependents.1.resource_type", "grant"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dependents.1.resource_id", "aws_kms_grant.test", "grant_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaResourceDependentsDataSource_regionMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDependentsDataSourceConfig_regionMismatch(),
+				ExpectError: regexache.MustCompile(`does not match the provider's configured Region`),
+			},
+		},
+	})
+}
+
+func testAccResourceDependentsDataSourceConfig_securityGroup(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test1" {
+  name   = "%[1]s-1"
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_security_group" "test2" {
+  name   = "%[1]s-2"
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    from_port       = 443
+    to_port         = 443
+    protocol        = "tcp"
+    security_groups = [aws_security_group.test1.id]
+  }
+}
+
+data "aws_resource_dependents" "test" {
+  arn = aws_security_group.test1.arn
+
+  depends_on = [aws_security_group.test2]
+}
+`, rName))
+}
+
+func testAccResourceDependentsDataSourceConfig_kmsKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_alias" "test" {
+  name          = "alias/%[1]s"
+  target_key_id = aws_kms_key.test.key_id
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_grant" "test" {
+  name              = %[1]q
+  key_id            = aws_kms_key.test.key_id
+  grantee_principal = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+  operations        = ["Decrypt"]
+}
+
+data "aws_resource_dependents" "test" {
+  arn = aws_kms_key.test.arn
+
+  depends_on = [aws_kms_alias.test, aws_kms_grant.test]
+}
+`, rName)
+}
+
+func testAccResourceDependentsDataSourceConfig_regionMismatch() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_resource_dependents" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:ec2:not-a-region-1:${data.aws_caller_identity.current.account_id}:vpc/vpc-12345678"
+}
+`
+}
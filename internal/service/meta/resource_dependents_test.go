@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// testKeyDependentsConn returns the configured aliases and grants, one per page.
+type testKeyDependentsConn struct {
+	kmsiface.KMSAPI
+	aliases   []string
+	grants    []string
+	grantsErr error
+}
+
+func (c *testKeyDependentsConn) ListAliasesPagesWithContext(_ context.Context, input *kms.ListAliasesInput, fn func(*kms.ListAliasesOutput, bool) bool, _ ...request.Option) error {
+	for i, v := range c.aliases {
+		page := &kms.ListAliasesOutput{
+			Aliases: []*kms.AliasListEntry{{AliasName: aws.String(v), TargetKeyId: input.KeyId}},
+		}
+
+		if !fn(page, i == len(c.aliases)-1) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (c *testKeyDependentsConn) ListGrantsPagesWithContext(_ context.Context, input *kms.ListGrantsInput, fn func(*kms.ListGrantsResponse, bool) bool, _ ...request.Option) error {
+	if c.grantsErr != nil {
+		return c.grantsErr
+	}
+
+	for i, v := range c.grants {
+		page := &kms.ListGrantsResponse{
+			Grants: []*kms.GrantListEntry{{GrantId: aws.String(v), KeyId: input.KeyId}},
+		}
+
+		if !fn(page, i == len(c.grants)-1) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func TestFindKeyDependents(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		conn    *testKeyDependentsConn
+		want    []resourceDependentModel
+		wantErr bool
+	}{
+		"none": {
+			conn: &testKeyDependentsConn{},
+		},
+		"aliases and grants": {
+			conn: &testKeyDependentsConn{
+				aliases: []string{"alias/test1", "alias/test2"},
+				grants:  []string{"grant1", "grant2"},
+			},
+			want: []resourceDependentModel{
+				newResourceDependent("alias", "alias/test1"),
+				newResourceDependent("alias", "alias/test2"),
+				newResourceDependent("grant", "grant1"),
+				newResourceDependent("grant", "grant2"),
+			},
+		},
+		"grants only": {
+			conn: &testKeyDependentsConn{
+				grants: []string{"grant1"},
+			},
+			want: []resourceDependentModel{
+				newResourceDependent("grant", "grant1"),
+			},
+		},
+		"error": {
+			conn: &testKeyDependentsConn{
+				aliases:   []string{"alias/test1"},
+				grantsErr: errors.New("AccessDeniedException"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := findKeyDependents(context.Background(), testCase.conn, "1234abcd-12ab-34cd-56ef-1234567890ab")
+
+			if gotErr := err != nil; gotErr != testCase.wantErr {
+				t.Fatalf("error = %v, want error %t", err, testCase.wantErr)
+			}
+			if len(got) != len(testCase.want) {
+				t.Fatalf("dependents = %v, want %v", got, testCase.want)
+			}
+			for i := range got {
+				if !got[i].ResourceType.Equal(testCase.want[i].ResourceType) || !got[i].ResourceID.Equal(testCase.want[i].ResourceID) {
+					t.Errorf("dependents[%d] = %v, want %v", i, got[i], testCase.want[i])
+				}
+			}
+		})
+	}
+}
@@ -35,9 +35,16 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceRegions,
 			Name:    "Regions",
 		},
+		{
+			Factory: newDataSourceResourceDependents,
+			Name:    "Resource Dependents",
+		},
 		{
 			Factory: newDataSourceService,
 		},
+		{
+			Factory: newDataSourceServicePrincipal,
+		},
 	}
 }
 
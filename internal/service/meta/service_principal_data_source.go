@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource
+func newDataSourceServicePrincipal(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceServicePrincipal{}
+
+	return d, nil
+}
+
+type dataSourceServicePrincipal struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceServicePrincipal) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_service_principal"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceServicePrincipal) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"service_name": schema.StringAttribute{
+				Required: true,
+			},
+			"suffix": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceServicePrincipal) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceServicePrincipalData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var region *endpoints.Region
+
+	// find the region given by the user
+	if !data.Region.IsNull() {
+		name := data.Region.ValueString()
+		matchingRegion, err := FindRegionByName(name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("finding Region by name (%s)", name), err.Error())
+
+			return
+		}
+
+		region = matchingRegion
+	}
+
+	// Default to provider current region if no other filters matched
+	if region == nil {
+		name := d.Meta().Region
+		matchingRegion, err := FindRegionByName(name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("finding Region by name (%s)", name), err.Error())
+
+			return
+		}
+
+		region = matchingRegion
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region.ID())
+
+	if !ok {
+		response.Diagnostics.AddError("finding partition for Region", fmt.Sprintf("no partition found for Region %s", region.ID()))
+
+		return
+	}
+
+	serviceName := data.ServiceName.ValueString()
+	suffix := servicePrincipalSuffix(serviceName, partition)
+
+	data.ID = types.StringValue(serviceName + "." + region.ID() + "." + suffix)
+	data.Name = types.StringValue(serviceName + "." + suffix)
+	data.Region = types.StringValue(region.ID())
+	data.Suffix = types.StringValue(suffix)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// servicePrincipalSuffix returns the DNS suffix used in the service principal for the given service and partition.
+// Service principals use "amazonaws.com" in every partition except for a handful of services in AWS China.
+func servicePrincipalSuffix(service string, partition endpoints.Partition) string {
+	if partition.ID() == endpoints.AwsCnPartitionID {
+		switch service {
+		case "codedeploy", "elasticmapreduce", "logs":
+			return partition.DNSSuffix()
+		}
+	}
+
+	return "amazonaws.com"
+}
+
+type dataSourceServicePrincipalData struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Region      types.String `tfsdk:"region"`
+	ServiceName types.String `tfsdk:"service_name"`
+	Suffix      types.String `tfsdk:"suffix"`
+}
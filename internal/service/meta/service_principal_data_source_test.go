@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestAccMetaServicePrincipal_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service_principal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalDataSourceConfig_basic("s3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprintf("s3.%s.amazonaws.com", acctest.Region())),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "s3.amazonaws.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "region", acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "suffix", "amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaServicePrincipal_byRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service_principal.test"
+
+	testCases := []struct {
+		region  string
+		service string
+		name    string
+	}{
+		{endpoints.UsEast1RegionID, "logs", "logs.amazonaws.com"},
+		{endpoints.UsGovWest1RegionID, "logs", "logs.amazonaws.com"},
+		{endpoints.CnNorth1RegionID, "logs", "logs.amazonaws.com.cn"},
+		{endpoints.CnNorthwest1RegionID, "elasticmapreduce", "elasticmapreduce.amazonaws.com.cn"},
+		{endpoints.CnNorthwest1RegionID, "s3", "s3.amazonaws.com"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(fmt.Sprintf("%s/%s", testCase.region, testCase.service), func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+				ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccServicePrincipalDataSourceConfig_region(testCase.service, testCase.region),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "name", testCase.name),
+							resource.TestCheckResourceAttr(dataSourceName, "region", testCase.region),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccServicePrincipalDataSourceConfig_basic(service string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "test" {
+  service_name = %[1]q
+}
+`, service)
+}
+
+func testAccServicePrincipalDataSourceConfig_region(service, region string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "test" {
+  service_name = %[1]q
+  region       = %[2]q
+}
+`, service, region)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_neptune_global_cluster")
@@ -91,6 +92,12 @@ func ResourceGlobalCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"primary_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"source_db_cluster_identifier": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -183,6 +190,13 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting global_cluster_members: %s", err)
 	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("primary_db_cluster_arn", "")
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.BoolValue(v.IsWriter) {
+			d.Set("primary_db_cluster_arn", v.DBClusterArn)
+			break
+		}
+	}
 	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
 
 	return diags
@@ -253,6 +267,27 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// Promote a secondary cluster using a managed planned failover ("switchover").
+	// A change in primary cluster caused by an unplanned failover outside of Terraform is just refreshed.
+	if d.HasChange("primary_db_cluster_arn") {
+		if clusterARN := d.Get("primary_db_cluster_arn").(string); clusterARN != "" {
+			input := &neptune.FailoverGlobalClusterInput{
+				GlobalClusterIdentifier:   aws.String(d.Id()),
+				TargetDbClusterIdentifier: aws.String(clusterARN),
+			}
+
+			_, err := conn.FailoverGlobalClusterWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "failing over Neptune Global Cluster (%s) to Neptune Cluster (%s): %s", d.Id(), clusterARN, err)
+			}
+
+			if _, err := waitGlobalClusterFailedOver(ctx, conn, d.Id(), clusterARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Neptune Global Cluster (%s) failover: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceGlobalClusterRead(ctx, d, meta)...)
 }
 
@@ -427,6 +462,41 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *neptune.Neptune, id str
 	return nil, err
 }
 
+func waitGlobalClusterFailedOver(ctx context.Context, conn *neptune.Neptune, id, clusterARN string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{globalClusterStatusFailingOver},
+		Target:  []string{globalClusterStatusAvailable},
+		Refresh: func() (interface{}, string, error) {
+			output, err := FindGlobalClusterByID(ctx, conn, id)
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := aws.StringValue(output.Status)
+
+			// The global cluster may still report as available before the failover begins.
+			if status == globalClusterStatusAvailable && !slices.ContainsFunc(output.GlobalClusterMembers, func(v *neptune.GlobalClusterMember) bool {
+				return aws.StringValue(v.DBClusterArn) == clusterARN && aws.BoolValue(v.IsWriter)
+			}) {
+				return output, globalClusterStatusFailingOver, nil
+			}
+
+			return output, status, nil
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitGlobalClusterDeleted(ctx context.Context, conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        []string{globalClusterStatusAvailable, globalClusterStatusDeleting},
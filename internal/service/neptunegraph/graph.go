@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_neptunegraph_graph", name="Graph")
+// @Tags(identifierAttribute="arn")
+func resourceGraph() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGraphCreate,
+		ReadWithoutTimeout:   resourceGraphRead,
+		UpdateWithoutTimeout: resourceGraphUpdate,
+		DeleteWithoutTimeout: resourceGraphDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"graph_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexache.MustCompile(`^[a-z][0-9a-z-]*$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`--|-$`), "cannot contain two consecutive hyphens or end with a hyphen"),
+				),
+			},
+			"kms_key_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"provisioned_memory": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(16),
+			},
+			"public_connectivity": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"replica_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 2),
+			},
+			"skip_final_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vector_search_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dimension": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 65536),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+	name := d.Get("graph_name").(string)
+	input := &neptunegraph.CreateGraphInput{
+		DeletionProtection: aws.Bool(d.Get("deletion_protection").(bool)),
+		GraphName:          aws.String(name),
+		ProvisionedMemory:  aws.Int32(int32(d.Get("provisioned_memory").(int))),
+		PublicConnectivity: aws.Bool(d.Get("public_connectivity").(bool)),
+		ReplicaCount:       aws.Int32(int32(d.Get("replica_count").(int))),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("kms_key_identifier"); ok {
+		input.KmsKeyIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vector_search_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VectorSearchConfiguration = expandVectorSearchConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateGraph(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Neptune Analytics Graph (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	if _, err := waitGraphCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Neptune Analytics Graph (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceGraphRead(ctx, d, meta)...)
+}
+
+func resourceGraphRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+	graph, err := findGraphByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Neptune Analytics Graph (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Neptune Analytics Graph (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", graph.Arn)
+	d.Set("deletion_protection", graph.DeletionProtection)
+	d.Set("endpoint", graph.Endpoint)
+	d.Set("graph_name", graph.Name)
+	d.Set("kms_key_identifier", graph.KmsKeyIdentifier)
+	d.Set("provisioned_memory", graph.ProvisionedMemory)
+	d.Set("public_connectivity", graph.PublicConnectivity)
+	d.Set("replica_count", graph.ReplicaCount)
+	if err := d.Set("vector_search_configuration", flattenVectorSearchConfiguration(graph.VectorSearchConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vector_search_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceGraphUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+	if d.HasChanges("deletion_protection", "provisioned_memory", "public_connectivity") {
+		input := &neptunegraph.UpdateGraphInput{
+			GraphIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("deletion_protection") {
+			input.DeletionProtection = aws.Bool(d.Get("deletion_protection").(bool))
+		}
+
+		if d.HasChange("provisioned_memory") {
+			input.ProvisionedMemory = aws.Int32(int32(d.Get("provisioned_memory").(int)))
+		}
+
+		if d.HasChange("public_connectivity") {
+			input.PublicConnectivity = aws.Bool(d.Get("public_connectivity").(bool))
+		}
+
+		_, err := conn.UpdateGraph(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Neptune Analytics Graph (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitGraphUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Neptune Analytics Graph (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceGraphRead(ctx, d, meta)...)
+}
+
+func resourceGraphDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Neptune Analytics Graph: %s", d.Id())
+	_, err := conn.DeleteGraph(ctx, &neptunegraph.DeleteGraphInput{
+		GraphIdentifier: aws.String(d.Id()),
+		SkipSnapshot:    aws.Bool(d.Get("skip_final_snapshot").(bool)),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Neptune Analytics Graph (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitGraphDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Neptune Analytics Graph (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findGraphByID(ctx context.Context, conn *neptunegraph.Client, id string) (*neptunegraph.GetGraphOutput, error) {
+	input := &neptunegraph.GetGraphInput{
+		GraphIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetGraph(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusGraph(ctx context.Context, conn *neptunegraph.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGraphByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitGraphCreated(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetGraphOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GraphStatusCreating),
+		Target:  enum.Slice(awstypes.GraphStatusAvailable),
+		Refresh: statusGraph(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetGraphOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGraphUpdated(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetGraphOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GraphStatusUpdating),
+		Target:  enum.Slice(awstypes.GraphStatusAvailable),
+		Refresh: statusGraph(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetGraphOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGraphDeleted(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetGraphOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GraphStatusAvailable, awstypes.GraphStatusDeleting, awstypes.GraphStatusSnapshotting),
+		Target:  []string{},
+		Refresh: statusGraph(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetGraphOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandVectorSearchConfiguration(tfMap map[string]interface{}) *awstypes.VectorSearchConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.VectorSearchConfiguration{}
+
+	if v, ok := tfMap["dimension"].(int); ok && v != 0 {
+		apiObject.Dimension = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenVectorSearchConfiguration(apiObject *awstypes.VectorSearchConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dimension": aws.ToInt32(apiObject.Dimension),
+	}
+
+	return []interface{}{tfMap}
+}
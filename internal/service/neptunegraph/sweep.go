@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_neptunegraph_graph", &resource.Sweeper{
+		Name: "aws_neptunegraph_graph",
+		F:    sweepGraphs,
+	})
+}
+
+func sweepGraphs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	input := &neptunegraph.ListGraphsInput{}
+	conn := client.NeptuneGraphClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Neptune Analytics Graph sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Neptune Analytics Graphs (%s): %w", region, err)
+		}
+
+		for _, v := range page.Graphs {
+			id := aws.ToString(v.Id)
+
+			if aws.ToBool(v.DeletionProtection) {
+				log.Printf("[INFO] Skipping Neptune Analytics Graph %s: DeletionProtection=true", id)
+				continue
+			}
+
+			r := resourceGraph()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("skip_final_snapshot", true)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Neptune Analytics Graphs (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
@@ -58,6 +59,43 @@ func ResourceLink() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"link_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2000),
+									},
+								},
+							},
+						},
+						"metric_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"link_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -103,6 +141,10 @@ func resourceLinkCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		Tags:           getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("link_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.LinkConfiguration = expandLinkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	out, err := conn.CreateLink(ctx, in)
 	if err != nil {
 		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionCreating, ResNameLink, d.Get("sink_identifier").(string), err)
@@ -135,6 +177,9 @@ func resourceLinkRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("arn", out.Arn)
 	d.Set("label", out.Label)
 	d.Set("label_template", out.LabelTemplate)
+	if err := d.Set("link_configuration", flattenLinkConfiguration(out.LinkConfiguration)); err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionSetting, ResNameLink, d.Id(), err)
+	}
 	d.Set("link_id", out.Id)
 	d.Set("resource_types", flex.FlattenStringValueList(out.ResourceTypes))
 	d.Set("sink_arn", out.SinkArn)
@@ -152,8 +197,16 @@ func resourceLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		Identifier: aws.String(d.Id()),
 	}
 
-	if d.HasChanges("resource_types") {
+	if d.HasChanges("link_configuration", "resource_types") {
+		// UpdateLink replaces the whole link, so the unchanged attributes must be sent too.
 		in.ResourceTypes = flex.ExpandStringyValueSet[types.ResourceType](d.Get("resource_types").(*schema.Set))
+
+		if v, ok := d.GetOk("link_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			in.LinkConfiguration = expandLinkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			in.LinkConfiguration = &types.LinkConfiguration{}
+		}
+
 		update = true
 	}
 
@@ -212,3 +265,47 @@ func findLinkByID(ctx context.Context, conn *oam.Client, id string) (*oam.GetLin
 
 	return out, nil
 }
+
+func expandLinkConfiguration(tfMap map[string]interface{}) *types.LinkConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LinkConfiguration{}
+
+	if v, ok := tfMap["log_group_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogGroupConfiguration = &types.LogGroupConfiguration{
+			Filter: aws.String(v[0].(map[string]interface{})["filter"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["metric_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MetricConfiguration = &types.MetricConfiguration{
+			Filter: aws.String(v[0].(map[string]interface{})["filter"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenLinkConfiguration(apiObject *types.LinkConfiguration) []interface{} {
+	if apiObject == nil || (apiObject.LogGroupConfiguration == nil && apiObject.MetricConfiguration == nil) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LogGroupConfiguration; v != nil {
+		tfMap["log_group_configuration"] = []interface{}{map[string]interface{}{
+			"filter": aws.ToString(v.Filter),
+		}}
+	}
+
+	if v := apiObject.MetricConfiguration; v != nil {
+		tfMap["metric_configuration"] = []interface{}{map[string]interface{}{
+			"filter": aws.ToString(v.Filter),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -159,6 +159,60 @@ func TestAccObservabilityAccessManagerLink_update(t *testing.T) {
 	})
 }
 
+func TestAccObservabilityAccessManagerLink_linkConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var link oam.GetLinkOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_oam_link.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckPartitionHasService(t, names.ObservabilityAccessManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ObservabilityAccessManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkConfig_linkConfiguration(rName, "LogGroupName LIKE 'aws/lambda/%'", "Namespace IN ('AWS/EC2', 'AWS/ELB')"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(ctx, resourceName, &link),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.0.log_group_configuration.0.filter", "LogGroupName LIKE 'aws/lambda/%'"),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.0.metric_configuration.0.filter", "Namespace IN ('AWS/EC2', 'AWS/ELB')"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLinkConfig_linkConfiguration(rName, "LogGroupName NOT IN ('Private-Log-Group')", "Namespace NOT LIKE 'AWS/%'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(ctx, resourceName, &link),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.0.log_group_configuration.0.filter", "LogGroupName NOT IN ('Private-Log-Group')"),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.0.metric_configuration.0.filter", "Namespace NOT LIKE 'AWS/%'"),
+				),
+			},
+			{
+				Config: testAccLinkConfig_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(ctx, resourceName, &link),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccObservabilityAccessManagerLink_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -373,6 +427,63 @@ resource "aws_oam_link" "test" {
 `, rName))
 }
 
+func testAccLinkConfig_linkConfiguration(rName, logGroupFilter, metricFilter string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		fmt.Sprintf(`
+data "aws_caller_identity" "source" {}
+data "aws_partition" "source" {}
+
+resource "aws_oam_sink" "test" {
+  provider = "awsalternate"
+
+  name = %[1]q
+}
+
+resource "aws_oam_sink_policy" "test" {
+  provider = "awsalternate"
+
+  sink_identifier = aws_oam_sink.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = ["oam:CreateLink", "oam:UpdateLink"]
+        Effect   = "Allow"
+        Resource = "*"
+        Principal = {
+          "AWS" = "arn:${data.aws_partition.source.partition}:iam::${data.aws_caller_identity.source.account_id}:root"
+        }
+        Condition = {
+          "ForAllValues:StringEquals" = {
+            "oam:ResourceTypes" = ["AWS::CloudWatch::Metric", "AWS::Logs::LogGroup"]
+          }
+        }
+      }
+    ]
+  })
+}
+
+resource "aws_oam_link" "test" {
+  label_template  = "$AccountName"
+  resource_types  = ["AWS::CloudWatch::Metric", "AWS::Logs::LogGroup"]
+  sink_identifier = aws_oam_sink.test.id
+
+  link_configuration {
+    log_group_configuration {
+      filter = %[2]q
+    }
+
+    metric_configuration {
+      filter = %[3]q
+    }
+  }
+
+  depends_on = [aws_oam_sink_policy.test]
+}
+`, rName, logGroupFilter, metricFilter))
+}
+
 func testAccLinkConfig_tags1(rName, tag1Key, tag1Value string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAlternateAccountProvider(),
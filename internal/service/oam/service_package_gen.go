@@ -36,6 +36,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSink,
 			TypeName: "aws_oam_sink",
 		},
+		{
+			Factory:  DataSourceSinkPolicy,
+			TypeName: "aws_oam_sink_policy",
+		},
 		{
 			Factory:  DataSourceSinks,
 			TypeName: "aws_oam_sinks",
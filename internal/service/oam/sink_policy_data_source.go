@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/oam"
+	"github.com/aws/aws-sdk-go-v2/service/oam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_oam_sink_policy")
+func DataSourceSinkPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSinkPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attached_link": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_types": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"connected_account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sink_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sink_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+const (
+	DSNameSinkPolicy = "Sink Policy Data Source"
+)
+
+func dataSourceSinkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerClient(ctx)
+
+	sinkIdentifier := d.Get("sink_identifier").(string)
+
+	out, err := findSinkPolicyByID(ctx, conn, sinkIdentifier)
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionReading, DSNameSinkPolicy, sinkIdentifier, err)
+	}
+
+	links, err := findAttachedLinks(ctx, conn, &oam.ListAttachedLinksInput{
+		SinkIdentifier: aws.String(sinkIdentifier),
+	})
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionReading, DSNameSinkPolicy, sinkIdentifier, err)
+	}
+
+	d.SetId(aws.ToString(out.SinkArn))
+
+	d.Set("arn", out.SinkArn)
+	d.Set("policy", out.Policy)
+	d.Set("sink_id", out.SinkId)
+
+	var accountIDs []string
+	tfList := make([]interface{}, 0, len(links))
+	for _, v := range links {
+		// Links are owned by the source account, so the account that is connected
+		// to the sink is the one in the link's ARN.
+		var accountID string
+		if linkARN, err := arn.Parse(aws.ToString(v.LinkArn)); err == nil {
+			accountID = linkARN.AccountID
+			accountIDs = append(accountIDs, accountID)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"account_id":     accountID,
+			"label":          aws.ToString(v.Label),
+			"link_arn":       aws.ToString(v.LinkArn),
+			"resource_types": v.ResourceTypes,
+		})
+	}
+
+	if err := d.Set("attached_link", tfList); err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionSetting, DSNameSinkPolicy, d.Id(), err)
+	}
+	d.Set("connected_account_ids", accountIDs)
+
+	return nil
+}
+
+func findAttachedLinks(ctx context.Context, conn *oam.Client, input *oam.ListAttachedLinksInput) ([]types.ListAttachedLinksItem, error) {
+	var output []types.ListAttachedLinksItem
+
+	pages := oam.NewListAttachedLinksPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
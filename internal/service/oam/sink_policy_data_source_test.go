@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oam_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccObservabilityAccessManagerSinkPolicyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_oam_sink_policy.test"
+	linkResourceName := "aws_oam_link.test"
+	sinkResourceName := "aws_oam_sink.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckPartitionHasService(t, names.ObservabilityAccessManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ObservabilityAccessManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkPolicyDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", sinkResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "attached_link.#", "1"),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "attached_link.0.account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attached_link.0.label", linkResourceName, "label"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attached_link.0.link_arn", linkResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "attached_link.0.resource_types.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "connected_account_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sink_id", sinkResourceName, "sink_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSinkPolicyDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLinkConfig_basic(rName), `
+data "aws_oam_sink_policy" "test" {
+  provider = "awsalternate"
+
+  sink_identifier = aws_oam_sink.test.id
+
+  depends_on = [aws_oam_link.test]
+}
+`)
+}
@@ -37,6 +37,10 @@ func ResourceAccount() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -97,6 +101,10 @@ func ResourceAccount() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[\w+=,.@-]{1,64}$`), "must consist of uppercase letters, lowercase letters, digits with no spaces, and any of the following characters"),
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -175,7 +183,8 @@ func resourceAccountRead(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
 
-	account, err := FindAccountByID(ctx, conn, d.Id())
+	// A closed account remains SUSPENDED in the organization for 90 days and is kept in state as CLOSED.
+	account, err := findAccountByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] AWS Organizations Account does not exist, removing from state: %s", d.Id())
@@ -199,6 +208,7 @@ func resourceAccountRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("joined_timestamp", aws.TimeValue(account.JoinedTimestamp).Format(time.RFC3339))
 	d.Set("name", account.Name)
 	d.Set("parent_id", parentAccountID)
+	d.Set("state", accountStateFromStatus(aws.StringValue(account.Status)))
 	d.Set("status", account.Status)
 
 	return diags
@@ -229,36 +239,54 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
 
-	close := d.Get("close_on_deletion").(bool)
-	var err error
+	if d.Get("status").(string) == organizations.AccountStatusSuspended {
+		log.Printf("[DEBUG] AWS Organizations Account (%s) is already closed", d.Id())
+		return diags
+	}
 
-	if close {
-		log.Printf("[DEBUG] Closing AWS Organizations Account: %s", d.Id())
-		_, err = conn.CloseAccountWithContext(ctx, &organizations.CloseAccountInput{
-			AccountId: aws.String(d.Id()),
-		})
-	} else {
+	if !d.Get("close_on_deletion").(bool) {
 		log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
-		_, err = conn.RemoveAccountFromOrganizationWithContext(ctx, &organizations.RemoveAccountFromOrganizationInput{
+		_, err := conn.RemoveAccountFromOrganizationWithContext(ctx, &organizations.RemoveAccountFromOrganizationInput{
 			AccountId: aws.String(d.Id()),
 		})
+
+		if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "removing AWS Organizations Account (%s) from organization: %s", d.Id(), err)
+		}
+
+		return diags
 	}
 
-	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
+	log.Printf("[DEBUG] Closing AWS Organizations Account: %s", d.Id())
+	_, err := conn.CloseAccountWithContext(ctx, &organizations.CloseAccountInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException, organizations.ErrCodeAccountAlreadyClosedException) {
 		return diags
 	}
 
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeConstraintViolationException) {
+		return sdkdiag.AppendErrorf(diags, "closing AWS Organizations Account (%s): %s. "+
+			"Organizations limits the number of member accounts that can be closed in a rolling 30-day period; "+
+			"the account may need to be closed manually or once the quota has reset", d.Id(), err)
+	}
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting AWS Organizations Account (%s): %s", d.Id(), err)
+		return sdkdiag.AppendErrorf(diags, "closing AWS Organizations Account (%s): %s", d.Id(), err)
 	}
 
-	if close {
-		if _, err := waitAccountDeleted(ctx, conn, d.Id()); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) delete: %s", d.Id(), err)
-		}
+	if _, err := waitAccountClosed(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) close: %s", d.Id(), err)
 	}
 
-	return diags
+	return sdkdiag.AppendWarningf(diags, "AWS Organizations Account (%s) has been closed and is SUSPENDED. "+
+		"The account remains in the organization for up to 90 days before it is permanently closed "+
+		"and can be reopened through AWS Support during that period", d.Id())
 }
 
 func createAccount(ctx context.Context, conn *organizations.Organizations, name, email string, iamUserAccessToBilling, roleName *string, tags []*organizations.Tag, govCloud bool) (*organizations.CreateAccountStatus, error) {
@@ -421,7 +449,7 @@ func waitAccountCreated(ctx context.Context, conn *organizations.Organizations,
 
 func statusAccountStatus(ctx context.Context, conn *organizations.Organizations, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindAccountByID(ctx, conn, id)
+		output, err := findAccountByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -435,13 +463,15 @@ func statusAccountStatus(ctx context.Context, conn *organizations.Organizations,
 	}
 }
 
-func waitAccountDeleted(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.Account, error) {
+// waitAccountClosed waits for a closed account to be SUSPENDED.
+// A closed account is SUSPENDED for 90 days before AWS permanently closes it.
+func waitAccountClosed(ctx context.Context, conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.Account, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      []string{organizations.AccountStatusPendingClosure, organizations.AccountStatusActive},
-		Target:       []string{},
+		Target:       []string{organizations.AccountStatusSuspended},
 		Refresh:      statusAccountStatus(ctx, conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -452,3 +482,18 @@ func waitAccountDeleted(ctx context.Context, conn *organizations.Organizations,
 
 	return nil, err
 }
+
+const (
+	accountStateActive = "ACTIVE"
+	accountStateClosed = "CLOSED"
+)
+
+// accountStateFromStatus maps an Organizations account status to the account's lifecycle state.
+func accountStateFromStatus(status string) string {
+	switch status {
+	case organizations.AccountStatusPendingClosure, organizations.AccountStatusSuspended:
+		return accountStateClosed
+	default:
+		return accountStateActive
+	}
+}
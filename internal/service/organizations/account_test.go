@@ -7,9 +7,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -63,6 +66,7 @@ func testAccAccount_basic(t *testing.T) {
 					acctest.CheckResourceAttrRFC3339(resourceName, "joined_timestamp"),
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttrSet(resourceName, "parent_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
 					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
@@ -103,11 +107,25 @@ func testAccAccount_CloseOnDeletion(t *testing.T) {
 					acctest.CheckResourceAttrRFC3339(resourceName, "joined_timestamp"),
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttrSet(resourceName, "parent_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
 					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
 			testAccAccountImportStep(resourceName),
+			{
+				Config: testAccAccountConfig_closeOnDeletion(name, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountClosedWithWarning(ctx, resourceName),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "CLOSED"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUSPENDED"),
+				),
+			},
 		},
 	})
 }
@@ -261,6 +279,34 @@ func testAccCheckAccountDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckAccountClosedWithWarning deletes the account, which must have close_on_deletion set,
+// and checks that a warning that the account is SUSPENDED is returned.
+func testAccCheckAccountClosedWithWarning(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		r := tforganizations.ResourceAccount()
+		d := r.Data(&terraformsdk.InstanceState{ID: rs.Primary.ID, Attributes: rs.Primary.Attributes})
+
+		diags := r.DeleteWithoutTimeout(ctx, d, acctest.Provider.Meta()) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+
+		if diags.HasError() {
+			return fmt.Errorf("closing AWS Organizations Account (%s): %v", rs.Primary.ID, diags)
+		}
+
+		for _, v := range diags {
+			if v.Severity == diag.Warning && strings.Contains(v.Summary, "SUSPENDED") {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("closing AWS Organizations Account (%s): no SUSPENDED warning in %v", rs.Primary.ID, diags)
+	}
+}
+
 func testAccCheckAccountExists(ctx context.Context, n string, v *organizations.Account) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
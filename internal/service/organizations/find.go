@@ -14,6 +14,23 @@ import (
 )
 
 func FindAccountByID(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.Account, error) {
+	output, err := findAccountByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(output.Status); status == organizations.AccountStatusSuspended {
+		return nil, &retry.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return output, nil
+}
+
+// findAccountByID returns the account in any status, including SUSPENDED.
+func findAccountByID(ctx context.Context, conn *organizations.Organizations, id string) (*organizations.Account, error) {
 	input := &organizations.DescribeAccountInput{
 		AccountId: aws.String(id),
 	}
@@ -35,13 +52,6 @@ func FindAccountByID(ctx context.Context, conn *organizations.Organizations, id
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	if status := aws.StringValue(output.Account.Status); status == organizations.AccountStatusSuspended {
-		return nil, &retry.NotFoundError{
-			Message:     status,
-			LastRequest: input,
-		}
-	}
-
 	return output.Account, nil
 }
 
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography
+
+// Exports for use in tests only.
+var (
+	ResourceKey      = resourceKey
+	ResourceKeyAlias = resourceKeyAlias
+
+	FindKeyAliasByName = findKeyAliasByName
+	FindKeyByID        = findKeyByID
+)
@@ -0,0 +1,461 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/paymentcryptography"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/paymentcryptography/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_paymentcryptography_key", name="Key")
+// @Tags(identifierAttribute="id")
+func resourceKey() *schema.Resource {
+	modeOfUseSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyCreate,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyUpdate,
+		DeleteWithoutTimeout: resourceKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(3, 180),
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exportable": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_attributes": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_algorithm": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.KeyAlgorithm](),
+						},
+						"key_class": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.KeyClass](),
+						},
+						"key_modes_of_use": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"decrypt":         modeOfUseSchema(),
+									"derive_key":      modeOfUseSchema(),
+									"encrypt":         modeOfUseSchema(),
+									"generate":        modeOfUseSchema(),
+									"no_restrictions": modeOfUseSchema(),
+									"sign":            modeOfUseSchema(),
+									"unwrap":          modeOfUseSchema(),
+									"verify":          modeOfUseSchema(),
+									"wrap":            modeOfUseSchema(),
+								},
+							},
+						},
+						"key_usage": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.KeyUsage](),
+						},
+					},
+				},
+			},
+			"key_check_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_check_value_algorithm": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.KeyCheckValueAlgorithm](),
+			},
+			"key_origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+	input := &paymentcryptography.CreateKeyInput{
+		Enabled:    aws.Bool(d.Get(names.AttrEnabled).(bool)),
+		Exportable: aws.Bool(d.Get("exportable").(bool)),
+		Tags:       getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("key_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.KeyAttributes = expandKeyAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("key_check_value_algorithm"); ok {
+		input.KeyCheckValueAlgorithm = awstypes.KeyCheckValueAlgorithm(v.(string))
+	}
+
+	output, err := conn.CreateKey(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Payment Cryptography Key: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.Key.KeyArn))
+
+	if _, err := waitKeyCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Payment Cryptography Key (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+	key, err := findKeyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Payment Cryptography Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Payment Cryptography Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, key.KeyArn)
+	d.Set(names.AttrEnabled, key.Enabled)
+	d.Set("exportable", key.Exportable)
+	if key.KeyAttributes != nil {
+		if err := d.Set("key_attributes", []interface{}{flattenKeyAttributes(key.KeyAttributes)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting key_attributes: %s", err)
+		}
+	} else {
+		d.Set("key_attributes", nil)
+	}
+	d.Set("key_check_value", key.KeyCheckValue)
+	d.Set("key_check_value_algorithm", key.KeyCheckValueAlgorithm)
+	d.Set("key_origin", key.KeyOrigin)
+	d.Set("key_state", key.KeyState)
+
+	return diags
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+	if d.HasChange(names.AttrEnabled) {
+		if d.Get(names.AttrEnabled).(bool) {
+			_, err := conn.StartKeyUsage(ctx, &paymentcryptography.StartKeyUsageInput{
+				KeyIdentifier: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "enabling Payment Cryptography Key (%s): %s", d.Id(), err)
+			}
+		} else {
+			_, err := conn.StopKeyUsage(ctx, &paymentcryptography.StopKeyUsageInput{
+				KeyIdentifier: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disabling Payment Cryptography Key (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Payment Cryptography Key: %s", d.Id())
+	_, err := conn.DeleteKey(ctx, &paymentcryptography.DeleteKeyInput{
+		DeleteKeyInDays: aws.Int32(int32(d.Get("deletion_window_in_days").(int))),
+		KeyIdentifier:   aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Payment Cryptography Key (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitKeyDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Payment Cryptography Key (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findKeyByID(ctx context.Context, conn *paymentcryptography.Client, id string) (*awstypes.Key, error) {
+	input := &paymentcryptography.GetKeyInput{
+		KeyIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetKey(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Key == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Keys scheduled for deletion can still be described until the waiting period ends.
+	if state := output.Key.KeyState; state == awstypes.KeyStateDeletePending || state == awstypes.KeyStateDeleteComplete {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output.Key, nil
+}
+
+func statusKey(ctx context.Context, conn *paymentcryptography.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findKeyByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.KeyState), nil
+	}
+}
+
+func waitKeyCreated(ctx context.Context, conn *paymentcryptography.Client, id string, timeout time.Duration) (*awstypes.Key, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.KeyStateCreateInProgress),
+		Target:  enum.Slice(awstypes.KeyStateCreateComplete),
+		Refresh: statusKey(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Key); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKeyDeleted(ctx context.Context, conn *paymentcryptography.Client, id string, timeout time.Duration) (*awstypes.Key, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.KeyStateCreateComplete),
+		Target:  []string{},
+		Refresh: statusKey(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Key); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandKeyAttributes(tfMap map[string]interface{}) *awstypes.KeyAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.KeyAttributes{}
+
+	if v, ok := tfMap["key_algorithm"].(string); ok && v != "" {
+		apiObject.KeyAlgorithm = awstypes.KeyAlgorithm(v)
+	}
+
+	if v, ok := tfMap["key_class"].(string); ok && v != "" {
+		apiObject.KeyClass = awstypes.KeyClass(v)
+	}
+
+	if v, ok := tfMap["key_modes_of_use"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KeyModesOfUse = expandKeyModesOfUse(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["key_usage"].(string); ok && v != "" {
+		apiObject.KeyUsage = awstypes.KeyUsage(v)
+	}
+
+	return apiObject
+}
+
+func expandKeyModesOfUse(tfMap map[string]interface{}) *awstypes.KeyModesOfUse {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.KeyModesOfUse{}
+
+	if v, ok := tfMap["decrypt"].(bool); ok {
+		apiObject.Decrypt = v
+	}
+
+	if v, ok := tfMap["derive_key"].(bool); ok {
+		apiObject.DeriveKey = v
+	}
+
+	if v, ok := tfMap["encrypt"].(bool); ok {
+		apiObject.Encrypt = v
+	}
+
+	if v, ok := tfMap["generate"].(bool); ok {
+		apiObject.Generate = v
+	}
+
+	if v, ok := tfMap["no_restrictions"].(bool); ok {
+		apiObject.NoRestrictions = v
+	}
+
+	if v, ok := tfMap["sign"].(bool); ok {
+		apiObject.Sign = v
+	}
+
+	if v, ok := tfMap["unwrap"].(bool); ok {
+		apiObject.Unwrap = v
+	}
+
+	if v, ok := tfMap["verify"].(bool); ok {
+		apiObject.Verify = v
+	}
+
+	if v, ok := tfMap["wrap"].(bool); ok {
+		apiObject.Wrap = v
+	}
+
+	return apiObject
+}
+
+func flattenKeyAttributes(apiObject *awstypes.KeyAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"key_algorithm": apiObject.KeyAlgorithm,
+		"key_class":     apiObject.KeyClass,
+		"key_usage":     apiObject.KeyUsage,
+	}
+
+	if v := apiObject.KeyModesOfUse; v != nil {
+		tfMap["key_modes_of_use"] = []interface{}{flattenKeyModesOfUse(v)}
+	}
+
+	return tfMap
+}
+
+func flattenKeyModesOfUse(apiObject *awstypes.KeyModesOfUse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"decrypt":         apiObject.Decrypt,
+		"derive_key":      apiObject.DeriveKey,
+		"encrypt":         apiObject.Encrypt,
+		"generate":        apiObject.Generate,
+		"no_restrictions": apiObject.NoRestrictions,
+		"sign":            apiObject.Sign,
+		"unwrap":          apiObject.Unwrap,
+		"verify":          apiObject.Verify,
+		"wrap":            apiObject.Wrap,
+	}
+
+	return tfMap
+}
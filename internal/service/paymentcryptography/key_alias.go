@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/paymentcryptography"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/paymentcryptography/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_paymentcryptography_key_alias", name="Key Alias")
+func resourceKeyAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyAliasCreate,
+		ReadWithoutTimeout:   resourceKeyAliasRead,
+		UpdateWithoutTimeout: resourceKeyAliasUpdate,
+		DeleteWithoutTimeout: resourceKeyAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(7, 256),
+					validation.StringMatch(regexache.MustCompile(`^alias/[0-9A-Za-z_/-]+$`), "must begin with alias/ and contain only letters, numbers, underscores, slashes and hyphens"),
+				),
+			},
+			"key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceKeyAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+	name := d.Get("alias_name").(string)
+	input := &paymentcryptography.CreateAliasInput{
+		AliasName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("key_arn"); ok {
+		input.KeyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAlias(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Payment Cryptography Key Alias (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Alias.AliasName))
+
+	return append(diags, resourceKeyAliasRead(ctx, d, meta)...)
+}
+
+func resourceKeyAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+	alias, err := findKeyAliasByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Payment Cryptography Key Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Payment Cryptography Key Alias (%s): %s", d.Id(), err)
+	}
+
+	d.Set("alias_name", alias.AliasName)
+	d.Set("key_arn", alias.KeyArn)
+
+	return diags
+}
+
+func resourceKeyAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+	input := &paymentcryptography.UpdateAliasInput{
+		AliasName: aws.String(d.Id()),
+	}
+
+	// Omitting the key ARN disassociates the alias from its key.
+	if v, ok := d.GetOk("key_arn"); ok {
+		input.KeyArn = aws.String(v.(string))
+	}
+
+	_, err := conn.UpdateAlias(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Payment Cryptography Key Alias (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceKeyAliasRead(ctx, d, meta)...)
+}
+
+func resourceKeyAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Payment Cryptography Key Alias: %s", d.Id())
+	_, err := conn.DeleteAlias(ctx, &paymentcryptography.DeleteAliasInput{
+		AliasName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Payment Cryptography Key Alias (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findKeyAliasByName(ctx context.Context, conn *paymentcryptography.Client, name string) (*awstypes.Alias, error) {
+	input := &paymentcryptography.GetAliasInput{
+		AliasName: aws.String(name),
+	}
+
+	output, err := conn.GetAlias(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Alias == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Alias, nil
+}
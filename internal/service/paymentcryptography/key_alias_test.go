@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpaymentcryptography "github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPaymentCryptographyKeyAlias_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_paymentcryptography_key_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyAliasConfig_basic(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyAliasExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias_name", "alias/"+rName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_paymentcryptography_key.test1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKeyAliasConfig_basic(rName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyAliasExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_paymentcryptography_key.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPaymentCryptographyKeyAlias_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_paymentcryptography_key_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyAliasConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyAliasExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpaymentcryptography.ResourceKeyAlias(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKeyAliasDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_paymentcryptography_key_alias" {
+				continue
+			}
+
+			_, err := tfpaymentcryptography.FindKeyAliasByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Payment Cryptography Key Alias %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyAliasExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyClient(ctx)
+
+		_, err := tfpaymentcryptography.FindKeyAliasByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccKeyAliasConfig_basic(rName, keyName string) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key" "test1" {
+  exportable = true
+
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_P0_PIN_ENCRYPTION_KEY"
+
+    key_modes_of_use {
+      decrypt = true
+      encrypt = true
+      unwrap  = true
+      wrap    = true
+    }
+  }
+}
+
+resource "aws_paymentcryptography_key" "test2" {
+  exportable = true
+
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_P0_PIN_ENCRYPTION_KEY"
+
+    key_modes_of_use {
+      decrypt = true
+      encrypt = true
+      unwrap  = true
+      wrap    = true
+    }
+  }
+}
+
+resource "aws_paymentcryptography_key_alias" "test" {
+  alias_name = "alias/%[1]s"
+  key_arn    = aws_paymentcryptography_key.%[2]s.arn
+}
+`, rName, keyName)
+}
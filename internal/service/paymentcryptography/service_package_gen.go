@@ -27,7 +27,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceKey,
+			TypeName: "aws_paymentcryptography_key",
+			Name:     "Key",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  resourceKeyAlias,
+			TypeName: "aws_paymentcryptography_key_alias",
+			Name:     "Key Alias",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
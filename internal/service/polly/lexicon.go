@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package polly
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"log"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/polly/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_polly_lexicon", name="Lexicon")
+func resourceLexicon() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLexiconPut,
+		ReadWithoutTimeout:   resourceLexiconRead,
+		UpdateWithoutTimeout: resourceLexiconPut,
+		DeleteWithoutTimeout: resourceLexiconDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alphabet": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressEquivalentLexiconDiffs,
+			},
+			"language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lexemes_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 20),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z]+$`), "must contain only alphanumeric characters"),
+				),
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLexiconPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PollyClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &polly.PutLexiconInput{
+		Content: aws.String(d.Get("content").(string)),
+		Name:    aws.String(name),
+	}
+
+	_, err := conn.PutLexicon(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Polly Lexicon (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return append(diags, resourceLexiconRead(ctx, d, meta)...)
+}
+
+func resourceLexiconRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PollyClient(ctx)
+
+	output, err := findLexiconByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Polly Lexicon (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Polly Lexicon (%s): %s", d.Id(), err)
+	}
+
+	d.Set("content", output.Lexicon.Content)
+	d.Set("name", output.Lexicon.Name)
+	if attributes := output.LexiconAttributes; attributes != nil {
+		d.Set("alphabet", attributes.Alphabet)
+		d.Set("arn", attributes.LexiconArn)
+		d.Set("language_code", attributes.LanguageCode)
+		d.Set("lexemes_count", attributes.LexemesCount)
+		d.Set("size", attributes.Size)
+	}
+
+	return diags
+}
+
+func resourceLexiconDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PollyClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Polly Lexicon: %s", d.Id())
+	_, err := conn.DeleteLexicon(ctx, &polly.DeleteLexiconInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.LexiconNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Polly Lexicon (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findLexiconByName(ctx context.Context, conn *polly.Client, name string) (*polly.GetLexiconOutput, error) {
+	input := &polly.GetLexiconInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetLexicon(ctx, input)
+
+	if errs.IsA[*awstypes.LexiconNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Lexicon == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// suppressEquivalentLexiconDiffs suppresses differences between two Pronunciation
+// Lexicon Specification (PLS) documents that differ only in formatting, e.g.
+// indentation, attribute order, comments or the XML declaration.
+func suppressEquivalentLexiconDiffs(k, old, new string, d *schema.ResourceData) bool {
+	equivalent, err := lexiconsEquivalent(old, new)
+
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+func lexiconsEquivalent(s1, s2 string) (bool, error) {
+	t1, err := normalizeLexicon(s1)
+	if err != nil {
+		return false, err
+	}
+
+	t2, err := normalizeLexicon(s2)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(t1, t2), nil
+}
+
+// normalizeLexicon returns the significant XML tokens of a lexicon document.
+func normalizeLexicon(s string) ([]xml.Token, error) {
+	var tokens []xml.Token
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch v := token.(type) {
+		case xml.StartElement:
+			attrs := slices.Clone(v.Attr)
+			slices.SortFunc(attrs, func(a, b xml.Attr) int {
+				if c := strings.Compare(a.Name.Space, b.Name.Space); c != 0 {
+					return c
+				}
+				return strings.Compare(a.Name.Local, b.Name.Local)
+			})
+			tokens = append(tokens, xml.StartElement{Name: v.Name, Attr: attrs})
+		case xml.EndElement:
+			tokens = append(tokens, v)
+		case xml.CharData:
+			if text := strings.TrimSpace(string(v)); text != "" {
+				tokens = append(tokens, xml.CharData(text))
+			}
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, errors.New("empty lexicon document")
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package polly_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolly "github.com/hashicorp/terraform-provider-aws/internal/service/polly"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestLexiconsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s1, s2   string
+		expected bool
+		wantErr  bool
+	}{
+		"identical": {
+			s1:       `<lexicon version="1.0" alphabet="ipa" xml:lang="en-US"><lexeme><grapheme>W3C</grapheme><alias>World Wide Web Consortium</alias></lexeme></lexicon>`,
+			s2:       `<lexicon version="1.0" alphabet="ipa" xml:lang="en-US"><lexeme><grapheme>W3C</grapheme><alias>World Wide Web Consortium</alias></lexeme></lexicon>`,
+			expected: true,
+		},
+		"whitespace and declaration": {
+			s1: `<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0" alphabet="ipa" xml:lang="en-US">
+  <!-- acronyms -->
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <alias>World Wide Web Consortium</alias>
+  </lexeme>
+</lexicon>`,
+			s2:       `<lexicon version="1.0" alphabet="ipa" xml:lang="en-US"><lexeme><grapheme>W3C</grapheme><alias>World Wide Web Consortium</alias></lexeme></lexicon>`,
+			expected: true,
+		},
+		"attribute order": {
+			s1:       `<lexicon version="1.0" alphabet="ipa" xml:lang="en-US"><lexeme><grapheme>W3C</grapheme></lexeme></lexicon>`,
+			s2:       `<lexicon xml:lang="en-US" alphabet="ipa" version="1.0"><lexeme><grapheme>W3C</grapheme></lexeme></lexicon>`,
+			expected: true,
+		},
+		"different alias": {
+			s1:       `<lexicon version="1.0" alphabet="ipa" xml:lang="en-US"><lexeme><grapheme>W3C</grapheme><alias>World Wide Web Consortium</alias></lexeme></lexicon>`,
+			s2:       `<lexicon version="1.0" alphabet="ipa" xml:lang="en-US"><lexeme><grapheme>W3C</grapheme><alias>W3 Consortium</alias></lexeme></lexicon>`,
+			expected: false,
+		},
+		"different attribute value": {
+			s1:       `<lexicon version="1.0" alphabet="ipa" xml:lang="en-US"></lexicon>`,
+			s2:       `<lexicon version="1.0" alphabet="x-sampa" xml:lang="en-US"></lexicon>`,
+			expected: false,
+		},
+		"invalid XML": {
+			s1:      `<lexicon version="1.0">`,
+			s2:      `<lexicon version="1.0"></lexicon>`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfpolly.LexiconsEquivalent(testCase.s1, testCase.s2)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("LexiconsEquivalent() err %t, want %t: %s", got, want, err)
+			}
+
+			if err == nil && got != testCase.expected {
+				t.Errorf("LexiconsEquivalent() = %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccPollyLexicon_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v polly.GetLexiconOutput
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "ipa"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "polly", "lexicon/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v polly.GetLexiconOutput
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpolly.ResourceLexicon(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v polly.GetLexiconOutput
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+				),
+			},
+			{
+				// Reformatted content must not produce a diff.
+				Config:   testAccLexiconConfig_reformatted(rName, "World Wide Web Consortium"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3 Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLexiconDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_polly_lexicon" {
+				continue
+			}
+
+			_, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Polly Lexicon %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLexiconExists(ctx context.Context, n string, v *polly.GetLexiconOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		output, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLexiconConfig_basic(rName, alias string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name    = %[1]q
+  content = <<-EOT
+<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US">
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <alias>%[2]s</alias>
+  </lexeme>
+</lexicon>
+EOT
+}
+`, rName, alias)
+}
+
+func testAccLexiconConfig_reformatted(rName, alias string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name    = %[1]q
+  content = <<-EOT
+<lexicon xml:lang="en-US" alphabet="ipa" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" version="1.0">
+<lexeme><grapheme>W3C</grapheme><alias>%[2]s</alias></lexeme>
+</lexicon>
+EOT
+}
+`, rName, alias)
+}
@@ -32,7 +32,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceLexicon,
+			TypeName: "aws_polly_lexicon",
+			Name:     "Lexicon",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	serviceCodeAmazonEC2 = "AmazonEC2"
+)
+
+// @SDKDataSource("aws_pricing_product_ondemand")
+func dataSourceProductOnDemand() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProductOnDemandRead,
+
+		Schema: map[string]*schema.Schema{
+			"capacity_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Used",
+			},
+			"currency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hourly_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"license_model": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "No License required",
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Linux",
+			},
+			"pre_installed_software": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "NA",
+			},
+			"price_per_unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"sku": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenancy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Shared",
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceProductOnDemandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PricingClient(ctx)
+
+	region := meta.(*conns.AWSClient).Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	input := &pricing.GetProductsInput{
+		Filters: []types.Filter{
+			termMatchFilter("capacitystatus", d.Get("capacity_status").(string)),
+			termMatchFilter("instanceType", d.Get("instance_type").(string)),
+			termMatchFilter("licenseModel", d.Get("license_model").(string)),
+			termMatchFilter("operatingSystem", d.Get("operating_system").(string)),
+			termMatchFilter("preInstalledSw", d.Get("pre_installed_software").(string)),
+			termMatchFilter("regionCode", region),
+			termMatchFilter("tenancy", d.Get("tenancy").(string)),
+		},
+		ServiceCode: aws.String(serviceCodeAmazonEC2),
+	}
+
+	output, err := conn.GetProducts(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Pricing Products: %s", err)
+	}
+
+	if numberOfElements := len(output.PriceList); numberOfElements == 0 {
+		return sdkdiag.AppendErrorf(diags, "Pricing on-demand product query did not return any elements")
+	} else if numberOfElements > 1 {
+		return sdkdiag.AppendErrorf(diags, "Pricing on-demand product query not precise enough. Returned %d elements", numberOfElements)
+	}
+
+	price, err := onDemandPriceFromPriceList(output.PriceList[0])
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Pricing on-demand product (%s): %s", d.Get("instance_type").(string), err)
+	}
+
+	hourlyPrice, err := strconv.ParseFloat(price.pricePerUnit, 64)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing Pricing on-demand product (%s) price (%s): %s", price.sku, price.pricePerUnit, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", price.sku, region))
+	d.Set("currency", price.currency)
+	d.Set("description", price.description)
+	d.Set("hourly_price", hourlyPrice)
+	d.Set("price_per_unit", price.pricePerUnit)
+	d.Set("region", region)
+	d.Set("sku", price.sku)
+	d.Set("unit", price.unit)
+
+	return diags
+}
+
+func termMatchFilter(field, value string) types.Filter {
+	return types.Filter{
+		Field: aws.String(field),
+		Type:  types.FilterTypeTermMatch,
+		Value: aws.String(value),
+	}
+}
+
+// onDemandPrice is the single on-demand price dimension of a Pricing API product.
+type onDemandPrice struct {
+	currency     string
+	description  string
+	pricePerUnit string
+	sku          string
+	unit         string
+}
+
+// priceListItem is the subset of a Pricing API price list item's JSON representation used to resolve on-demand prices.
+type priceListItem struct {
+	Product struct {
+		SKU string `json:"sku"`
+	} `json:"product"`
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				Description  string            `json:"description"`
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+				Unit         string            `json:"unit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// onDemandPriceFromPriceList returns the USD on-demand price from a Pricing API price list item.
+// The item must contain exactly one on-demand term with exactly one price dimension.
+func onDemandPriceFromPriceList(priceList string) (*onDemandPrice, error) {
+	const (
+		currencyUSD = "USD"
+	)
+
+	var item priceListItem
+
+	if err := json.Unmarshal([]byte(priceList), &item); err != nil {
+		return nil, fmt.Errorf("parsing price list: %w", err)
+	}
+
+	if n := len(item.Terms.OnDemand); n != 1 {
+		return nil, fmt.Errorf("expected 1 on-demand term, got %d", n)
+	}
+
+	for _, term := range item.Terms.OnDemand {
+		if n := len(term.PriceDimensions); n != 1 {
+			return nil, fmt.Errorf("expected 1 on-demand price dimension, got %d", n)
+		}
+
+		for _, dimension := range term.PriceDimensions {
+			v, ok := dimension.PricePerUnit[currencyUSD]
+			if !ok {
+				return nil, fmt.Errorf("no %s price per unit", currencyUSD)
+			}
+
+			return &onDemandPrice{
+				currency:     currencyUSD,
+				description:  dimension.Description,
+				pricePerUnit: v,
+				sku:          item.Product.SKU,
+				unit:         dimension.Unit,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
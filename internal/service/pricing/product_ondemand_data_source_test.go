@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPricingProductOnDemandDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_pricing_product_ondemand.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID, endpoints.ApSouth1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PricingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductOnDemandDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "currency", "USD"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "description"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hourly_price"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "price_per_unit"),
+					resource.TestCheckResourceAttr(dataSourceName, "region", endpoints.UsWest2RegionID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sku"),
+					resource.TestCheckResourceAttr(dataSourceName, "unit", "Hrs"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProductOnDemandDataSourceConfig_basic = `
+data "aws_pricing_product_ondemand" "test" {
+  instance_type = "t3.micro"
+  region        = "us-west-2"
+}
+`
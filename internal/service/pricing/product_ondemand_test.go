@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing
+
+import (
+	"testing"
+)
+
+func TestOnDemandPriceFromPriceList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		priceList           string
+		expectedErr         bool
+		expectedPrice       string
+		expectedSKU         string
+		expectedUnit        string
+		expectedDescription string
+	}{
+		"valid": {
+			priceList: `{
+  "product": {"sku": "ABCDEFGHIJKLMNOP", "productFamily": "Compute Instance"},
+  "terms": {
+    "OnDemand": {
+      "ABCDEFGHIJKLMNOP.JRTCKXETXF": {
+        "priceDimensions": {
+          "ABCDEFGHIJKLMNOP.JRTCKXETXF.6YS6EN2CT7": {
+            "unit": "Hrs",
+            "pricePerUnit": {"USD": "0.0104000000"},
+            "description": "$0.0104 per On Demand Linux t3.micro Instance Hour"
+          }
+        }
+      }
+    }
+  }
+}`,
+			expectedPrice:       "0.0104000000",
+			expectedSKU:         "ABCDEFGHIJKLMNOP",
+			expectedUnit:        "Hrs",
+			expectedDescription: "$0.0104 per On Demand Linux t3.micro Instance Hour",
+		},
+		"no on-demand terms": {
+			priceList:   `{"product": {"sku": "ABCDEFGHIJKLMNOP"}, "terms": {"Reserved": {}}}`,
+			expectedErr: true,
+		},
+		"multiple price dimensions": {
+			priceList: `{
+  "product": {"sku": "ABCDEFGHIJKLMNOP"},
+  "terms": {
+    "OnDemand": {
+      "ABCDEFGHIJKLMNOP.JRTCKXETXF": {
+        "priceDimensions": {
+          "a": {"unit": "Hrs", "pricePerUnit": {"USD": "1"}},
+          "b": {"unit": "Hrs", "pricePerUnit": {"USD": "2"}}
+        }
+      }
+    }
+  }
+}`,
+			expectedErr: true,
+		},
+		"no USD price": {
+			priceList: `{
+  "product": {"sku": "ABCDEFGHIJKLMNOP"},
+  "terms": {
+    "OnDemand": {
+      "ABCDEFGHIJKLMNOP.JRTCKXETXF": {
+        "priceDimensions": {
+          "a": {"unit": "Hrs", "pricePerUnit": {"CNY": "1"}}
+        }
+      }
+    }
+  }
+}`,
+			expectedErr: true,
+		},
+		"invalid JSON": {
+			priceList:   `{`,
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := onDemandPriceFromPriceList(testCase.priceList)
+
+			if testCase.expectedErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.pricePerUnit != testCase.expectedPrice {
+				t.Errorf("pricePerUnit = %s, want %s", got.pricePerUnit, testCase.expectedPrice)
+			}
+			if got.sku != testCase.expectedSKU {
+				t.Errorf("sku = %s, want %s", got.sku, testCase.expectedSKU)
+			}
+			if got.unit != testCase.expectedUnit {
+				t.Errorf("unit = %s, want %s", got.unit, testCase.expectedUnit)
+			}
+			if got.description != testCase.expectedDescription {
+				t.Errorf("description = %s, want %s", got.description, testCase.expectedDescription)
+			}
+		})
+	}
+}
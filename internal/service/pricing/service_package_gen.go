@@ -28,6 +28,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceProduct,
 			TypeName: "aws_pricing_product",
 		},
+		{
+			Factory:  dataSourceProductOnDemand,
+			TypeName: "aws_pricing_product_ondemand",
+		},
 	}
 }
 
@@ -5,9 +5,11 @@ package qldb
 
 // Exports for use in tests only.
 var (
-	FindLedgerByName       = findLedgerByName
-	FindStreamByTwoPartKey = findStreamByTwoPartKey
+	FindJournalS3ExportByTwoPartKey = findJournalS3ExportByTwoPartKey
+	FindLedgerByName                = findLedgerByName
+	FindStreamByTwoPartKey          = findStreamByTwoPartKey
 
-	ResourceLedger = resourceLedger
-	ResourceStream = resourceStream
+	ResourceJournalS3Export = resourceJournalS3Export
+	ResourceLedger          = resourceLedger
+	ResourceStream          = resourceStream
 )
@@ -33,6 +33,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceJournalS3Export,
+			TypeName: "aws_qldb_journal_s3_export",
+			Name:     "Journal S3 Export",
+		},
 		{
 			Factory:  resourceLedger,
 			TypeName: "aws_qldb_ledger",
@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ram_permission", name="Permission")
+// @Tags
+func resourcePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionCreate,
+		ReadWithoutTimeout:   resourcePermissionRead,
+		UpdateWithoutTimeout: resourcePermissionUpdate,
+		DeleteWithoutTimeout: resourcePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 36),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"permission_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_template": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	policyTemplate, err := structure.NormalizeJsonString(d.Get("policy_template").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name := d.Get("name").(string)
+	input := &ram.CreatePermissionInput{
+		ClientToken:    aws.String(sdkid.UniqueId()),
+		Name:           aws.String(name),
+		PolicyTemplate: aws.String(policyTemplate),
+		ResourceType:   aws.String(d.Get("resource_type").(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	output, err := conn.CreatePermissionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Permission (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Permission.Arn))
+
+	return append(diags, resourcePermissionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	permission, err := findPermissionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", permission.Arn)
+	d.Set("name", permission.Name)
+	d.Set("permission_type", permission.PermissionType)
+	d.Set("resource_type", permission.ResourceType)
+	d.Set("status", permission.Status)
+	d.Set("version", flex.StringValueToInt64Value(aws.StringValue(permission.Version)))
+
+	// Policy templates are not complete IAM policies, so compare them as plain JSON.
+	if v := aws.StringValue(permission.Permission); !verify.JSONStringsEqual(d.Get("policy_template").(string), v) {
+		policyTemplate, err := structure.NormalizeJsonString(v)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("policy_template", policyTemplate)
+	}
+
+	setTagsOut(ctx, permission.Tags)
+
+	return diags
+}
+
+func resourcePermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	if d.HasChange("policy_template") {
+		policyTemplate, err := structure.NormalizeJsonString(d.Get("policy_template").(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// The new version automatically becomes the permission's default version.
+		// Resource shares that pin an older version continue to use it.
+		input := &ram.CreatePermissionVersionInput{
+			ClientToken:    aws.String(sdkid.UniqueId()),
+			PermissionArn:  aws.String(d.Id()),
+			PolicyTemplate: aws.String(policyTemplate),
+		}
+
+		_, err = conn.CreatePermissionVersionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RAM Permission (%s) version: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := permissionUpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RAM Permission (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePermissionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	log.Printf("[DEBUG] Deleting RAM Permission: %s", d.Id())
+	_, err := conn.DeletePermissionWithContext(ctx, &ram.DeletePermissionInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		PermissionArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPermissionByARN(ctx context.Context, conn *ram.RAM, arn string) (*ram.ResourceSharePermissionDetail, error) {
+	input := &ram.GetPermissionInput{
+		PermissionArn: aws.String(arn),
+	}
+
+	output, err := findPermission(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(output.Status); status == ram.PermissionStatusDeleted || status == ram.PermissionStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findPermission(ctx context.Context, conn *ram.RAM, input *ram.GetPermissionInput) (*ram.ResourceSharePermissionDetail, error) {
+	output, err := conn.GetPermissionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Permission == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Permission, nil
+}
+
+// permissionUpdateTags updates RAM permission tags.
+// The generated updateTags identifies resources by resource share ARN, whereas permissions are identified by resource ARN.
+func permissionUpdateTags(ctx context.Context, conn *ram.RAM, identifier string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreSystem(names.RAM); len(removedTags) > 0 {
+		input := &ram.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		if _, err := conn.UntagResourceWithContext(ctx, input); err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreSystem(names.RAM); len(updatedTags) > 0 {
+		input := &ram.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        Tags(updatedTags),
+		}
+
+		if _, err := conn.TagResourceWithContext(ctx, input); err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
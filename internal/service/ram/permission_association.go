@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ram_permission_association", name="Permission Association")
+func resourcePermissionAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionAssociationCreate,
+		ReadWithoutTimeout:   resourcePermissionAssociationRead,
+		UpdateWithoutTimeout: resourcePermissionAssociationUpdate,
+		DeleteWithoutTimeout: resourcePermissionAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"permission_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"permission_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"replace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	permissionAssociationResourceIDPartCount = 2
+)
+
+func resourcePermissionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	resourceShareARN, permissionARN := d.Get("resource_share_arn").(string), d.Get("permission_arn").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{resourceShareARN, permissionARN}, permissionAssociationResourceIDPartCount, false))
+	input := &ram.AssociateResourceSharePermissionInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		Replace:          aws.Bool(d.Get("replace").(bool)),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	if v, ok := d.GetOk("permission_version"); ok {
+		input.PermissionVersion = aws.Int64(int64(v.(int)))
+	}
+
+	_, err := conn.AssociateResourceSharePermissionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Permission Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitPermissionAssociationCreated(ctx, conn, resourceShareARN, permissionARN); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePermissionAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePermissionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), permissionAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	resourceShareARN, permissionARN := parts[0], parts[1]
+
+	permissionAssociation, err := findPermissionAssociationByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Permission Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("default_version", permissionAssociation.DefaultVersion)
+	d.Set("permission_arn", permissionAssociation.Arn)
+	d.Set("permission_version", flex.StringValueToInt64Value(aws.StringValue(permissionAssociation.PermissionVersion)))
+	d.Set("resource_share_arn", permissionAssociation.ResourceShareArn)
+	d.Set("resource_type", permissionAssociation.ResourceType)
+
+	return diags
+}
+
+func resourcePermissionAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	if d.HasChange("permission_version") {
+		resourceShareARN, permissionARN := d.Get("resource_share_arn").(string), d.Get("permission_arn").(string)
+		input := &ram.AssociateResourceSharePermissionInput{
+			ClientToken:       aws.String(sdkid.UniqueId()),
+			PermissionArn:     aws.String(permissionARN),
+			PermissionVersion: aws.Int64(int64(d.Get("permission_version").(int))),
+			Replace:           aws.Bool(true),
+			ResourceShareArn:  aws.String(resourceShareARN),
+		}
+
+		_, err := conn.AssociateResourceSharePermissionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RAM Permission Association (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPermissionAssociationCreated(ctx, conn, resourceShareARN, permissionARN); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePermissionAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePermissionAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), permissionAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	resourceShareARN, permissionARN := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting RAM Permission Association: %s", d.Id())
+	_, err = conn.DisassociateResourceSharePermissionWithContext(ctx, &ram.DisassociateResourceSharePermissionInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		ResourceShareArn: aws.String(resourceShareARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPermissionAssociationDeleted(ctx, conn, resourceShareARN, permissionARN); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPermissionAssociationByTwoPartKey(ctx context.Context, conn *ram.RAM, resourceShareARN, permissionARN string) (*ram.AssociatedPermission, error) {
+	input := &ram.ListPermissionAssociationsInput{
+		PermissionArn: aws.String(permissionARN),
+	}
+
+	output, err := findPermissionAssociations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var associations []*ram.AssociatedPermission
+	for _, v := range output {
+		if aws.StringValue(v.ResourceShareArn) == resourceShareARN {
+			associations = append(associations, v)
+		}
+	}
+
+	association, err := tfresource.AssertSinglePtrResult(associations)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(association.Status); status == ram.ResourceShareAssociationStatusDisassociated {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return association, nil
+}
+
+func findPermissionAssociations(ctx context.Context, conn *ram.RAM, input *ram.ListPermissionAssociationsInput) ([]*ram.AssociatedPermission, error) {
+	var output []*ram.AssociatedPermission
+
+	err := conn.ListPermissionAssociationsPagesWithContext(ctx, input, func(page *ram.ListPermissionAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusPermissionAssociation(ctx context.Context, conn *ram.RAM, resourceShareARN, permissionARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPermissionAssociationByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitPermissionAssociationCreated(ctx context.Context, conn *ram.RAM, resourceShareARN, permissionARN string) (*ram.AssociatedPermission, error) {
+	const (
+		timeout = 5 * time.Minute
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ram.ResourceShareAssociationStatusAssociating},
+		Target:  []string{ram.ResourceShareAssociationStatusAssociated},
+		Refresh: statusPermissionAssociation(ctx, conn, resourceShareARN, permissionARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ram.AssociatedPermission); ok {
+		if status := aws.StringValue(output.Status); status == ram.ResourceShareAssociationStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("permission association %s", status))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPermissionAssociationDeleted(ctx context.Context, conn *ram.RAM, resourceShareARN, permissionARN string) (*ram.AssociatedPermission, error) {
+	const (
+		timeout = 5 * time.Minute
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ram.ResourceShareAssociationStatusAssociated, ram.ResourceShareAssociationStatusDisassociating},
+		Target:  []string{},
+		Refresh: statusPermissionAssociation(ctx, conn, resourceShareARN, permissionARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ram.AssociatedPermission); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfram "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRAMPermissionAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permissionAssociation ram.AssociatedPermission
+	resourceName := "aws_ram_permission_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permissionAssociation),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_arn", "aws_ram_permission.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "permission_version", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_share_arn", "aws_ram_resource_share.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "ec2:Subnet"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace"},
+			},
+		},
+	})
+}
+
+func TestAccRAMPermissionAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permissionAssociation ram.AssociatedPermission
+	resourceName := "aws_ram_permission_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permissionAssociation),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfram.ResourcePermissionAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRAMPermissionAssociation_permissionVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permissionAssociation ram.AssociatedPermission
+	resourceName := "aws_ram_permission_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionAssociationConfig_permissionVersion(rName, "ec2:DescribeSubnets", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permissionAssociation),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "permission_version", "1"),
+				),
+			},
+			{
+				Config: testAccPermissionAssociationConfig_permissionVersion(rName, "ec2:DescribeSubnetAttribute", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permissionAssociation),
+					resource.TestCheckResourceAttr("aws_ram_permission.test", "version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+					resource.TestCheckResourceAttr(resourceName, "permission_version", "1"),
+				),
+			},
+			{
+				Config: testAccPermissionAssociationConfig_permissionVersion(rName, "ec2:DescribeSubnetAttribute", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permissionAssociation),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "permission_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionAssociationExists(ctx context.Context, n string, v *ram.AssociatedPermission) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
+
+		output, err := tfram.FindPermissionAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_share_arn"], rs.Primary.Attributes["permission_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ram_permission_association" {
+				continue
+			}
+
+			_, err := tfram.FindPermissionAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_share_arn"], rs.Primary.Attributes["permission_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RAM Permission Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPermissionAssociationConfig_base(rName, action string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+}
+
+resource "aws_ram_resource_association" "test" {
+  resource_arn       = aws_subnet.test[0].arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+resource "aws_ram_permission" "test" {
+  name          = %[1]q
+  resource_type = "ec2:Subnet"
+
+  policy_template = jsonencode({
+    Effect = "Allow"
+    Action = [%[2]q]
+  })
+}
+`, rName, action))
+}
+
+func testAccPermissionAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionAssociationConfig_base(rName, "ec2:DescribeSubnets"), `
+resource "aws_ram_permission_association" "test" {
+  permission_arn     = aws_ram_permission.test.arn
+  resource_share_arn = aws_ram_resource_association.test.resource_share_arn
+  replace            = true
+}
+`)
+}
+
+func testAccPermissionAssociationConfig_permissionVersion(rName, action string, version int) string {
+	return acctest.ConfigCompose(testAccPermissionAssociationConfig_base(rName, action), fmt.Sprintf(`
+resource "aws_ram_permission_association" "test" {
+  permission_arn     = aws_ram_permission.test.arn
+  permission_version = %[1]d
+  resource_share_arn = aws_ram_resource_association.test.resource_share_arn
+  replace            = true
+}
+`, version))
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_ram_permission", name="Permission")
+func dataSourcePermission() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePermissionRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_resource_type_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"permission_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ram.PermissionTypeFilterAll,
+				ValidateFunc: validation.StringInSlice(ram.PermissionTypeFilter_Values(), false),
+			},
+			"policy_template": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	input := &ram.ListPermissionsInput{
+		PermissionType: aws.String(d.Get("permission_type").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	name := d.Get("name").(string)
+	permissions, err := findPermissions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permissions: %s", err)
+	}
+
+	permissions = tfslices.Filter(permissions, func(v *ram.ResourceSharePermissionSummary) bool {
+		return aws.StringValue(v.Name) == name
+	})
+
+	summary, err := tfresource.AssertSinglePtrResult(permissions)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("RAM Permission", err))
+	}
+
+	arn := aws.StringValue(summary.Arn)
+	permission, err := findPermissionByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+	d.Set("arn", arn)
+	d.Set("is_resource_type_default", permission.IsResourceTypeDefault)
+	d.Set("name", permission.Name)
+	d.Set("policy_template", permission.Permission)
+	d.Set("resource_type", permission.ResourceType)
+	d.Set("status", permission.Status)
+	d.Set("version", flex.StringValueToInt64Value(aws.StringValue(permission.Version)))
+
+	return diags
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRAMPermissionDataSource_awsManaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ram_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionDataSourceConfig_awsManaged,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.MatchResourceAttrGlobalARNNoAccount(dataSourceName, "arn", "ram", regexache.MustCompile(`permission/AWSRAMDefaultPermissionSubnet$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "is_resource_type_default", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy_template"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", "ec2:Subnet"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRAMPermissionDataSource_customerManaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ram_permission.test"
+	dataSourceName := "data.aws_ram_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionDataSourceConfig_customerManaged(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_resource_type_default", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_type", resourceName, "resource_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPermissionDataSourceConfig_awsManaged = `
+data "aws_ram_permission" "test" {
+  name          = "AWSRAMDefaultPermissionSubnet"
+  resource_type = "ec2:Subnet"
+}
+`
+
+func testAccPermissionDataSourceConfig_customerManaged(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_basic(rName, "ec2:DescribeSubnets"), `
+data "aws_ram_permission" "test" {
+  name            = aws_ram_permission.test.name
+  permission_type = "CUSTOMER_MANAGED"
+}
+`)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// @SDKDataSource("aws_ram_permissions", name="Permissions")
+func dataSourcePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permission_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ram.PermissionTypeFilterAll,
+				ValidateFunc: validation.StringInSlice(ram.PermissionTypeFilter_Values(), false),
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	input := &ram.ListPermissionsInput{
+		PermissionType: aws.String(d.Get("permission_type").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	permissions, err := findPermissions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permissions: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", tfslices.ApplyToAll(permissions, func(v *ram.ResourceSharePermissionSummary) string {
+		return aws.StringValue(v.Arn)
+	}))
+	d.Set("names", tfslices.ApplyToAll(permissions, func(v *ram.ResourceSharePermissionSummary) string {
+		return aws.StringValue(v.Name)
+	}))
+
+	return diags
+}
+
+func findPermissions(ctx context.Context, conn *ram.RAM, input *ram.ListPermissionsInput) ([]*ram.ResourceSharePermissionSummary, error) {
+	var output []*ram.ResourceSharePermissionSummary
+
+	err := conn.ListPermissionsPagesWithContext(ctx, input, func(page *ram.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -25,6 +25,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourcePermission,
+			TypeName: "aws_ram_permission",
+			Name:     "Permission",
+		},
+		{
+			Factory:  dataSourcePermissions,
+			TypeName: "aws_ram_permissions",
+			Name:     "Permissions",
+		},
 		{
 			Factory:  dataSourceResourceShare,
 			TypeName: "aws_ram_resource_share",
@@ -36,6 +46,17 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourcePermission,
+			TypeName: "aws_ram_permission",
+			Name:     "Permission",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  resourcePermissionAssociation,
+			TypeName: "aws_ram_permission_association",
+			Name:     "Permission Association",
+		},
 		{
 			Factory:  resourcePrincipalAssociation,
 			TypeName: "aws_ram_principal_association",
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Collection")
+func newDataSourceCollection(_ context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceCollection{}, nil
+}
+
+const (
+	DSNameCollection = "Collection Data Source"
+)
+
+type dataSourceCollection struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCollection) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_rekognition_collection"
+}
+
+func (d *dataSourceCollection) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"collection_id": schema.StringAttribute{
+				Required: true,
+			},
+			"creation_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"face_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"face_model_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"user_count": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCollection) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().RekognitionClient(ctx)
+
+	var data dataSourceCollectionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID := data.CollectionID.ValueString()
+	out, err := findCollectionByID(ctx, conn, collectionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, DSNameCollection, collectionID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, out.CollectionARN)
+	data.ID = types.StringValue(collectionID)
+
+	tags, err := listTags(ctx, conn, data.ARN.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, DSNameCollection, collectionID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceCollectionData struct {
+	ARN               types.String      `tfsdk:"arn"`
+	CollectionID      types.String      `tfsdk:"collection_id"`
+	CreationTimestamp timetypes.RFC3339 `tfsdk:"creation_timestamp"`
+	FaceCount         types.Int64       `tfsdk:"face_count"`
+	FaceModelVersion  types.String      `tfsdk:"face_model_version"`
+	ID                types.String      `tfsdk:"id"`
+	Tags              types.Map         `tfsdk:"tags"`
+	UserCount         types.Int64       `tfsdk:"user_count"`
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Collections")
+func newDataSourceCollections(_ context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceCollections{}, nil
+}
+
+const (
+	DSNameCollections = "Collections Data Source"
+)
+
+type dataSourceCollections struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCollections) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_rekognition_collections"
+}
+
+func (d *dataSourceCollections) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"collection_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"face_model_versions": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCollections) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().RekognitionClient(ctx)
+
+	var data dataSourceCollectionsData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Collection IDs and face model versions are returned as parallel lists.
+	out := &rekognition.ListCollectionsOutput{}
+
+	pages := rekognition.NewListCollectionsPaginator(conn, &rekognition.ListCollectionsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, DSNameCollections, d.Meta().Region, err),
+				err.Error(),
+			)
+			return
+		}
+
+		out.CollectionIds = append(out.CollectionIds, page.CollectionIds...)
+		out.FaceModelVersions = append(out.FaceModelVersions, page.FaceModelVersions...)
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceCollectionsData struct {
+	CollectionIDs     fwtypes.ListValueOf[types.String] `tfsdk:"collection_ids"`
+	FaceModelVersions fwtypes.ListValueOf[types.String] `tfsdk:"face_model_versions"`
+	ID                types.String                      `tfsdk:"id"`
+}
@@ -6,11 +6,15 @@ package rekognition
 // Exports for use in tests only.
 
 var (
-	ResourceProject    = newResourceProject
-	ResourceCollection = newResourceCollection
+	ResourceProject         = newResourceProject
+	ResourceProjectVersion  = newResourceProjectVersion
+	ResourceCollection      = newResourceCollection
+	ResourceStreamProcessor = newResourceStreamProcessor
 )
 
 var (
-	FindCollectionByID = findCollectionByID
-	FindProjectByName  = findProjectByName
+	FindCollectionByID        = findCollectionByID
+	FindProjectByName         = findProjectByName
+	FindProjectVersionByID    = findProjectVersionByID
+	FindStreamProcessorByName = findStreamProcessorByName
 )
@@ -0,0 +1,571 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rekognition"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rekognition/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Project Version")
+// @Tags(identifierAttribute="arn")
+func newResourceProjectVersion(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceProjectVersion{}
+
+	// Training a custom labels model routinely takes several hours.
+	r.SetDefaultCreateTimeout(8 * time.Hour)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceProjectVersion struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+const (
+	ResNameProjectVersion = "Project Version"
+
+	projectVersionIDPartCount = 2
+)
+
+func (r *resourceProjectVersion) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_rekognition_project_version"
+}
+
+func (r *resourceProjectVersion) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	assetsBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[projectVersionAssetModel](ctx),
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"ground_truth_manifest": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[projectVersionGroundTruthManifestModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"s3_object": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[projectVersionS3ObjectModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"bucket": schema.StringAttribute{
+											Required: true,
+										},
+										"name": schema.StringAttribute{
+											Required: true,
+										},
+										"version": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"id":  framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProjectVersionStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"version_description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must conform to: ^[a-zA-Z0-9_.\\-]+$"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"feature_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[projectVersionFeatureConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"content_moderation": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[projectVersionContentModerationConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"confidence_threshold": schema.Float64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Float64{
+											float64planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Float64{
+											float64validator.Between(0, 100),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[projectVersionOutputConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_bucket": schema.StringAttribute{
+							Required: true,
+						},
+						"s3_key_prefix": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"testing_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[projectVersionTestingDataModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_create": schema.BoolAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"assets": assetsBlock,
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"training_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[projectVersionTrainingDataModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"assets": assetsBlock,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceProjectVersion) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var plan resourceProjectVersionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &rekognition.CreateProjectVersionInput{}
+
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreateProjectVersion(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, plan.VersionName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil || out.ProjectVersionArn == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, plan.VersionName.ValueString(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	id, err := intflex.FlattenResourceId([]string{plan.ProjectARN.ValueString(), plan.VersionName.ValueString()}, projectVersionIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, plan.VersionName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ARN = flex.StringToFramework(ctx, out.ProjectVersionArn)
+	state.ID = types.StringValue(id)
+
+	createTimeout := r.CreateTimeout(ctx, state.Timeouts)
+	version, err := waitProjectVersionCreated(ctx, conn, state.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForCreation, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	var fromAPI resourceProjectVersionData
+	resp.Diagnostics.Append(flex.Flatten(ctx, version, &fromAPI)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.FeatureConfig.IsNull() {
+		state.FeatureConfig = fromAPI.FeatureConfig
+	}
+	state.KMSKeyID = fromAPI.KMSKeyID
+	state.Status = fromAPI.Status
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProjectVersion) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state resourceProjectVersionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findProjectVersionByID(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	featureConfigConfigured := !state.FeatureConfig.IsNull()
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API reports the default feature configuration when none was supplied.
+	if !featureConfigConfigured {
+		state.FeatureConfig = fwtypes.NewListNestedObjectValueOfNull[projectVersionFeatureConfigModel](ctx)
+	}
+
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), projectVersionIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ARN = flex.StringToFramework(ctx, out.ProjectVersionArn)
+	state.ProjectARN = fwtypes.ARNValueMust(parts[0])
+	state.VersionName = types.StringValue(parts[1])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProjectVersion) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resourceProjectVersionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceProjectVersion) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state resourceProjectVersionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &rekognition.DeleteProjectVersionInput{
+		ProjectVersionArn: state.ARN.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteProjectVersion(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionDeleting, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitProjectVersionDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForDeletion, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceProjectVersion) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, req, resp)
+}
+
+func waitProjectVersionCreated(ctx context.Context, conn *rekognition.Client, id string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ProjectVersionStatusTrainingInProgress),
+		Target:       enum.Slice(awstypes.ProjectVersionStatusTrainingCompleted),
+		Refresh:      statusProjectVersion(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 1 * time.Minute,
+		Delay:        1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionDeleted(ctx context.Context, conn *rekognition.Client, id string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProjectVersionStatusDeleting),
+		Target:  []string{},
+		Refresh: statusProjectVersion(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func findProjectVersionByID(ctx context.Context, conn *rekognition.Client, id string) (*awstypes.ProjectVersionDescription, error) {
+	parts, err := intflex.ExpandResourceId(id, projectVersionIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &rekognition.DescribeProjectVersionsInput{
+		ProjectArn:   aws.String(parts[0]),
+		VersionNames: []string{parts[1]},
+	}
+
+	out, err := conn.DescribeProjectVersions(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || len(out.ProjectVersionDescriptions) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return &out.ProjectVersionDescriptions[0], nil
+}
+
+func statusProjectVersion(ctx context.Context, conn *rekognition.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findProjectVersionByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+type resourceProjectVersionData struct {
+	ARN                types.String                                                      `tfsdk:"arn"`
+	FeatureConfig      fwtypes.ListNestedObjectValueOf[projectVersionFeatureConfigModel] `tfsdk:"feature_config"`
+	ID                 types.String                                                      `tfsdk:"id"`
+	KMSKeyID           types.String                                                      `tfsdk:"kms_key_id"`
+	OutputConfig       fwtypes.ListNestedObjectValueOf[projectVersionOutputConfigModel]  `tfsdk:"output_config"`
+	ProjectARN         fwtypes.ARN                                                       `tfsdk:"project_arn"`
+	Status             fwtypes.StringEnum[awstypes.ProjectVersionStatus]                 `tfsdk:"status"`
+	Tags               types.Map                                                         `tfsdk:"tags"`
+	TagsAll            types.Map                                                         `tfsdk:"tags_all"`
+	TestingData        fwtypes.ListNestedObjectValueOf[projectVersionTestingDataModel]   `tfsdk:"testing_data"`
+	Timeouts           timeouts.Value                                                    `tfsdk:"timeouts"`
+	TrainingData       fwtypes.ListNestedObjectValueOf[projectVersionTrainingDataModel]  `tfsdk:"training_data"`
+	VersionDescription types.String                                                      `tfsdk:"version_description"`
+	VersionName        types.String                                                      `tfsdk:"version_name"`
+}
+
+type projectVersionFeatureConfigModel struct {
+	ContentModeration fwtypes.ListNestedObjectValueOf[projectVersionContentModerationConfigModel] `tfsdk:"content_moderation"`
+}
+
+type projectVersionContentModerationConfigModel struct {
+	ConfidenceThreshold types.Float64 `tfsdk:"confidence_threshold"`
+}
+
+type projectVersionOutputConfigModel struct {
+	S3Bucket    types.String `tfsdk:"s3_bucket"`
+	S3KeyPrefix types.String `tfsdk:"s3_key_prefix"`
+}
+
+type projectVersionTrainingDataModel struct {
+	Assets fwtypes.ListNestedObjectValueOf[projectVersionAssetModel] `tfsdk:"assets"`
+}
+
+type projectVersionTestingDataModel struct {
+	Assets     fwtypes.ListNestedObjectValueOf[projectVersionAssetModel] `tfsdk:"assets"`
+	AutoCreate types.Bool                                                `tfsdk:"auto_create"`
+}
+
+type projectVersionAssetModel struct {
+	GroundTruthManifest fwtypes.ListNestedObjectValueOf[projectVersionGroundTruthManifestModel] `tfsdk:"ground_truth_manifest"`
+}
+
+type projectVersionGroundTruthManifestModel struct {
+	S3Object fwtypes.ListNestedObjectValueOf[projectVersionS3ObjectModel] `tfsdk:"s3_object"`
+}
+
+type projectVersionS3ObjectModel struct {
+	Bucket  types.String `tfsdk:"bucket"`
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Training a model requires a labelled dataset, so the manifest must be
+// provided out of band. Training can take over an hour to complete.
+func TestAccRekognitionProjectVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	manifestBucket := acctest.SkipIfEnvVarNotSet(t, "REKOGNITION_MANIFEST_BUCKET")
+	manifestKey := acctest.SkipIfEnvVarNotSet(t, "REKOGNITION_MANIFEST_KEY")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+			testAccProjectPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "project_arn", "aws_rekognition_project.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "TRAINING_COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"testing_data", "training_data"},
+			},
+		},
+	})
+}
+
+func TestAccRekognitionProjectVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	manifestBucket := acctest.SkipIfEnvVarNotSet(t, "REKOGNITION_MANIFEST_BUCKET")
+	manifestKey := acctest.SkipIfEnvVarNotSet(t, "REKOGNITION_MANIFEST_KEY")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+			testAccProjectPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfrekognition.ResourceProjectVersion, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectVersionExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameProjectVersion, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameProjectVersion, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionClient(ctx)
+		_, err := tfrekognition.FindProjectVersionByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameProjectVersion, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProjectVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rekognition_project_version" {
+				continue
+			}
+
+			_, err := tfrekognition.FindProjectVersionByID(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Rekognition, create.ErrActionCheckingDestroyed, tfrekognition.ResNameProjectVersion, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_rekognition_project" "test" {
+  name    = %[1]q
+  feature = "CUSTOM_LABELS"
+}
+
+resource "aws_rekognition_project_version" "test" {
+  project_arn  = aws_rekognition_project.test.arn
+  version_name = %[1]q
+
+  output_config {
+    s3_bucket     = aws_s3_bucket.test.bucket
+    s3_key_prefix = "output"
+  }
+
+  training_data {
+    assets {
+      ground_truth_manifest {
+        s3_object {
+          bucket = %[2]q
+          name   = %[3]q
+        }
+      }
+    }
+  }
+
+  testing_data {
+    auto_create = true
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, manifestBucket, manifestKey)
+}
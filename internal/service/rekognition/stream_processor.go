@@ -0,0 +1,750 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rekognition"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rekognition/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Stream Processor")
+// @Tags(identifierAttribute="arn")
+func newResourceStreamProcessor(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceStreamProcessor{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceStreamProcessor struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+const (
+	ResNameStreamProcessor = "Stream Processor"
+)
+
+func (r *resourceStreamProcessor) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_rekognition_stream_processor"
+}
+
+func (r *resourceStreamProcessor) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	boundingBoxValidators := []validator.Float64{
+		float64validator.Between(0, 1),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"id":  framework.IDAttribute(),
+			"kms_key_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must conform to: ^[a-zA-Z0-9_.\\-]+$"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.StreamProcessorStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"data_sharing_preference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorDataSharingPreferenceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"opt_in": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"input": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorInputModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kinesis_video_stream": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorKinesisVideoStreamModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"notification_channel": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorNotificationChannelModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"sns_topic_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorOutputModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kinesis_data_stream": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorKinesisDataStreamModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"s3_destination": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorS3DestinationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bucket": schema.StringAttribute{
+										Required: true,
+									},
+									"key_prefix": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"regions_of_interest": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorRegionOfInterestModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"bounding_box": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorBoundingBoxModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"height": schema.Float64Attribute{
+										Required:   true,
+										Validators: boundingBoxValidators,
+									},
+									"left": schema.Float64Attribute{
+										Required:   true,
+										Validators: boundingBoxValidators,
+									},
+									"top": schema.Float64Attribute{
+										Required:   true,
+										Validators: boundingBoxValidators,
+									},
+									"width": schema.Float64Attribute{
+										Required:   true,
+										Validators: boundingBoxValidators,
+									},
+								},
+							},
+						},
+						"polygon": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorPointModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeBetween(3, 10),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"x": schema.Float64Attribute{
+										Required:   true,
+										Validators: boundingBoxValidators,
+									},
+									"y": schema.Float64Attribute{
+										Required:   true,
+										Validators: boundingBoxValidators,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorSettingsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connected_home": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorConnectedHomeSettingsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("face_search")),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"labels": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+											listvalidator.ValueStringsAre(stringvalidator.OneOf("PERSON", "PET", "PACKAGE", "ALL")),
+										},
+									},
+									"min_confidence": schema.Float64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Float64{
+											float64planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Float64{
+											float64validator.Between(0, 100),
+										},
+									},
+								},
+							},
+						},
+						"face_search": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorFaceSearchSettingsModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"collection_id": schema.StringAttribute{
+										Required: true,
+									},
+									"face_match_threshold": schema.Float64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Float64{
+											float64planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Float64{
+											float64validator.Between(0, 100),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceStreamProcessor) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var plan resourceStreamProcessorData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &rekognition.CreateStreamProcessorInput{}
+
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreateStreamProcessor(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameStreamProcessor, plan.Name.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil || out.StreamProcessorArn == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameStreamProcessor, plan.Name.ValueString(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ARN = flex.StringToFramework(ctx, out.StreamProcessorArn)
+	state.ID = state.Name
+
+	createTimeout := r.CreateTimeout(ctx, state.Timeouts)
+	processor, err := waitStreamProcessorCreated(ctx, conn, state.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForCreation, ResNameStreamProcessor, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	var fromAPI resourceStreamProcessorData
+	resp.Diagnostics.Append(flex.Flatten(ctx, processor, &fromAPI)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Thresholds left unset are defaulted by the API.
+	state.Settings = fromAPI.Settings
+	state.Status = fromAPI.Status
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceStreamProcessor) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state resourceStreamProcessorData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findStreamProcessorByName(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, ResNameStreamProcessor, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	dataSharingPreferenceConfigured := !state.DataSharingPreference.IsNull()
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ARN = flex.StringToFramework(ctx, out.StreamProcessorArn)
+
+	// The API always returns a data sharing preference, defaulting to opted out.
+	if !dataSharingPreferenceConfigured && (out.DataSharingPreference == nil || !out.DataSharingPreference.OptIn) {
+		state.DataSharingPreference = fwtypes.NewListNestedObjectValueOfNull[streamProcessorDataSharingPreferenceModel](ctx)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceStreamProcessor) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var plan, state resourceStreamProcessorData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DataSharingPreference.Equal(state.DataSharingPreference) ||
+		!plan.RegionsOfInterest.Equal(state.RegionsOfInterest) ||
+		!plan.Settings.Equal(state.Settings) {
+		in := &rekognition.UpdateStreamProcessorInput{
+			Name: plan.Name.ValueStringPointer(),
+		}
+
+		if !plan.DataSharingPreference.Equal(state.DataSharingPreference) {
+			// Removing the block opts back out of data sharing.
+			in.DataSharingPreferenceForUpdate = &awstypes.StreamProcessorDataSharingPreference{}
+			resp.Diagnostics.Append(flex.Expand(ctx, plan.DataSharingPreference, &in.DataSharingPreferenceForUpdate)...)
+		}
+
+		if !plan.RegionsOfInterest.Equal(state.RegionsOfInterest) {
+			if plan.RegionsOfInterest.IsNull() || len(plan.RegionsOfInterest.Elements()) == 0 {
+				in.ParametersToDelete = append(in.ParametersToDelete, awstypes.StreamProcessorParameterToDeleteRegionsOfInterest)
+			} else {
+				resp.Diagnostics.Append(flex.Expand(ctx, plan.RegionsOfInterest, &in.RegionsOfInterestForUpdate)...)
+			}
+		}
+
+		if !plan.Settings.Equal(state.Settings) {
+			planSettings, d := plan.Settings.ToPtr(ctx)
+			resp.Diagnostics.Append(d...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if planSettings != nil && !planSettings.ConnectedHome.IsNull() {
+				in.SettingsForUpdate = &awstypes.StreamProcessorSettingsForUpdate{}
+				resp.Diagnostics.Append(flex.Expand(ctx, planSettings.ConnectedHome, &in.SettingsForUpdate.ConnectedHomeForUpdate)...)
+			}
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateStreamProcessor(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionUpdating, ResNameStreamProcessor, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		processor, err := waitStreamProcessorUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForUpdate, ResNameStreamProcessor, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		var fromAPI resourceStreamProcessorData
+		resp.Diagnostics.Append(flex.Flatten(ctx, processor, &fromAPI)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Settings = fromAPI.Settings
+		plan.Status = fromAPI.Status
+	} else {
+		plan.Status = state.Status
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceStreamProcessor) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state resourceStreamProcessorData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &rekognition.DeleteStreamProcessorInput{
+		Name: state.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteStreamProcessor(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionDeleting, ResNameStreamProcessor, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitStreamProcessorDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForDeletion, ResNameStreamProcessor, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceStreamProcessor) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, req, resp)
+}
+
+func waitStreamProcessorCreated(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.StreamProcessorStatusStarting, awstypes.StreamProcessorStatusUpdating),
+		Target:                    enum.Slice(awstypes.StreamProcessorStatusStopped),
+		Refresh:                   statusStreamProcessor(ctx, conn, name),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorUpdated(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.StreamProcessorStatusUpdating),
+		Target:                    enum.Slice(awstypes.StreamProcessorStatusStopped, awstypes.StreamProcessorStatusRunning),
+		Refresh:                   statusStreamProcessor(ctx, conn, name),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorDeleted(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.StreamProcessorStatusStopped,
+			awstypes.StreamProcessorStatusStopping,
+			awstypes.StreamProcessorStatusStarting,
+			awstypes.StreamProcessorStatusRunning,
+			awstypes.StreamProcessorStatusFailed,
+			awstypes.StreamProcessorStatusUpdating,
+		),
+		Target:  []string{},
+		Refresh: statusStreamProcessor(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func findStreamProcessorByName(ctx context.Context, conn *rekognition.Client, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	in := &rekognition.DescribeStreamProcessorInput{
+		Name: aws.String(name),
+	}
+
+	out, err := conn.DescribeStreamProcessor(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.StreamProcessorArn == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func statusStreamProcessor(ctx context.Context, conn *rekognition.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findStreamProcessorByName(ctx, conn, name)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+type resourceStreamProcessorData struct {
+	ARN                   types.String                                                               `tfsdk:"arn"`
+	DataSharingPreference fwtypes.ListNestedObjectValueOf[streamProcessorDataSharingPreferenceModel] `tfsdk:"data_sharing_preference"`
+	ID                    types.String                                                               `tfsdk:"id"`
+	Input                 fwtypes.ListNestedObjectValueOf[streamProcessorInputModel]                 `tfsdk:"input"`
+	KMSKeyID              types.String                                                               `tfsdk:"kms_key_id"`
+	Name                  types.String                                                               `tfsdk:"name"`
+	NotificationChannel   fwtypes.ListNestedObjectValueOf[streamProcessorNotificationChannelModel]   `tfsdk:"notification_channel"`
+	Output                fwtypes.ListNestedObjectValueOf[streamProcessorOutputModel]                `tfsdk:"output"`
+	RegionsOfInterest     fwtypes.ListNestedObjectValueOf[streamProcessorRegionOfInterestModel]      `tfsdk:"regions_of_interest"`
+	RoleARN               fwtypes.ARN                                                                `tfsdk:"role_arn"`
+	Settings              fwtypes.ListNestedObjectValueOf[streamProcessorSettingsModel]              `tfsdk:"settings"`
+	Status                fwtypes.StringEnum[awstypes.StreamProcessorStatus]                         `tfsdk:"status"`
+	Tags                  types.Map                                                                  `tfsdk:"tags"`
+	TagsAll               types.Map                                                                  `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                                             `tfsdk:"timeouts"`
+}
+
+type streamProcessorDataSharingPreferenceModel struct {
+	OptIn types.Bool `tfsdk:"opt_in"`
+}
+
+type streamProcessorInputModel struct {
+	KinesisVideoStream fwtypes.ListNestedObjectValueOf[streamProcessorKinesisVideoStreamModel] `tfsdk:"kinesis_video_stream"`
+}
+
+type streamProcessorKinesisVideoStreamModel struct {
+	ARN fwtypes.ARN `tfsdk:"arn"`
+}
+
+type streamProcessorNotificationChannelModel struct {
+	SNSTopicARN fwtypes.ARN `tfsdk:"sns_topic_arn"`
+}
+
+type streamProcessorOutputModel struct {
+	KinesisDataStream fwtypes.ListNestedObjectValueOf[streamProcessorKinesisDataStreamModel] `tfsdk:"kinesis_data_stream"`
+	S3Destination     fwtypes.ListNestedObjectValueOf[streamProcessorS3DestinationModel]     `tfsdk:"s3_destination"`
+}
+
+type streamProcessorKinesisDataStreamModel struct {
+	ARN fwtypes.ARN `tfsdk:"arn"`
+}
+
+type streamProcessorS3DestinationModel struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	KeyPrefix types.String `tfsdk:"key_prefix"`
+}
+
+type streamProcessorRegionOfInterestModel struct {
+	BoundingBox fwtypes.ListNestedObjectValueOf[streamProcessorBoundingBoxModel] `tfsdk:"bounding_box"`
+	Polygon     fwtypes.ListNestedObjectValueOf[streamProcessorPointModel]       `tfsdk:"polygon"`
+}
+
+type streamProcessorBoundingBoxModel struct {
+	Height types.Float64 `tfsdk:"height"`
+	Left   types.Float64 `tfsdk:"left"`
+	Top    types.Float64 `tfsdk:"top"`
+	Width  types.Float64 `tfsdk:"width"`
+}
+
+type streamProcessorPointModel struct {
+	X types.Float64 `tfsdk:"x"`
+	Y types.Float64 `tfsdk:"y"`
+}
+
+type streamProcessorSettingsModel struct {
+	ConnectedHome fwtypes.ListNestedObjectValueOf[streamProcessorConnectedHomeSettingsModel] `tfsdk:"connected_home"`
+	FaceSearch    fwtypes.ListNestedObjectValueOf[streamProcessorFaceSearchSettingsModel]    `tfsdk:"face_search"`
+}
+
+type streamProcessorConnectedHomeSettingsModel struct {
+	Labels        fwtypes.ListValueOf[types.String] `tfsdk:"labels"`
+	MinConfidence types.Float64                     `tfsdk:"min_confidence"`
+}
+
+type streamProcessorFaceSearchSettingsModel struct {
+	CollectionID       types.String  `tfsdk:"collection_id"`
+	FaceMatchThreshold types.Float64 `tfsdk:"face_match_threshold"`
+}
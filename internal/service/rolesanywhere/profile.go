@@ -6,6 +6,7 @@ package rolesanywhere
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -37,6 +39,32 @@ func ResourceProfile() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"attribute_mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_field": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.CertificateField](),
+						},
+						"mapping_rule": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"specifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"duration_seconds": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -118,6 +146,13 @@ func resourceProfileCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.StringValue(output.Profile.ProfileId))
 
+	// New profiles are created with default attribute mappings. Replace them with the configured ones.
+	if v, ok := d.GetOk("attribute_mapping"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateProfileAttributeMappings(ctx, conn, d.Id(), flattenAttributeMappings(output.Profile.AttributeMappings), v.(*schema.Set).List()); err != nil {
+			return diag.Errorf("setting RolesAnywhere Profile (%s) attribute mappings: %s", d.Id(), err)
+		}
+	}
+
 	return resourceProfileRead(ctx, d, meta)
 }
 
@@ -137,6 +172,9 @@ func resourceProfileRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	d.Set("arn", profile.ProfileArn)
+	if err := d.Set("attribute_mapping", flattenAttributeMappings(profile.AttributeMappings)); err != nil {
+		return diag.Errorf("setting attribute_mapping: %s", err)
+	}
 	d.Set("duration_seconds", profile.DurationSeconds)
 	d.Set("enabled", profile.Enabled)
 	d.Set("managed_policy_arns", profile.ManagedPolicyArns)
@@ -151,7 +189,7 @@ func resourceProfileRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept("enabled", "tags_all") {
+	if d.HasChangesExcept("attribute_mapping", "enabled", "tags_all") {
 		input := &rolesanywhere.UpdateProfileInput{
 			ProfileId: aws.String(d.Id()),
 		}
@@ -183,6 +221,14 @@ func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("attribute_mapping") {
+		o, n := d.GetChange("attribute_mapping")
+
+		if err := updateProfileAttributeMappings(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return diag.Errorf("updating RolesAnywhere Profile (%s) attribute mappings: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("enabled") {
 		_, n := d.GetChange("enabled")
 		if n == true {
@@ -242,3 +288,96 @@ func enableProfile(ctx context.Context, profileId string, meta interface{}) erro
 	_, err := conn.EnableProfile(ctx, input)
 	return err
 }
+
+// updateProfileAttributeMappings deletes the mappings for certificate fields that are no longer configured
+// and puts the mapping rules for every configured certificate field.
+func updateProfileAttributeMappings(ctx context.Context, conn *rolesanywhere.Client, profileID string, oldList, newList []interface{}) error {
+	newMappings := expandAttributeMappings(newList)
+	fields := make(map[types.CertificateField]struct{}, len(newMappings))
+
+	for _, v := range newMappings {
+		fields[v.CertificateField] = struct{}{}
+	}
+
+	for _, v := range expandAttributeMappings(oldList) {
+		if _, ok := fields[v.CertificateField]; ok {
+			continue
+		}
+
+		input := &rolesanywhere.DeleteAttributeMappingInput{
+			CertificateField: v.CertificateField,
+			ProfileId:        aws.String(profileID),
+		}
+
+		if _, err := conn.DeleteAttributeMapping(ctx, input); err != nil {
+			return fmt.Errorf("deleting %s attribute mapping: %w", v.CertificateField, err)
+		}
+	}
+
+	for _, v := range newMappings {
+		input := &rolesanywhere.PutAttributeMappingInput{
+			CertificateField: v.CertificateField,
+			MappingRules:     v.MappingRules,
+			ProfileId:        aws.String(profileID),
+		}
+
+		if _, err := conn.PutAttributeMapping(ctx, input); err != nil {
+			return fmt.Errorf("putting %s attribute mapping: %w", v.CertificateField, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAttributeMappings(tfList []interface{}) []types.AttributeMapping {
+	var apiObjects []types.AttributeMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AttributeMapping{
+			CertificateField: types.CertificateField(tfMap["certificate_field"].(string)),
+		}
+
+		if v, ok := tfMap["mapping_rule"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				apiObject.MappingRules = append(apiObject.MappingRules, types.MappingRule{
+					Specifier: aws.String(tfMap["specifier"].(string)),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAttributeMappings(apiObjects []types.AttributeMapping) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		mappingRules := make([]interface{}, 0, len(apiObject.MappingRules))
+
+		for _, v := range apiObject.MappingRules {
+			mappingRules = append(mappingRules, map[string]interface{}{
+				"specifier": aws.StringValue(v.Specifier),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"certificate_field": string(apiObject.CertificateField),
+			"mapping_rule":      mappingRules,
+		})
+	}
+
+	return tfList
+}
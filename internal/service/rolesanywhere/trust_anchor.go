@@ -6,6 +6,7 @@ package rolesanywhere
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
@@ -15,12 +16,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// The principal reported for notification settings that have not been customized.
+	notificationSettingDefaultConfiguredBy = "rolesanywhere.amazonaws.com"
+)
+
 // @SDKResource("aws_rolesanywhere_trust_anchor", name="Trust Anchor")
 // @Tags(identifierAttribute="arn")
 func ResourceTrustAnchor() *schema.Resource {
@@ -48,6 +55,34 @@ func ResourceTrustAnchor() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"notification_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(types.NotificationChannelAll),
+							ValidateDiagFunc: enum.Validate[types.NotificationChannel](),
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"event": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.NotificationEvent](),
+						},
+						"threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+					},
+				},
+			},
 			"source": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -101,6 +136,10 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:    getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("notification_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotificationSettings = expandNotificationSettings(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating RolesAnywhere Trust Anchor (%s): %#v", d.Id(), input)
 	output, err := conn.CreateTrustAnchor(ctx, input)
 
@@ -131,6 +170,9 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("arn", trustAnchor.TrustAnchorArn)
 	d.Set("enabled", trustAnchor.Enabled)
 	d.Set("name", trustAnchor.Name)
+	if err := d.Set("notification_settings", flattenNotificationSettingDetails(trustAnchor.NotificationSettings)); err != nil {
+		return diag.Errorf("setting notification_settings: %s", err)
+	}
 
 	if err := d.Set("source", flattenSource(trustAnchor.Source)); err != nil {
 		return diag.Errorf("setting source: %s", err)
@@ -142,7 +184,7 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("notification_settings", "tags", "tags_all") {
 		input := &rolesanywhere.UpdateTrustAnchorInput{
 			TrustAnchorId: aws.String(d.Id()),
 			Name:          aws.String(d.Get("name").(string)),
@@ -170,6 +212,14 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("notification_settings") {
+		o, n := d.GetChange("notification_settings")
+
+		if err := updateTrustAnchorNotificationSettings(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return diag.Errorf("updating RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+		}
+	}
+
 	return resourceTrustAnchorRead(ctx, d, meta)
 }
 
@@ -274,6 +324,102 @@ func expandSourceDataCertificateBundle(tfMap map[string]interface{}) *types.Sour
 	return result
 }
 
+// updateTrustAnchorNotificationSettings resets the notification settings that are no longer configured
+// to their defaults and puts the configured ones.
+func updateTrustAnchorNotificationSettings(ctx context.Context, conn *rolesanywhere.Client, trustAnchorID string, oldList, newList []interface{}) error {
+	newSettings := expandNotificationSettings(newList)
+	keys := make(map[types.NotificationSettingKey]struct{}, len(newSettings))
+
+	for _, v := range newSettings {
+		keys[types.NotificationSettingKey{Channel: v.Channel, Event: v.Event}] = struct{}{}
+	}
+
+	var resetKeys []types.NotificationSettingKey
+
+	for _, v := range expandNotificationSettings(oldList) {
+		key := types.NotificationSettingKey{Channel: v.Channel, Event: v.Event}
+
+		if _, ok := keys[key]; !ok {
+			resetKeys = append(resetKeys, key)
+		}
+	}
+
+	if len(resetKeys) > 0 {
+		input := &rolesanywhere.ResetNotificationSettingsInput{
+			NotificationSettingKeys: resetKeys,
+			TrustAnchorId:           aws.String(trustAnchorID),
+		}
+
+		if _, err := conn.ResetNotificationSettings(ctx, input); err != nil {
+			return fmt.Errorf("resetting: %w", err)
+		}
+	}
+
+	if len(newSettings) > 0 {
+		input := &rolesanywhere.PutNotificationSettingsInput{
+			NotificationSettings: newSettings,
+			TrustAnchorId:        aws.String(trustAnchorID),
+		}
+
+		if _, err := conn.PutNotificationSettings(ctx, input); err != nil {
+			return fmt.Errorf("putting: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func expandNotificationSettings(tfList []interface{}) []types.NotificationSetting {
+	var apiObjects []types.NotificationSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.NotificationSetting{
+			Channel: types.NotificationChannel(tfMap["channel"].(string)),
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+			Event:   types.NotificationEvent(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+			apiObject.Threshold = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenNotificationSettingDetails returns only the notification settings customized in the account.
+// Settings that are still at their IAM Roles Anywhere defaults are omitted.
+func flattenNotificationSettingDetails(apiObjects []types.NotificationSettingDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if aws.StringValue(apiObject.ConfiguredBy) == notificationSettingDefaultConfiguredBy {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"channel": string(apiObject.Channel),
+			"enabled": aws.BoolValue(apiObject.Enabled),
+			"event":   string(apiObject.Event),
+		}
+
+		if v := apiObject.Threshold; v != nil {
+			tfMap["threshold"] = aws.Int32Value(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func disableTrustAnchor(ctx context.Context, trustAnchorId string, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
@@ -356,25 +356,16 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		action = route53.ChangeActionCreate
 	}
 
-	// Create the new records. We abuse StateChangeConf for this to
-	// retry for us since Route53 sometimes returns errors about another
-	// operation happening at the same time.
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(action),
-				ResourceRecordSet: rec,
-			},
+	// Create the new records. Changes to the same hosted zone made concurrently
+	// by other records are sent in the same request.
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(action),
+			ResourceRecordSet: rec,
 		},
 	}
 
-	input := &route53.ChangeResourceRecordSetsInput{
-		ChangeBatch:  changeBatch,
-		HostedZoneId: aws.String(CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))),
-	}
-
-	changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
+	changeInfo, err := recordChanges.submit(ctx, conn, CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id)), changes)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Route 53 Record: %s", err)
@@ -659,31 +650,22 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	// Build the to be created record
 	rec := expandResourceRecordSet(d, aws.StringValue(zoneRecord.HostedZone.Name))
 
-	// Delete the old and create the new records in a single batch. We abuse
-	// StateChangeConf for this to retry for us since Route53 sometimes returns
-	// errors about another operation happening at the same time.
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(route53.ChangeActionDelete),
-				ResourceRecordSet: oldRec,
-			},
-			{
-				Action:            aws.String(route53.ChangeActionCreate),
-				ResourceRecordSet: rec,
-			},
+	// Delete the old and create the new records in a single batch.
+	// Both changes are always sent in the same request.
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: oldRec,
+		},
+		{
+			Action:            aws.String(route53.ChangeActionCreate),
+			ResourceRecordSet: rec,
 		},
-	}
-
-	input := &route53.ChangeResourceRecordSetsInput{
-		HostedZoneId: aws.String(CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))),
-		ChangeBatch:  changeBatch,
 	}
 
 	log.Printf("[DEBUG] Updating resource records for zone: %s, name: %s", zone, aws.StringValue(rec.Name))
 
-	changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
+	changeInfo, err := recordChanges.submit(ctx, conn, CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id)), changes)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Route 53 resource record sets: %s", err)
@@ -732,28 +714,23 @@ func resourceRecordDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "reading Route 53 Record (%s): %s", d.Id(), err)
 	}
 
-	// Change batch for deleting
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Deleted by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(route53.ChangeActionDelete),
-				ResourceRecordSet: rec,
-			},
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: rec,
 		},
 	}
-	input := &route53.ChangeResourceRecordSetsInput{
-		ChangeBatch:  changeBatch,
-		HostedZoneId: aws.String(zoneID),
-	}
 
-	respRaw, err := DeleteRecordSet(ctx, conn, input)
+	changeInfo, err := recordChanges.submit(ctx, conn, zoneID, changes)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeInvalidChangeBatch) {
+		return diags
+	}
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Record (%s): %s", d.Id(), err)
 	}
 
-	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
 	if changeInfo == nil {
 		log.Printf("[INFO] No ChangeInfo Found. Waiting for Sync not required")
 		return diags
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+const (
+	// Maximum number of changes in a single ChangeResourceRecordSets request.
+	recordChangeBatchMaxChanges = 1000
+)
+
+var (
+	recordChanges = newRecordChangeBatcher(func(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change) (*route53.ChangeInfo, error) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: aws.String("Managed by Terraform"),
+				Changes: changes,
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		return ChangeResourceRecordSets(ctx, conn, input)
+	})
+)
+
+type recordChangeSendFunc func(context.Context, *route53.Route53, string, []*route53.Change) (*route53.ChangeInfo, error)
+
+// recordChangeBatcher coalesces resource record set changes to the same hosted zone, made concurrently
+// by different resources during an apply, into fewer ChangeResourceRecordSets calls.
+// Changes to a hosted zone with no request in progress are sent at once.
+// Changes made while a request is in progress wait for it to complete and are then sent together.
+// The changes submitted by a single caller are always sent in the same request, so remain atomic.
+type recordChangeBatcher struct {
+	lock  sync.Mutex
+	send  recordChangeSendFunc
+	zones map[recordChangeBatchKey]*recordChangeZone
+}
+
+type recordChangeBatchKey struct {
+	conn   *route53.Route53
+	zoneID string
+}
+
+// recordChangeZone holds the changes waiting to be sent to a hosted zone.
+type recordChangeZone struct {
+	pending []*recordChangeRequest
+}
+
+type recordChangeRequest struct {
+	ctx     context.Context
+	changes []*route53.Change
+	done    chan recordChangeResult
+}
+
+type recordChangeResult struct {
+	changeInfo *route53.ChangeInfo
+	err        error
+}
+
+func newRecordChangeBatcher(send recordChangeSendFunc) *recordChangeBatcher {
+	return &recordChangeBatcher{
+		send:  send,
+		zones: make(map[recordChangeBatchKey]*recordChangeZone),
+	}
+}
+
+// submit sends the changes to the hosted zone, possibly along with other callers' changes, and waits for the request to complete.
+// If ctx is done before the changes are sent they are dropped.
+func (b *recordChangeBatcher) submit(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change) (*route53.ChangeInfo, error) {
+	request := &recordChangeRequest{
+		ctx:     ctx,
+		changes: changes,
+		done:    make(chan recordChangeResult, 1),
+	}
+	key := recordChangeBatchKey{
+		conn:   conn,
+		zoneID: zoneID,
+	}
+
+	b.lock.Lock()
+	zone, ok := b.zones[key]
+	if ok {
+		zone.pending = append(zone.pending, request)
+	} else {
+		b.zones[key] = &recordChangeZone{}
+	}
+	b.lock.Unlock()
+
+	if !ok {
+		go b.run(conn, zoneID, key, []*recordChangeRequest{request})
+	}
+
+	select {
+	case result := <-request.done:
+		return result.changeInfo, result.err
+	case <-ctx.Done():
+	}
+
+	b.lock.Lock()
+	if ok && zone.remove(request) {
+		b.lock.Unlock()
+
+		return nil, ctx.Err()
+	}
+	b.lock.Unlock()
+
+	// The changes have already been sent, so report the outcome.
+	result := <-request.done
+
+	return result.changeInfo, result.err
+}
+
+// run sends the specified requests and then any changes to the hosted zone made in the meantime until none are waiting.
+func (b *recordChangeBatcher) run(conn *route53.Route53, zoneID string, key recordChangeBatchKey, requests []*recordChangeRequest) {
+	for len(requests) > 0 {
+		b.flush(conn, zoneID, requests)
+
+		b.lock.Lock()
+		requests = b.zones[key].next()
+		if len(requests) == 0 {
+			delete(b.zones, key)
+		}
+		b.lock.Unlock()
+	}
+}
+
+// next removes and returns the waiting requests whose changes fit in a single request.
+// The caller must hold the batcher's lock.
+func (z *recordChangeZone) next() []*recordChangeRequest {
+	var requests []*recordChangeRequest
+	size := 0
+
+	for len(z.pending) > 0 {
+		request := z.pending[0]
+
+		if len(requests) > 0 && size+len(request.changes) > recordChangeBatchMaxChanges {
+			break
+		}
+
+		z.pending = z.pending[1:]
+
+		if err := request.ctx.Err(); err != nil {
+			request.done <- recordChangeResult{err: err}
+			continue
+		}
+
+		requests = append(requests, request)
+		size += len(request.changes)
+	}
+
+	return requests
+}
+
+// remove removes the specified request if it is waiting to be sent.
+// The caller must hold the batcher's lock.
+func (z *recordChangeZone) remove(request *recordChangeRequest) bool {
+	for i, v := range z.pending {
+		if v == request {
+			z.pending = append(z.pending[:i], z.pending[i+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// flush sends the requests' changes and waits for them to complete.
+// If a combined request fails, each caller's changes are retried in a separate request so that
+// one invalid change does not fail the others and any error is reported to the right caller.
+func (b *recordChangeBatcher) flush(conn *route53.Route53, zoneID string, requests []*recordChangeRequest) {
+	// The changes must not be canceled along with the first caller's request once they are sent.
+	ctx := context.WithoutCancel(requests[0].ctx)
+
+	if len(requests) > 1 {
+		var changes []*route53.Change
+		for _, request := range requests {
+			changes = append(changes, request.changes...)
+		}
+
+		log.Printf("[DEBUG] Sending %d batched changes for Route 53 Hosted Zone (%s)", len(changes), zoneID)
+		changeInfo, err := b.send(ctx, conn, zoneID, changes)
+
+		if err == nil {
+			for _, request := range requests {
+				request.done <- recordChangeResult{changeInfo: changeInfo}
+			}
+
+			return
+		}
+
+		log.Printf("[WARN] Sending batched changes for Route 53 Hosted Zone (%s), retrying individually: %s", zoneID, err)
+	}
+
+	var wg sync.WaitGroup
+	for _, request := range requests {
+		wg.Add(1)
+		go func(request *recordChangeRequest) {
+			defer wg.Done()
+
+			changeInfo, err := b.send(ctx, conn, zoneID, request.changes)
+			request.done <- recordChangeResult{changeInfo: changeInfo, err: err}
+		}(request)
+	}
+	wg.Wait()
+}
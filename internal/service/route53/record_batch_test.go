@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// testRecordChangeSender records the changes sent in each request.
+// Requests for a record named "blocker" wait until release is closed.
+type testRecordChangeSender struct {
+	lock     sync.Mutex
+	release  chan struct{}
+	requests [][]string
+}
+
+func (s *testRecordChangeSender) send(_ context.Context, _ *route53.Route53, _ string, changes []*route53.Change) (*route53.ChangeInfo, error) {
+	var names []string
+	for _, change := range changes {
+		names = append(names, aws.StringValue(change.ResourceRecordSet.Name))
+	}
+
+	s.lock.Lock()
+	s.requests = append(s.requests, names)
+	n := len(s.requests)
+	s.lock.Unlock()
+
+	for _, name := range names {
+		switch name {
+		case "blocker":
+			<-s.release
+		case "invalid":
+			return nil, errors.New("InvalidChangeBatch")
+		}
+	}
+
+	return &route53.ChangeInfo{Id: aws.String(fmt.Sprintf("C%d", n))}, nil
+}
+
+// wait waits until the specified number of requests have been sent.
+func (s *testRecordChangeSender) wait(t *testing.T, n int) {
+	t.Helper()
+
+	for i := 0; i < 1000; i++ {
+		s.lock.Lock()
+		got := len(s.requests)
+		s.lock.Unlock()
+
+		if got >= n {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("timed out waiting for %d requests", n)
+}
+
+func testRecordChange(name string) []*route53.Change {
+	return []*route53.Change{
+		{
+			Action: aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: aws.String(name),
+				Type: aws.String(route53.RRTypeA),
+			},
+		},
+	}
+}
+
+// testRecordChangeWaitPending waits until the specified number of requests are waiting to be sent to the hosted zone.
+func testRecordChangeWaitPending(t *testing.T, batcher *recordChangeBatcher, zoneID string, n int) {
+	t.Helper()
+
+	for i := 0; i < 1000; i++ {
+		batcher.lock.Lock()
+		got := 0
+		if zone, ok := batcher.zones[recordChangeBatchKey{zoneID: zoneID}]; ok {
+			got = len(zone.pending)
+		}
+		batcher.lock.Unlock()
+
+		if got == n {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("timed out waiting for %d pending requests", n)
+}
+
+func TestRecordChangeBatcherSingle(t *testing.T) {
+	t.Parallel()
+
+	sender := &testRecordChangeSender{}
+	batcher := newRecordChangeBatcher(sender.send)
+
+	// A change to a hosted zone with no request in progress is sent at once.
+	changeInfo, err := batcher.submit(context.Background(), nil, "Z123456", testRecordChange("record"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := aws.StringValue(changeInfo.Id), "C1"; got != want {
+		t.Errorf("change ID = %q, want %q", got, want)
+	}
+	if got, want := len(sender.requests), 1; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestRecordChangeBatcher(t *testing.T) {
+	t.Parallel()
+
+	const (
+		records = 25
+		zoneID  = "Z123456"
+	)
+
+	sender := &testRecordChangeSender{release: make(chan struct{})}
+	batcher := newRecordChangeBatcher(sender.send)
+
+	names := []string{"blocker"}
+	for i := 1; i < records; i++ {
+		names = append(names, fmt.Sprintf("record%d", i))
+	}
+
+	errs := testRecordChangeSubmit(t, batcher, sender, zoneID, names)
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", names[i], err)
+		}
+	}
+
+	// Changes made while the first request is in progress are sent together once it completes.
+	if got, want := len(sender.requests), 2; got != want {
+		t.Fatalf("requests = %d, want %d", got, want)
+	}
+	if got, want := len(sender.requests[1]), records-1; got != want {
+		t.Errorf("batched changes = %d, want %d", got, want)
+	}
+}
+
+func TestRecordChangeBatcherInvalidChange(t *testing.T) {
+	t.Parallel()
+
+	const zoneID = "Z123456"
+
+	sender := &testRecordChangeSender{release: make(chan struct{})}
+	batcher := newRecordChangeBatcher(sender.send)
+
+	names := []string{"blocker", "valid1", "invalid", "valid2"}
+	errs := testRecordChangeSubmit(t, batcher, sender, zoneID, names)
+
+	// An invalid change fails only its own request.
+	for i, name := range names {
+		if gotErr, wantErr := errs[i] != nil, name == "invalid"; gotErr != wantErr {
+			t.Errorf("%s: error = %v, want error %t", name, errs[i], wantErr)
+		}
+	}
+
+	if got, want := len(sender.requests), 5; got != want {
+		t.Errorf("requests = %d, want %d (1 blocker, 1 batched, 3 individual)", got, want)
+	}
+}
+
+// testRecordChangeSubmit submits the first change, waits for it to be sent and then submits the others concurrently.
+// The first change's request is completed once all the others are waiting.
+func testRecordChangeSubmit(t *testing.T, batcher *recordChangeBatcher, sender *testRecordChangeSender, zoneID string, names []string) []error {
+	t.Helper()
+
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+
+			_, errs[i] = batcher.submit(context.Background(), nil, zoneID, testRecordChange(name))
+		}(i, name)
+
+		if i == 0 {
+			sender.wait(t, 1)
+		}
+	}
+
+	testRecordChangeWaitPending(t, batcher, zoneID, len(names)-1)
+	close(sender.release)
+	wg.Wait()
+
+	return errs
+}
+
+func TestRecordChangeBatcherCanceled(t *testing.T) {
+	t.Parallel()
+
+	const zoneID = "Z123456"
+
+	sender := &testRecordChangeSender{release: make(chan struct{})}
+	batcher := newRecordChangeBatcher(sender.send)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := batcher.submit(context.Background(), nil, zoneID, testRecordChange("blocker"))
+		done <- err
+	}()
+	sender.wait(t, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error, 1)
+	go func() {
+		_, err := batcher.submit(ctx, nil, zoneID, testRecordChange("canceled"))
+		canceled <- err
+	}()
+	testRecordChangeWaitPending(t, batcher, zoneID, 1)
+
+	// A caller whose Context is done before its changes are sent gets an error and its changes are dropped.
+	cancel()
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled: error = %v, want %v", err, context.Canceled)
+	}
+
+	close(sender.release)
+	if err := <-done; err != nil {
+		t.Errorf("blocker: unexpected error: %s", err)
+	}
+
+	sender.lock.Lock()
+	defer sender.lock.Unlock()
+
+	for _, request := range sender.requests {
+		for _, name := range request {
+			if name == "canceled" {
+				t.Error("canceled change sent")
+			}
+		}
+	}
+}
@@ -5,6 +5,8 @@ package rum
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -51,6 +54,44 @@ func ResourceMetricsDestination() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"metric_definition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dimension_keys": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"event_pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 237),
+						},
+						"unit_label": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"value_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 280),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -82,6 +123,12 @@ func resourceMetricsDestinationPut(ctx context.Context, d *schema.ResourceData,
 		d.SetId(name)
 	}
 
+	if d.HasChange("metric_definition") {
+		if err := updateMetricDefinitions(ctx, conn, d); err != nil {
+			return diag.Errorf("updating CloudWatch RUM Metrics Destination (%s) metric definitions: %s", name, err)
+		}
+	}
+
 	return resourceMetricsDestinationRead(ctx, d, meta)
 }
 
@@ -105,6 +152,16 @@ func resourceMetricsDestinationRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("destination_arn", dest.DestinationArn)
 	d.Set("iam_role_arn", dest.IamRoleArn)
 
+	defs, err := findMetricDefinitions(ctx, conn, d.Id(), aws.StringValue(dest.Destination), aws.StringValue(dest.DestinationArn))
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch RUM Metrics Destination (%s) metric definitions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("metric_definition", flattenMetricDefinitions(defs)); err != nil {
+		return diag.Errorf("setting metric_definition: %s", err)
+	}
+
 	return nil
 }
 
@@ -175,3 +232,168 @@ func FindMetricsDestinationByName(ctx context.Context, conn *cloudwatchrum.Cloud
 
 	return output[0], nil
 }
+
+func findMetricDefinitions(ctx context.Context, conn *cloudwatchrum.CloudWatchRUM, name, destination, destinationARN string) ([]*cloudwatchrum.MetricDefinition, error) {
+	input := &cloudwatchrum.BatchGetRumMetricDefinitionsInput{
+		AppMonitorName: aws.String(name),
+		Destination:    aws.String(destination),
+	}
+	if destinationARN != "" {
+		input.DestinationArn = aws.String(destinationARN)
+	}
+	var output []*cloudwatchrum.MetricDefinition
+
+	err := conn.BatchGetRumMetricDefinitionsPagesWithContext(ctx, input, func(page *cloudwatchrum.BatchGetRumMetricDefinitionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricDefinitions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchrum.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// updateMetricDefinitions replaces all of the destination's metric definitions with those configured.
+func updateMetricDefinitions(ctx context.Context, conn *cloudwatchrum.CloudWatchRUM, d *schema.ResourceData) error {
+	name := d.Id()
+	destination := d.Get("destination").(string)
+	destinationARN := d.Get("destination_arn").(string)
+
+	defs, err := findMetricDefinitions(ctx, conn, name, destination, destinationARN)
+
+	if err != nil {
+		return err
+	}
+
+	if len(defs) > 0 {
+		input := &cloudwatchrum.BatchDeleteRumMetricDefinitionsInput{
+			AppMonitorName: aws.String(name),
+			Destination:    aws.String(destination),
+		}
+		if destinationARN != "" {
+			input.DestinationArn = aws.String(destinationARN)
+		}
+		for _, v := range defs {
+			input.MetricDefinitionIds = append(input.MetricDefinitionIds, v.MetricDefinitionId)
+		}
+
+		output, err := conn.BatchDeleteRumMetricDefinitionsWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting metric definitions: %w", err)
+		}
+
+		var errs []error
+		for _, v := range output.Errors {
+			errs = append(errs, fmt.Errorf("deleting metric definition (%s): %s: %s", aws.StringValue(v.MetricDefinitionId), aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage)))
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("metric_definition"); ok && v.(*schema.Set).Len() > 0 {
+		input := &cloudwatchrum.BatchCreateRumMetricDefinitionsInput{
+			AppMonitorName:    aws.String(name),
+			Destination:       aws.String(destination),
+			MetricDefinitions: expandMetricDefinitionRequests(v.(*schema.Set).List()),
+		}
+		if destinationARN != "" {
+			input.DestinationArn = aws.String(destinationARN)
+		}
+
+		output, err := conn.BatchCreateRumMetricDefinitionsWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("creating metric definitions: %w", err)
+		}
+
+		var errs []error
+		for _, v := range output.Errors {
+			errs = append(errs, fmt.Errorf("creating metric definition (%s): %s: %s", aws.StringValue(v.MetricDefinition.Name), aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage)))
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandMetricDefinitionRequests(tfList []interface{}) []*cloudwatchrum.MetricDefinitionRequest {
+	var apiObjects []*cloudwatchrum.MetricDefinitionRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &cloudwatchrum.MetricDefinitionRequest{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["dimension_keys"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.DimensionKeys = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["event_pattern"].(string); ok && v != "" {
+			apiObject.EventPattern = aws.String(v)
+		}
+
+		if v, ok := tfMap["namespace"].(string); ok && v != "" {
+			apiObject.Namespace = aws.String(v)
+		}
+
+		if v, ok := tfMap["unit_label"].(string); ok && v != "" {
+			apiObject.UnitLabel = aws.String(v)
+		}
+
+		if v, ok := tfMap["value_key"].(string); ok && v != "" {
+			apiObject.ValueKey = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenMetricDefinitions(apiObjects []*cloudwatchrum.MetricDefinition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"dimension_keys": aws.StringValueMap(apiObject.DimensionKeys),
+			"event_pattern":  aws.StringValue(apiObject.EventPattern),
+			"name":           aws.StringValue(apiObject.Name),
+			"namespace":      aws.StringValue(apiObject.Namespace),
+			"unit_label":     aws.StringValue(apiObject.UnitLabel),
+			"value_key":      aws.StringValue(apiObject.ValueKey),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
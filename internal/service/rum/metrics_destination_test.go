@@ -48,6 +48,58 @@ func TestAccRUMMetricsDestination_basic(t *testing.T) {
 	})
 }
 
+func TestAccRUMMetricsDestination_metricDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dest cloudwatchrum.MetricDestinationSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rum_metrics_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RUMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricsDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricsDestinationConfig_metricDefinition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricsDestinationExists(ctx, resourceName, &dest),
+					resource.TestCheckResourceAttr(resourceName, "metric_definition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_definition.*", map[string]string{
+						"name":                                "JsErrorCount",
+						"dimension_keys.%":                    "1",
+						"dimension_keys.metadata.browserName": "BrowserName",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMetricsDestinationConfig_metricDefinitionUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricsDestinationExists(ctx, resourceName, &dest),
+					resource.TestCheckResourceAttr(resourceName, "metric_definition.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_definition.*", map[string]string{
+						"name":       "PerformanceNavigationDuration",
+						"value_key":  "event_details.duration",
+						"unit_label": "Milliseconds",
+					}),
+				),
+			},
+			{
+				Config: testAccMetricsDestinationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricsDestinationExists(ctx, resourceName, &dest),
+					resource.TestCheckResourceAttr(resourceName, "metric_definition.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRUMMetricsDestination_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dest cloudwatchrum.MetricDestinationSummary
@@ -161,3 +213,71 @@ resource "aws_rum_metrics_destination" "test" {
 }
 `, rName)
 }
+
+func testAccMetricsDestinationConfig_metricDefinition(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rum_app_monitor" "test" {
+  name   = %[1]q
+  domain = "localhost"
+}
+
+resource "aws_rum_metrics_destination" "test" {
+  app_monitor_name = aws_rum_app_monitor.test.name
+  destination      = "CloudWatch"
+
+  metric_definition {
+    name = "JsErrorCount"
+
+    dimension_keys = {
+      "metadata.browserName" = "BrowserName"
+    }
+
+    event_pattern = jsonencode({
+      event_type = ["com.amazon.rum.js_error_event"]
+      metadata = {
+        browserName = ["Chrome", "Safari"]
+      }
+    })
+  }
+}
+`, rName)
+}
+
+func testAccMetricsDestinationConfig_metricDefinitionUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rum_app_monitor" "test" {
+  name   = %[1]q
+  domain = "localhost"
+}
+
+resource "aws_rum_metrics_destination" "test" {
+  app_monitor_name = aws_rum_app_monitor.test.name
+  destination      = "CloudWatch"
+
+  metric_definition {
+    name = "JsErrorCount"
+
+    dimension_keys = {
+      "metadata.browserName" = "BrowserName"
+    }
+
+    event_pattern = jsonencode({
+      event_type = ["com.amazon.rum.js_error_event"]
+      metadata = {
+        browserName = ["Chrome", "Safari"]
+      }
+    })
+  }
+
+  metric_definition {
+    name       = "PerformanceNavigationDuration"
+    value_key  = "event_details.duration"
+    unit_label = "Milliseconds"
+
+    event_pattern = jsonencode({
+      event_type = ["com.amazon.rum.performance_navigation_event"]
+    })
+  }
+}
+`, rName)
+}
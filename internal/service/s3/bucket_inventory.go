@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -163,7 +164,8 @@ func resourceBucketInventory() *schema.Resource {
 						"frequency": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ValidateDiagFunc: enum.Validate[types.InventoryFrequency](),
+							ValidateDiagFunc: enum.ValidateIgnoreCase[types.InventoryFrequency](),
+							DiffSuppressFunc: sdkv2.SuppressEquivalentStringCaseInsensitive,
 						},
 					},
 				},
@@ -204,7 +206,7 @@ func resourceBucketInventoryPut(ctx context.Context, d *schema.ResourceData, met
 	if v, ok := d.GetOk("schedule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		tfMap := v.([]interface{})[0].(map[string]interface{})
 		inventoryConfiguration.Schedule = &types.InventorySchedule{
-			Frequency: types.InventoryFrequency(tfMap["frequency"].(string)),
+			Frequency: expandInventoryFrequency(tfMap["frequency"].(string)),
 		}
 	}
 
@@ -349,6 +351,17 @@ func flattenInventoryFilter(filter *types.InventoryFilter) []map[string]interfac
 	return result
 }
 
+// expandInventoryFrequency returns the inventory frequency matching the configured value, e.g. "Daily" for "daily".
+func expandInventoryFrequency(v string) types.InventoryFrequency {
+	for _, frequency := range enum.EnumValues[types.InventoryFrequency]() {
+		if strings.EqualFold(string(frequency), v) {
+			return frequency
+		}
+	}
+
+	return types.InventoryFrequency(v)
+}
+
 func flattenInventorySchedule(schedule *types.InventorySchedule) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 	m := map[string]interface{}{
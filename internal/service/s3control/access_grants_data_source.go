@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	DSNameAccessGrants = "Access Grants Data Source"
+)
+
+// @FrameworkDataSource(name="Access Grants")
+func newAccessGrantsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &accessGrantsDataSource{}, nil
+}
+
+type accessGrantsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *accessGrantsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_s3control_access_grants"
+}
+
+func (d *accessGrantsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"grantee_identifier": schema.StringAttribute{
+				Optional: true,
+			},
+			"grantee_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GranteeType](),
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"permission": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Permission](),
+				Optional:   true,
+			},
+			"s3_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access_grants": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[accessGrantsDataSourceAccessGrantModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"access_grant_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"access_grant_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"access_grants_location_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"application_arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"grant_scope": schema.StringAttribute{
+							Computed: true,
+						},
+						"permission": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Permission](),
+							Computed:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"access_grants_location_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[accessGrantsLocationConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"s3_sub_prefix": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"grantee": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[granteeModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"grantee_identifier": schema.StringAttribute{
+										Computed: true,
+									},
+									"grantee_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GranteeType](),
+										Computed:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *accessGrantsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accessGrantsDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(d.Meta().AccountID)
+	}
+	input := &s3control.ListAccessGrantsInput{
+		AccountId:         aws.String(data.AccountID.ValueString()),
+		GranteeIdentifier: fwflex.StringFromFramework(ctx, data.GranteeIdentifier),
+		GranteeType:       data.GranteeType.ValueEnum(),
+	}
+
+	// Permissions and grant scopes are filtered here so that READWRITE grants and grants for enclosing prefixes are included.
+	permission := data.Permission.ValueEnum()
+	s3Prefix := data.S3Prefix.ValueString()
+
+	var out struct {
+		AccessGrants []awstypes.ListAccessGrantEntry
+	}
+	pages := s3control.NewListAccessGrantsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.S3Control, create.ErrActionReading, DSNameAccessGrants, data.AccountID.ValueString(), err), err.Error())
+
+			return
+		}
+
+		for _, v := range page.AccessGrantsList {
+			if permission != "" && !accessGrantPermissionIncludes(v.Permission, permission) {
+				continue
+			}
+			if s3Prefix != "" && !accessGrantScopeIncludes(aws.ToString(v.GrantScope), s3Prefix) {
+				continue
+			}
+
+			out.AccessGrants = append(out.AccessGrants, v)
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.AccountID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// accessGrantPermissionIncludes returns whether a grant with the specified permission allows the wanted level of access.
+func accessGrantPermissionIncludes(granted, want awstypes.Permission) bool {
+	return granted == want || granted == awstypes.PermissionReadwrite
+}
+
+// accessGrantScopeIncludes returns whether a grant scope, e.g. "s3://bucket/prefix*", includes the specified S3 path.
+// Grant scopes ending in "*" include every path with the preceding prefix. Other grant scopes are for a single object.
+func accessGrantScopeIncludes(grantScope, s3Path string) bool {
+	if prefix, ok := strings.CutSuffix(grantScope, "*"); ok {
+		return strings.HasPrefix(s3Path, prefix)
+	}
+
+	return grantScope == s3Path
+}
+
+type accessGrantsDataSourceModel struct {
+	AccessGrants      fwtypes.ListNestedObjectValueOf[accessGrantsDataSourceAccessGrantModel] `tfsdk:"access_grants"`
+	AccountID         types.String                                                            `tfsdk:"account_id"`
+	GranteeIdentifier types.String                                                            `tfsdk:"grantee_identifier"`
+	GranteeType       fwtypes.StringEnum[awstypes.GranteeType]                                `tfsdk:"grantee_type"`
+	ID                types.String                                                            `tfsdk:"id"`
+	Permission        fwtypes.StringEnum[awstypes.Permission]                                 `tfsdk:"permission"`
+	S3Prefix          types.String                                                            `tfsdk:"s3_prefix"`
+}
+
+type accessGrantsDataSourceAccessGrantModel struct {
+	AccessGrantARN                    types.String                                                            `tfsdk:"access_grant_arn"`
+	AccessGrantID                     types.String                                                            `tfsdk:"access_grant_id"`
+	AccessGrantsLocationConfiguration fwtypes.ListNestedObjectValueOf[accessGrantsLocationConfigurationModel] `tfsdk:"access_grants_location_configuration"`
+	AccessGrantsLocationID            types.String                                                            `tfsdk:"access_grants_location_id"`
+	ApplicationARN                    types.String                                                            `tfsdk:"application_arn"`
+	GrantScope                        types.String                                                            `tfsdk:"grant_scope"`
+	Grantee                           fwtypes.ListNestedObjectValueOf[granteeModel]                           `tfsdk:"grantee"`
+	Permission                        fwtypes.StringEnum[awstypes.Permission]                                 `tfsdk:"permission"`
+}
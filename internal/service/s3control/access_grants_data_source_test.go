@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccessGrantScopeIncludes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		grantScope string
+		s3Path     string
+		want       bool
+	}{
+		{"s3://*", "s3://bucket/prefix/data.txt", true},
+		{"s3://bucket/*", "s3://bucket/prefix/data.txt", true},
+		{"s3://bucket/prefix*", "s3://bucket/prefix/data.txt", true},
+		{"s3://bucket/prefix*", "s3://bucket/prefix", true},
+		{"s3://bucket/prefix*", "s3://bucket/other/data.txt", false},
+		{"s3://bucket/prefix*", "s3://other-bucket/prefix/data.txt", false},
+		{"s3://bucket/prefix/data.txt", "s3://bucket/prefix/data.txt", true},
+		{"s3://bucket/prefix/data.txt", "s3://bucket/prefix/data.txt.bak", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfs3control.AccessGrantScopeIncludes(testCase.grantScope, testCase.s3Path); got != testCase.want {
+			t.Errorf("AccessGrantScopeIncludes(%q, %q) = %t, want %t", testCase.grantScope, testCase.s3Path, got, testCase.want)
+		}
+	}
+}
+
+func testAccAccessGrantsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3control_access_grants.test"
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.access_grant_arn", resourceName, "access_grant_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.access_grant_id", resourceName, "access_grant_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.access_grants_location_id", resourceName, "access_grants_location_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.grant_scope", resourceName, "grant_scope"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.0.grantee.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.grantee.0.grantee_identifier", resourceName, "grantee.0.grantee_identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.0.grantee.0.grantee_type", "IAM"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.0.permission", "READ"),
+					resource.TestCheckResourceAttr("data.aws_s3control_access_grants.other_prefix", "access_grants.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_s3control_access_grants.write", "access_grants.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_basic(rName), `
+data "aws_s3control_access_grants" "test" {
+  grantee_type       = "IAM"
+  grantee_identifier = aws_iam_user.test.arn
+  permission         = "READ"
+  s3_prefix          = "s3://${aws_s3_bucket.test.bucket}/${aws_s3_object.test.key}/data.txt"
+
+  depends_on = [aws_s3control_access_grant.test]
+}
+
+data "aws_s3control_access_grants" "other_prefix" {
+  grantee_type       = "IAM"
+  grantee_identifier = aws_iam_user.test.arn
+  s3_prefix          = "s3://${aws_s3_bucket.test.bucket}/other/data.txt"
+
+  depends_on = [aws_s3control_access_grant.test]
+}
+
+data "aws_s3control_access_grants" "write" {
+  grantee_type       = "IAM"
+  grantee_identifier = aws_iam_user.test.arn
+  permission         = "WRITE"
+
+  depends_on = [aws_s3control_access_grant.test]
+}
+`)
+}
@@ -31,6 +31,9 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 			"tags":                  testAccAccessGrant_tags,
 			"locationConfiguration": testAccAccessGrant_locationConfiguration,
 		},
+		"GrantsDataSource": {
+			"basic": testAccAccessGrantsDataSource_basic,
+		},
 		"InstanceResourcePolicy": {
 			"basic":      testAccAccessGrantsInstanceResourcePolicy_basic,
 			"disappears": testAccAccessGrantsInstanceResourcePolicy_disappears,
@@ -10,6 +10,7 @@ const (
 	errCodeInvalidBucketState                   = "InvalidBucketState"
 	errCodeInvalidIAMRole                       = "InvalidIamRole"
 	errCodeInvalidRequest                       = "InvalidRequest"
+	errCodeJobStatusException                   = "JobStatusException"
 	errCodeNoSuchAccessPoint                    = "NoSuchAccessPoint"
 	errCodeNoSuchAccessPointPolicy              = "NoSuchAccessPointPolicy"
 	errCodeNoSuchAsyncRequest                   = "NoSuchAsyncRequest"
@@ -0,0 +1,1464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_s3control_job", name="Job")
+// @Tags
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"confirmed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"manifest", "manifest_generator"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.JobManifestFieldName](),
+										},
+									},
+									"format": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.JobManifestFormat](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"manifest_generator": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_job_manifest_generator": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_manifest_output": {
+										Type:     schema.TypeBool,
+										Required: true,
+										ForceNew: true,
+									},
+									"expected_bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"created_after": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidUTCTimestamp,
+												},
+												"created_before": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidUTCTimestamp,
+												},
+												"eligible_for_replication": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+												"match_any_storage_class": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type:             schema.TypeString,
+														ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+													},
+												},
+												"object_replication_statuses": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type:             schema.TypeString,
+														ValidateDiagFunc: enum.Validate[types.ReplicationStatus](),
+													},
+												},
+												"object_size_greater_than_bytes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"object_size_less_than_bytes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"manifest_output_location": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"expected_manifest_bucket_owner": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidAccountID,
+												},
+												"manifest_format": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.GeneratedManifestFormat](),
+												},
+												"manifest_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"source_bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"operation.0.lambda_invoke", "operation.0.s3_initiate_restore_object", "operation.0.s3_put_object_copy", "operation.0.s3_put_object_tagging"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"invocation_schema_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"1.0", "2.0"}, false),
+									},
+									"user_arguments": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"glacier_job_tier": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3GlacierJobTier](),
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3CannedAccessControlList](),
+									},
+									"checksum_algorithm": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ChecksumAlgorithm](),
+									},
+									"metadata_directive": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3MetadataDirective](),
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"storage_class": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3StorageClass](),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportFormat](),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.JobReportScope](),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int32(int32(d.Get("priority").(int))),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		Tags:                 getTagsInS3(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_generator"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManifestGenerator = expandJobManifestGenerator(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Operation = expandJobOperation(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateJob(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Batch Operations Job: %s", err)
+	}
+
+	jobID := aws.ToString(output.JobId)
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{accountID, jobID}, jobResourceIDPartCount, false)))
+
+	if d.Get("confirmation_required").(bool) {
+		if d.Get("confirmed").(bool) {
+			if err := confirmJob(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return diag.Errorf("confirming S3 Batch Operations Job (%s): %s", d.Id(), err)
+			}
+		} else if _, err := waitJobPrepared(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("waiting for S3 Batch Operations Job (%s) preparation: %s", d.Id(), err)
+		}
+	}
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobResourceIDPartCount, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, jobID := parts[0], parts[1]
+	output, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", output.JobArn)
+	d.Set("confirmation_required", output.ConfirmationRequired)
+	d.Set("description", output.Description)
+	d.Set("job_id", output.JobId)
+	if output.Manifest != nil {
+		if err := d.Set("manifest", []interface{}{flattenJobManifest(output.Manifest)}); err != nil {
+			return diag.Errorf("setting manifest: %s", err)
+		}
+	} else {
+		d.Set("manifest", nil)
+	}
+	if output.ManifestGenerator != nil {
+		if err := d.Set("manifest_generator", []interface{}{flattenJobManifestGenerator(output.ManifestGenerator)}); err != nil {
+			return diag.Errorf("setting manifest_generator: %s", err)
+		}
+	} else {
+		d.Set("manifest_generator", nil)
+	}
+	if err := d.Set("operation", []interface{}{flattenJobOperation(ctx, output.Operation)}); err != nil {
+		return diag.Errorf("setting operation: %s", err)
+	}
+	d.Set("priority", output.Priority)
+	if err := d.Set("report", []interface{}{flattenJobReport(output.Report)}); err != nil {
+		return diag.Errorf("setting report: %s", err)
+	}
+	d.Set("role_arn", output.RoleArn)
+	d.Set("status", output.Status)
+
+	tags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return diag.Errorf("listing tags for S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	setTagsOutS3(ctx, tagsS3(tags))
+
+	return nil
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobResourceIDPartCount, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, jobID := parts[0], parts[1]
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  aws.Int32(int32(d.Get("priority").(int))),
+		}
+
+		_, err := conn.UpdateJobPriority(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) priority: %s", d.Id(), err)
+		}
+	}
+
+	// A job can't be returned to the awaiting-confirmation state, so setting `confirmed` to false is a no-op.
+	if d.HasChange("confirmed") && d.Get("confirmation_required").(bool) && d.Get("confirmed").(bool) {
+		if err := confirmJob(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("confirming S3 Batch Operations Job (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := jobUpdateTags(ctx, conn, accountID, jobID, o, n); err != nil {
+			return diag.Errorf("updating S3 Batch Operations Job (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceJobRead(ctx, d, meta)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobResourceIDPartCount, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, jobID := parts[0], parts[1]
+	output, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	// Jobs can't be deleted. S3 removes them 90 days after they finish.
+	// Jobs that haven't finished are cancelled.
+	if jobStatusIsTerminal(output.Status) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	_, err = conn.UpdateJobStatus(ctx, &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: types.RequestedJobStatusCancelled,
+		StatusUpdateReason: aws.String("Deleted by Terraform"),
+	})
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil
+	}
+
+	// The job finished in the meantime.
+	if tfawserr.ErrCodeEquals(err, errCodeJobStatusException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("cancelling S3 Batch Operations Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobFinished(ctx, conn, accountID, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for S3 Batch Operations Job (%s) cancel: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const (
+	jobResourceIDPartCount = 2
+)
+
+// confirmJob runs a job that was created with confirmation required, once S3 has finished preparing it.
+func confirmJob(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) error {
+	output, err := waitJobPrepared(ctx, conn, accountID, jobID, timeout)
+
+	if err != nil {
+		return fmt.Errorf("waiting for preparation: %w", err)
+	}
+
+	// The job has already been confirmed or cancelled.
+	if output.Status != types.JobStatusSuspended {
+		return nil
+	}
+
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: types.RequestedJobStatusReady,
+	}
+
+	_, err = conn.UpdateJobStatus(ctx, input)
+
+	return err
+}
+
+func jobStatusIsTerminal(status types.JobStatus) bool {
+	switch status {
+	case types.JobStatusCancelled, types.JobStatusComplete, types.JobStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, jobID string) (*types.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func statusJob(ctx context.Context, conn *s3control.Client, accountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// waitJobPrepared waits until S3 has read a job's manifest, after which a job that requires confirmation is Suspended.
+func waitJobPrepared(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.JobStatusNew, types.JobStatusPreparing),
+		Target:     enum.Slice(types.JobStatusActive, types.JobStatusCancelled, types.JobStatusCancelling, types.JobStatusComplete, types.JobStatusCompleting, types.JobStatusPaused, types.JobStatusPausing, types.JobStatusReady, types.JobStatusSuspended),
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		setJobFailureReasons(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobFinished(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*types.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.JobStatusActive, types.JobStatusCancelling, types.JobStatusCompleting, types.JobStatusFailing, types.JobStatusNew, types.JobStatusPaused, types.JobStatusPausing, types.JobStatusPreparing, types.JobStatusReady, types.JobStatusSuspended),
+		Target:     enum.Slice(types.JobStatusCancelled, types.JobStatusComplete, types.JobStatusFailed),
+		Refresh:    statusJob(ctx, conn, accountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func setJobFailureReasons(err error, apiObject *types.JobDescriptor) {
+	var failureReasons []error
+
+	for _, v := range apiObject.FailureReasons {
+		failureReasons = append(failureReasons, fmt.Errorf("%s: %s", aws.ToString(v.FailureCode), aws.ToString(v.FailureReason)))
+	}
+
+	tfresource.SetLastError(err, errors.Join(failureReasons...))
+}
+
+func jobListTags(ctx context.Context, conn *s3control.Client, accountID, jobID string) (tftags.KeyValueTags, error) {
+	input := &s3control.GetJobTaggingInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.GetJobTagging(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTagsS3(ctx, output.Tags), nil
+}
+
+func jobUpdateTags(ctx context.Context, conn *s3control.Client, accountID, jobID string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := jobListTags(ctx, conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("listing tags: %s", err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3control.PutJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Tags:      tagsS3(newTags.Merge(ignoredTags)),
+		}
+
+		_, err := conn.PutJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("setting tags: %s", err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3control.DeleteJobTaggingInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+		}
+
+		_, err := conn.DeleteJobTagging(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting tags: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *types.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifest{}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Location = expandJobManifestLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Spec = expandJobManifestSpec(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandJobManifestLocation(tfMap map[string]interface{}) *types.JobManifestLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestLocation{}
+
+	if v, ok := tfMap["etag"].(string); ok && v != "" {
+		apiObject.ETag = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+		apiObject.ObjectArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+		apiObject.ObjectVersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestSpec(tfMap map[string]interface{}) *types.JobManifestSpec {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestSpec{}
+
+	if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Fields = flex.ExpandStringyValueList[types.JobManifestFieldName](v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = types.JobManifestFormat(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestGenerator(tfMap map[string]interface{}) types.JobManifestGenerator {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["s3_job_manifest_generator"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return &types.JobManifestGeneratorMemberS3JobManifestGenerator{
+			Value: expandS3JobManifestGenerator(v[0].(map[string]interface{})),
+		}
+	}
+
+	return nil
+}
+
+func expandS3JobManifestGenerator(tfMap map[string]interface{}) types.S3JobManifestGenerator {
+	apiObject := types.S3JobManifestGenerator{}
+
+	if v, ok := tfMap["enable_manifest_output"].(bool); ok {
+		apiObject.EnableManifestOutput = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["expected_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandJobManifestGeneratorFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["manifest_output_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManifestOutputLocation = expandS3ManifestOutputLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["source_bucket"].(string); ok && v != "" {
+		apiObject.SourceBucket = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestGeneratorFilter(tfMap map[string]interface{}) *types.JobManifestGeneratorFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobManifestGeneratorFilter{}
+
+	if v, ok := tfMap["created_after"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedAfter = aws.Time(v)
+	}
+
+	if v, ok := tfMap["created_before"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+		apiObject.CreatedBefore = aws.Time(v)
+	}
+
+	if v, ok := tfMap["eligible_for_replication"].(bool); ok && v {
+		apiObject.EligibleForReplication = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["match_any_storage_class"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchAnyStorageClass = flex.ExpandStringyValueSet[types.S3StorageClass](v)
+	}
+
+	if v, ok := tfMap["object_replication_statuses"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ObjectReplicationStatuses = flex.ExpandStringyValueSet[types.ReplicationStatus](v)
+	}
+
+	if v, ok := tfMap["object_size_greater_than_bytes"].(int); ok && v != 0 {
+		apiObject.ObjectSizeGreaterThanBytes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["object_size_less_than_bytes"].(int); ok && v != 0 {
+		apiObject.ObjectSizeLessThanBytes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandS3ManifestOutputLocation(tfMap map[string]interface{}) *types.S3ManifestOutputLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ManifestOutputLocation{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["expected_manifest_bucket_owner"].(string); ok && v != "" {
+		apiObject.ExpectedManifestBucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_format"].(string); ok && v != "" {
+		apiObject.ManifestFormat = types.GeneratedManifestFormat(v)
+	}
+
+	if v, ok := tfMap["manifest_prefix"].(string); ok && v != "" {
+		apiObject.ManifestPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(ctx context.Context, tfMap map[string]interface{}) *types.JobOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LambdaInvoke = expandLambdaInvokeOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 {
+		// All of the block's arguments are optional.
+		tfMap, _ := v[0].(map[string]interface{})
+		apiObject.S3InitiateRestoreObject = expandS3InitiateRestoreObjectOperation(tfMap)
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(ctx, v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		// All of the block's arguments are optional.
+		tfMap, _ := v[0].(map[string]interface{})
+		apiObject.S3PutObjectTagging = expandS3SetObjectTaggingOperation(ctx, tfMap)
+	}
+
+	return apiObject
+}
+
+func expandLambdaInvokeOperation(tfMap map[string]interface{}) *types.LambdaInvokeOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.LambdaInvokeOperation{}
+
+	if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["invocation_schema_version"].(string); ok && v != "" {
+		apiObject.InvocationSchemaVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_arguments"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserArguments = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandS3InitiateRestoreObjectOperation(tfMap map[string]interface{}) *types.S3InitiateRestoreObjectOperation {
+	apiObject := &types.S3InitiateRestoreObjectOperation{}
+
+	if v, ok := tfMap["expiration_in_days"].(int); ok && v != 0 {
+		apiObject.ExpirationInDays = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+		apiObject.GlacierJobTier = types.S3GlacierJobTier(v)
+	}
+
+	return apiObject
+}
+
+func expandS3CopyObjectOperation(ctx context.Context, tfMap map[string]interface{}) *types.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok && v {
+		apiObject.BucketKeyEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = types.S3CannedAccessControlList(v)
+	}
+
+	if v, ok := tfMap["checksum_algorithm"].(string); ok && v != "" {
+		apiObject.ChecksumAlgorithm = types.S3ChecksumAlgorithm(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = types.S3MetadataDirective(v)
+	}
+
+	if v, ok := tfMap["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.NewObjectTagging = tagsS3(tftags.New(ctx, v))
+	}
+
+	if v, ok := tfMap["requester_pays"].(bool); ok && v {
+		apiObject.RequesterPays = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = types.S3StorageClass(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3SetObjectTaggingOperation(ctx context.Context, tfMap map[string]interface{}) *types.S3SetObjectTaggingOperation {
+	apiObject := &types.S3SetObjectTaggingOperation{}
+
+	if v, ok := tfMap["tag_set"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagSet = tagsS3(tftags.New(ctx, v))
+	}
+
+	return apiObject
+}
+
+func expandJobReport(tfMap map[string]interface{}) *types.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.JobReport{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = types.JobReportFormat(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = types.JobReportScope(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobManifest(apiObject *types.JobManifest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = []interface{}{flattenJobManifestLocation(v)}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{flattenJobManifestSpec(v)}
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestLocation(apiObject *types.JobManifestLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ETag; v != nil {
+		tfMap["etag"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ObjectArn; v != nil {
+		tfMap["object_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ObjectVersionId; v != nil {
+		tfMap["object_version_id"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestSpec(apiObject *types.JobManifestSpec) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"fields": flex.FlattenStringyValueList(apiObject.Fields),
+		"format": apiObject.Format,
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestGenerator(apiObject types.JobManifestGenerator) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.JobManifestGeneratorMemberS3JobManifestGenerator:
+		tfMap["s3_job_manifest_generator"] = []interface{}{flattenS3JobManifestGenerator(v.Value)}
+	}
+
+	return tfMap
+}
+
+func flattenS3JobManifestGenerator(apiObject types.S3JobManifestGenerator) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"enable_manifest_output": aws.ToBool(apiObject.EnableManifestOutput),
+	}
+
+	if v := apiObject.ExpectedBucketOwner; v != nil {
+		tfMap["expected_bucket_owner"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = []interface{}{flattenJobManifestGeneratorFilter(v)}
+	}
+
+	if v := apiObject.ManifestOutputLocation; v != nil {
+		tfMap["manifest_output_location"] = []interface{}{flattenS3ManifestOutputLocation(v)}
+	}
+
+	if v := apiObject.SourceBucket; v != nil {
+		tfMap["source_bucket"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenJobManifestGeneratorFilter(apiObject *types.JobManifestGeneratorFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"eligible_for_replication":    aws.ToBool(apiObject.EligibleForReplication),
+		"match_any_storage_class":     flex.FlattenStringyValueSet(apiObject.MatchAnyStorageClass),
+		"object_replication_statuses": flex.FlattenStringyValueSet(apiObject.ObjectReplicationStatuses),
+	}
+
+	if v := apiObject.CreatedAfter; v != nil {
+		tfMap["created_after"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.CreatedBefore; v != nil {
+		tfMap["created_before"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ObjectSizeGreaterThanBytes; v != nil {
+		tfMap["object_size_greater_than_bytes"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.ObjectSizeLessThanBytes; v != nil {
+		tfMap["object_size_less_than_bytes"] = aws.ToInt64(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3ManifestOutputLocation(apiObject *types.S3ManifestOutputLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"manifest_format": apiObject.ManifestFormat,
+	}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap["bucket"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ExpectedManifestBucketOwner; v != nil {
+		tfMap["expected_manifest_bucket_owner"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ManifestPrefix; v != nil {
+		tfMap["manifest_prefix"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenJobOperation(ctx context.Context, apiObject *types.JobOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{flattenLambdaInvokeOperation(v)}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{flattenS3InitiateRestoreObjectOperation(v)}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{flattenS3CopyObjectOperation(ctx, v)}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tfMap["s3_put_object_tagging"] = []interface{}{flattenS3SetObjectTaggingOperation(ctx, v)}
+	}
+
+	return tfMap
+}
+
+func flattenLambdaInvokeOperation(apiObject *types.LambdaInvokeOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"user_arguments": apiObject.UserArguments,
+	}
+
+	if v := apiObject.FunctionArn; v != nil {
+		tfMap["function_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.InvocationSchemaVersion; v != nil {
+		tfMap["invocation_schema_version"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3InitiateRestoreObjectOperation(apiObject *types.S3InitiateRestoreObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"glacier_job_tier": apiObject.GlacierJobTier,
+	}
+
+	if v := apiObject.ExpirationInDays; v != nil {
+		tfMap["expiration_in_days"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3CopyObjectOperation(ctx context.Context, apiObject *types.S3CopyObjectOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_key_enabled":         aws.ToBool(apiObject.BucketKeyEnabled),
+		"canned_access_control_list": apiObject.CannedAccessControlList,
+		"checksum_algorithm":         apiObject.ChecksumAlgorithm,
+		"metadata_directive":         apiObject.MetadataDirective,
+		"new_object_tagging":         keyValueTagsS3(ctx, apiObject.NewObjectTagging).Map(),
+		"requester_pays":             aws.ToBool(apiObject.RequesterPays),
+		"storage_class":              apiObject.StorageClass,
+	}
+
+	if v := apiObject.SSEAwsKmsKeyId; v != nil {
+		tfMap["sse_aws_kms_key_id"] = aws.ToString(v)
+	}
+
+	if v := apiObject.TargetKeyPrefix; v != nil {
+		tfMap["target_key_prefix"] = aws.ToString(v)
+	}
+
+	if v := apiObject.TargetResource; v != nil {
+		tfMap["target_resource"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3SetObjectTaggingOperation(ctx context.Context, apiObject *types.S3SetObjectTaggingOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"tag_set": keyValueTagsS3(ctx, apiObject.TagSet).Map(),
+	}
+
+	return tfMap
+}
+
+func flattenJobReport(apiObject *types.JobReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":      aws.ToBool(apiObject.Enabled),
+		"format":       apiObject.Format,
+		"report_scope": apiObject.ReportScope,
+	}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap["bucket"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
@@ -15,7 +15,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newAccessGrantsDataSource,
+			Name:    "Access Grants",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -85,6 +90,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBucketPolicy,
 			TypeName: "aws_s3control_bucket_policy",
 		},
+		{
+			Factory:  resourceJob,
+			TypeName: "aws_s3control_job",
+			Name:     "Job",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourceMultiRegionAccessPoint,
 			TypeName: "aws_s3control_multi_region_access_point",
@@ -94,3 +94,59 @@ func findServiceQuotaByID(ctx context.Context, conn *servicequotas.Client, servi
 
 	return output.Quota, nil
 }
+
+// findOpenRequestedServiceQuotaChangeByQuota returns the most recent quota increase request
+// for the specified quota that is still awaiting a decision.
+func findOpenRequestedServiceQuotaChangeByQuota(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string) (*types.RequestedServiceQuotaChange, error) {
+	input := &servicequotas.ListRequestedServiceQuotaChangeHistoryByQuotaInput{
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	var output *types.RequestedServiceQuotaChange
+
+	paginator := servicequotas.NewListRequestedServiceQuotaChangeHistoryByQuotaPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		var nsr *types.NoSuchResourceException
+		if errors.As(err, &nsr) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = latestOpenRequestedServiceQuotaChange(output, page.RequestedQuotas)
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// latestOpenRequestedServiceQuotaChange returns the most recently created of the specified request and the
+// requests in the list that are still awaiting a decision.
+func latestOpenRequestedServiceQuotaChange(latest *types.RequestedServiceQuotaChange, requests []types.RequestedServiceQuotaChange) *types.RequestedServiceQuotaChange {
+	for _, v := range requests {
+		v := v
+
+		switch v.Status {
+		case types.RequestStatusCaseOpened, types.RequestStatusPending:
+		default:
+			continue
+		}
+
+		if latest == nil || aws.ToTime(v.Created).After(aws.ToTime(latest.Created)) {
+			latest = &v
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+)
+
+func TestLatestOpenRequestedServiceQuotaChange(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	request := func(id string, status types.RequestStatus, age time.Duration) types.RequestedServiceQuotaChange {
+		return types.RequestedServiceQuotaChange{
+			Created: aws.Time(created.Add(-age)),
+			Id:      aws.String(id),
+			Status:  status,
+		}
+	}
+	earlierPage := request("earlier-page", types.RequestStatusPending, 30*time.Minute)
+
+	testCases := map[string]struct {
+		latest   *types.RequestedServiceQuotaChange
+		requests []types.RequestedServiceQuotaChange
+		expected string
+	}{
+		"none": {},
+		"no open requests": {
+			requests: []types.RequestedServiceQuotaChange{
+				request("approved", types.RequestStatusApproved, 0),
+				request("denied", types.RequestStatusDenied, time.Hour),
+				request("closed", types.RequestStatusCaseClosed, 2*time.Hour),
+			},
+		},
+		"most recent open request": {
+			requests: []types.RequestedServiceQuotaChange{
+				request("older", types.RequestStatusPending, 2*time.Hour),
+				request("approved", types.RequestStatusApproved, 0),
+				request("newer", types.RequestStatusCaseOpened, time.Hour),
+			},
+			expected: "newer",
+		},
+		"earlier page more recent": {
+			latest: &earlierPage,
+			requests: []types.RequestedServiceQuotaChange{
+				request("older", types.RequestStatusPending, time.Hour),
+			},
+			expected: "earlier-page",
+		},
+		"earlier page less recent": {
+			latest: &earlierPage,
+			requests: []types.RequestedServiceQuotaChange{
+				request("newer", types.RequestStatusPending, 0),
+			},
+			expected: "newer",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := latestOpenRequestedServiceQuotaChange(testCase.latest, testCase.requests)
+
+			if testCase.expected == "" {
+				if got != nil {
+					t.Errorf("latestOpenRequestedServiceQuotaChange() = %s, want nil", aws.ToString(got.Id))
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("latestOpenRequestedServiceQuotaChange() = nil, want %s", testCase.expected)
+			}
+
+			if got, want := aws.ToString(got.Id), testCase.expected; got != want {
+				t.Errorf("latestOpenRequestedServiceQuotaChange() = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -160,23 +160,13 @@ func resourceServiceQuotaCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if value > quotaValue {
-		input := &servicequotas.RequestServiceQuotaIncreaseInput{
-			DesiredValue: aws.Float64(value),
-			QuotaCode:    aws.String(quotaCode),
-			ServiceCode:  aws.String(serviceCode),
-		}
-
-		output, err := conn.RequestServiceQuotaIncrease(ctx, input)
+		requestID, err := requestServiceQuotaIncrease(ctx, conn, serviceCode, quotaCode, value)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: %s", d.Id(), err)
 		}
 
-		if output == nil || output.RequestedQuota == nil {
-			return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: empty result", d.Id())
-		}
-
-		d.Set("request_id", output.RequestedQuota.Id)
+		d.Set("request_id", requestID)
 	}
 
 	return append(diags, resourceServiceQuotaRead(ctx, d, meta)...)
@@ -224,6 +214,20 @@ func resourceServiceQuotaRead(ctx context.Context, d *schema.ResourceData, meta
 
 	requestID := d.Get("request_id").(string)
 
+	// Pick up requests opened outside of this resource's state, e.g. after import or in a previous apply.
+	if requestID == "" {
+		openRequest, err := findOpenRequestedServiceQuotaChangeByQuota(ctx, conn, serviceCode, quotaCode)
+
+		if err != nil && !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "listing Service Quota (%s) open requests: %s", d.Id(), err)
+		}
+
+		if openRequest != nil {
+			requestID = aws.ToString(openRequest.Id)
+			d.Set("request_id", requestID)
+		}
+	}
+
 	if requestID != "" {
 		input := &servicequotas.GetRequestedServiceQuotaChangeInput{
 			RequestId: aws.String(requestID),
@@ -248,9 +252,12 @@ func resourceServiceQuotaRead(ctx context.Context, d *schema.ResourceData, meta
 
 		d.Set("request_status", output.RequestedQuota.Status)
 
-		switch output.RequestedQuota.Status {
-		case types.RequestStatusApproved, types.RequestStatusCaseClosed, types.RequestStatusDenied:
+		switch status := output.RequestedQuota.Status; status {
+		case types.RequestStatusApproved:
+			d.Set("request_id", "")
+		case types.RequestStatusCaseClosed, types.RequestStatusDenied, types.RequestStatusInvalidRequest, types.RequestStatusNotApproved:
 			d.Set("request_id", "")
+			diags = sdkdiag.AppendWarningf(diags, "Service Quota (%s) increase request (%s) to %g was not approved: %s", d.Id(), requestID, aws.ToFloat64(output.RequestedQuota.DesiredValue), status)
 		case types.RequestStatusCaseOpened, types.RequestStatusPending:
 			d.Set("value", output.RequestedQuota.DesiredValue)
 		}
@@ -270,6 +277,31 @@ func resourceServiceQuotaUpdate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "deleting Service Quota (%s): %s", d.Id(), err)
 	}
 
+	requestID, err := requestServiceQuotaIncrease(ctx, conn, serviceCode, quotaCode, value)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: %s", d.Id(), err)
+	}
+
+	d.Set("request_id", requestID)
+
+	return append(diags, resourceServiceQuotaRead(ctx, d, meta)...)
+}
+
+// requestServiceQuotaIncrease requests an increase of the specified quota to the desired value.
+// If an increase to the same value is already awaiting a decision, that request is tracked instead
+// of opening a new one.
+func requestServiceQuotaIncrease(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string, value float64) (string, error) {
+	openRequest, err := findOpenRequestedServiceQuotaChangeByQuota(ctx, conn, serviceCode, quotaCode)
+
+	if err != nil && !tfresource.NotFound(err) {
+		return "", fmt.Errorf("listing open requests: %w", err)
+	}
+
+	if openRequest != nil && aws.ToFloat64(openRequest.DesiredValue) == value {
+		return aws.ToString(openRequest.Id), nil
+	}
+
 	input := &servicequotas.RequestServiceQuotaIncreaseInput{
 		DesiredValue: aws.Float64(value),
 		QuotaCode:    aws.String(quotaCode),
@@ -279,16 +311,14 @@ func resourceServiceQuotaUpdate(ctx context.Context, d *schema.ResourceData, met
 	output, err := conn.RequestServiceQuotaIncrease(ctx, input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: %s", d.Id(), err)
+		return "", err
 	}
 
 	if output == nil || output.RequestedQuota == nil {
-		return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: empty result", d.Id())
+		return "", tfresource.NewEmptyResultError(input)
 	}
 
-	d.Set("request_id", output.RequestedQuota.Id)
-
-	return append(diags, resourceServiceQuotaRead(ctx, d, meta)...)
+	return aws.ToString(output.RequestedQuota.Id), nil
 }
 
 func resourceServiceQuotaParseID(id string) (string, string, error) {
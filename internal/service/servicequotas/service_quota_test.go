@@ -177,8 +177,15 @@ func TestAccServiceQuotasServiceQuota_Value_increaseOnCreate(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "service_code", serviceCode),
 					resource.TestCheckResourceAttr(resourceName, "value", value),
 					resource.TestCheckResourceAttrSet(resourceName, "request_id"),
+					resource.TestMatchResourceAttr(resourceName, "request_status", regexache.MustCompile(`^(PENDING|CASE_OPENED)$`)),
 				),
 			},
+			// The open increase request is discovered on import.
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,603 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snowball
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/snowball"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/snowball/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_snowball_job", name="Job")
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"forwarding_address_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"job_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.JobType](),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_pickup_sns_topic_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"job_states_to_notify": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.JobState](),
+							},
+						},
+						"notify_all": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sns_topic_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"remote_management": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RemoteManagement](),
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2_ami_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ami_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"snowball_ami_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"s3_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"key_range": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"begin_marker": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"end_marker": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"shipping_option": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ShippingOption](),
+			},
+			"snowball_capacity_preference": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.SnowballCapacity](),
+			},
+			"snowball_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.SnowballType](),
+			},
+		},
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballClient(ctx)
+
+	input := &snowball.CreateJobInput{
+		AddressId:      aws.String(d.Get("address_id").(string)),
+		JobType:        awstypes.JobType(d.Get("job_type").(string)),
+		ShippingOption: awstypes.ShippingOption(d.Get("shipping_option").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("forwarding_address_id"); ok {
+		input.ForwardingAddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("remote_management"); ok {
+		input.RemoteManagement = awstypes.RemoteManagement(v.(string))
+	}
+
+	if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_capacity_preference"); ok {
+		input.SnowballCapacityPreference = awstypes.SnowballCapacity(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_type"); ok {
+		input.SnowballType = awstypes.SnowballType(v.(string))
+	}
+
+	output, err := conn.CreateJob(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Snowball Job: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.JobId))
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballClient(ctx)
+
+	job, err := findJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snowball Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Snowball Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("address_id", job.AddressId)
+	if job.CreationDate != nil {
+		d.Set("creation_date", aws.ToTime(job.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("description", job.Description)
+	d.Set("forwarding_address_id", job.ForwardingAddressId)
+	d.Set("job_state", job.JobState)
+	d.Set("job_type", job.JobType)
+	d.Set("kms_key_arn", job.KmsKeyARN)
+	if job.Notification != nil {
+		if err := d.Set("notification", []interface{}{flattenNotification(job.Notification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting notification: %s", err)
+		}
+	} else {
+		d.Set("notification", nil)
+	}
+	d.Set("remote_management", job.RemoteManagement)
+	if job.Resources != nil {
+		if err := d.Set("resources", []interface{}{flattenJobResource(job.Resources)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+		}
+	} else {
+		d.Set("resources", nil)
+	}
+	d.Set("role_arn", job.RoleARN)
+	if job.ShippingDetails != nil {
+		d.Set("shipping_option", job.ShippingDetails.ShippingOption)
+	}
+	d.Set("snowball_capacity_preference", job.SnowballCapacityPreference)
+	d.Set("snowball_type", job.SnowballType)
+
+	return diags
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballClient(ctx)
+
+	// Jobs can only be updated while they are in the New state.
+	input := &snowball.UpdateJobInput{
+		AddressId:      aws.String(d.Get("address_id").(string)),
+		JobId:          aws.String(d.Id()),
+		ShippingOption: awstypes.ShippingOption(d.Get("shipping_option").(string)),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("forwarding_address_id") {
+		input.ForwardingAddressId = aws.String(d.Get("forwarding_address_id").(string))
+	}
+
+	if d.HasChange("notification") {
+		input.Notification = &awstypes.Notification{}
+		if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("resources") {
+		input.Resources = &awstypes.JobResource{}
+		if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleARN = aws.String(d.Get("role_arn").(string))
+	}
+
+	if d.HasChange("snowball_capacity_preference") {
+		input.SnowballCapacityPreference = awstypes.SnowballCapacity(d.Get("snowball_capacity_preference").(string))
+	}
+
+	_, err := conn.UpdateJob(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Snowball Job (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballClient(ctx)
+
+	// Completed jobs can't be cancelled and have no further lifecycle.
+	if v := d.Get("job_state").(string); v == string(awstypes.JobStateComplete) {
+		log.Printf("[DEBUG] Snowball Job (%s) is %s, removing from state", d.Id(), v)
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling Snowball Job: %s", d.Id())
+	_, err := conn.CancelJob(ctx, &snowball.CancelJobInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.InvalidResourceException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Snowball Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Snowball Job (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// findJobByID returns the metadata of the specified job.
+// Cancelled jobs are treated as not found.
+func findJobByID(ctx context.Context, conn *snowball.Client, id string) (*awstypes.JobMetadata, error) {
+	output, err := findJobByIDIncludingCancelled(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.JobState; state == awstypes.JobStateCancelled {
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output, nil
+}
+
+func findJobByIDIncludingCancelled(ctx context.Context, conn *snowball.Client, id string) (*awstypes.JobMetadata, error) {
+	input := &snowball.DescribeJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*awstypes.InvalidResourceException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobMetadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JobMetadata, nil
+}
+
+func statusJob(ctx context.Context, conn *snowball.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByIDIncludingCancelled(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobState), nil
+	}
+}
+
+func waitJobCancelled(ctx context.Context, conn *snowball.Client, id string, timeout time.Duration) (*awstypes.JobMetadata, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.JobStateNew,
+			awstypes.JobStatePreparingAppliance,
+			awstypes.JobStatePreparingShipment,
+			awstypes.JobStatePending,
+		),
+		Target:  enum.Slice(awstypes.JobStateCancelled),
+		Refresh: statusJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.JobMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandNotification(tfMap map[string]interface{}) *awstypes.Notification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.Notification{}
+
+	if v, ok := tfMap["device_pickup_sns_topic_arn"].(string); ok && v != "" {
+		apiObject.DevicePickupSnsTopicARN = aws.String(v)
+	}
+
+	if v, ok := tfMap["job_states_to_notify"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JobStatesToNotify = flex.ExpandStringyValueSet[awstypes.JobState](v)
+	}
+
+	if v, ok := tfMap["notify_all"].(bool); ok {
+		apiObject.NotifyAll = v
+	}
+
+	if v, ok := tfMap["sns_topic_arn"].(string); ok && v != "" {
+		apiObject.SnsTopicARN = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenNotification(apiObject *awstypes.Notification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"device_pickup_sns_topic_arn": aws.ToString(apiObject.DevicePickupSnsTopicARN),
+		"job_states_to_notify":        flex.FlattenStringyValueSet(apiObject.JobStatesToNotify),
+		"notify_all":                  apiObject.NotifyAll,
+		"sns_topic_arn":               aws.ToString(apiObject.SnsTopicARN),
+	}
+
+	return tfMap
+}
+
+func expandJobResource(tfMap map[string]interface{}) *awstypes.JobResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.JobResource{}
+
+	if v, ok := tfMap["ec2_ami_resource"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			ec2AMIResource := awstypes.Ec2AmiResource{
+				AmiId: aws.String(tfMap["ami_id"].(string)),
+			}
+
+			if v, ok := tfMap["snowball_ami_id"].(string); ok && v != "" {
+				ec2AMIResource.SnowballAmiId = aws.String(v)
+			}
+
+			apiObject.Ec2AmiResources = append(apiObject.Ec2AmiResources, ec2AMIResource)
+		}
+	}
+
+	if v, ok := tfMap["s3_resource"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			s3Resource := awstypes.S3Resource{
+				BucketArn: aws.String(tfMap["bucket_arn"].(string)),
+			}
+
+			if v, ok := tfMap["key_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				keyRange := &awstypes.KeyRange{}
+
+				if v, ok := tfMap["begin_marker"].(string); ok && v != "" {
+					keyRange.BeginMarker = aws.String(v)
+				}
+
+				if v, ok := tfMap["end_marker"].(string); ok && v != "" {
+					keyRange.EndMarker = aws.String(v)
+				}
+
+				s3Resource.KeyRange = keyRange
+			}
+
+			apiObject.S3Resources = append(apiObject.S3Resources, s3Resource)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenJobResource(apiObject *awstypes.JobResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var ec2AMIResources []interface{}
+	for _, v := range apiObject.Ec2AmiResources {
+		ec2AMIResources = append(ec2AMIResources, map[string]interface{}{
+			"ami_id":          aws.ToString(v.AmiId),
+			"snowball_ami_id": aws.ToString(v.SnowballAmiId),
+		})
+	}
+
+	var s3Resources []interface{}
+	for _, v := range apiObject.S3Resources {
+		tfMap := map[string]interface{}{
+			"bucket_arn": aws.ToString(v.BucketArn),
+		}
+
+		if v := v.KeyRange; v != nil {
+			tfMap["key_range"] = []interface{}{map[string]interface{}{
+				"begin_marker": aws.ToString(v.BeginMarker),
+				"end_marker":   aws.ToString(v.EndMarker),
+			}}
+		}
+
+		s3Resources = append(s3Resources, tfMap)
+	}
+
+	tfMap := map[string]interface{}{
+		"ec2_ami_resource": ec2AMIResources,
+		"s3_resource":      s3Resources,
+	}
+
+	return tfMap
+}
@@ -218,29 +218,41 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn(ctx)
 
-	input := &ssm.GetParameterInput{
-		Name:           aws.String(d.Id()),
-		WithDecryption: aws.Bool(true),
-	}
-
-	var resp *ssm.GetParameterOutput
-	err := retry.RetryContext(ctx, parameterCreationValidationTimeout, func() *retry.RetryError {
-		var err error
-		resp, err = conn.GetParameterWithContext(ctx, input)
+	var param *ssm.Parameter
+	var err error
 
-		if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) && d.IsNewResource() && d.Get("data_type").(string) == "aws:ec2:image" {
-			return retry.RetryableError(fmt.Errorf("reading SSM Parameter (%s) after creation: this can indicate that the provided parameter value could not be validated by SSM", d.Id()))
+	if d.IsNewResource() {
+		input := &ssm.GetParameterInput{
+			Name:           aws.String(d.Id()),
+			WithDecryption: aws.Bool(true),
 		}
 
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
+		var resp *ssm.GetParameterOutput
+		err = retry.RetryContext(ctx, parameterCreationValidationTimeout, func() *retry.RetryError {
+			var err error
+			resp, err = conn.GetParameterWithContext(ctx, input)
 
-		return nil
-	})
+			if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) && d.Get("data_type").(string) == "aws:ec2:image" {
+				return retry.RetryableError(fmt.Errorf("reading SSM Parameter (%s) after creation: this can indicate that the provided parameter value could not be validated by SSM", d.Id()))
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
 
-	if tfresource.TimedOut(err) {
-		resp, err = conn.GetParameterWithContext(ctx, input)
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			resp, err = conn.GetParameterWithContext(ctx, input)
+		}
+
+		if err == nil {
+			param = resp.Parameter
+		}
+	} else {
+		// Reads of existing parameters, e.g. during refresh, are batched into GetParameters calls.
+		param, err = parameterReads.get(ctx, conn, d.Id())
 	}
 
 	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) && !d.IsNewResource() {
@@ -253,7 +265,6 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading SSM Parameter (%s): %s", d.Id(), err)
 	}
 
-	param := resp.Parameter
 	d.Set("arn", param.ARN)
 	name := aws.StringValue(param.Name)
 	d.Set("name", name)
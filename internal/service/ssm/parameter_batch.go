@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+const (
+	// Maximum number of names in a single GetParameters request.
+	parameterReadBatchMaxNames = 10
+)
+
+var (
+	// How long reads of parameters are collected before being sent together.
+	parameterReadBatchWindow = 100 * time.Millisecond
+
+	parameterReads = newParameterReadBatcher(func(ctx context.Context, conn *ssm.SSM, names []string) (*ssm.GetParametersOutput, error) {
+		input := &ssm.GetParametersInput{
+			Names:          aws.StringSlice(names),
+			WithDecryption: aws.Bool(true),
+		}
+
+		return conn.GetParametersWithContext(ctx, input)
+	})
+)
+
+type parameterReadSendFunc func(context.Context, *ssm.SSM, []string) (*ssm.GetParametersOutput, error)
+
+// parameterReadBatcher coalesces reads of parameters, made concurrently by different resources during a refresh,
+// into GetParameters calls of up to 10 parameters each.
+type parameterReadBatcher struct {
+	lock    sync.Mutex
+	pending map[*ssm.SSM]*parameterReadBatch
+	send    parameterReadSendFunc
+}
+
+type parameterReadBatch struct {
+	ctx      context.Context
+	requests []*parameterReadRequest
+}
+
+type parameterReadRequest struct {
+	name string
+	done chan parameterReadResult
+}
+
+type parameterReadResult struct {
+	parameter *ssm.Parameter
+	err       error
+}
+
+func newParameterReadBatcher(send parameterReadSendFunc) *parameterReadBatcher {
+	return &parameterReadBatcher{
+		pending: make(map[*ssm.SSM]*parameterReadBatch),
+		send:    send,
+	}
+}
+
+// get queues a read of the named parameter and waits for the request it is sent in to complete.
+// A ParameterNotFound error is returned if the parameter does not exist.
+func (b *parameterReadBatcher) get(ctx context.Context, conn *ssm.SSM, name string) (*ssm.Parameter, error) {
+	request := &parameterReadRequest{
+		name: name,
+		done: make(chan parameterReadResult, 1),
+	}
+
+	b.lock.Lock()
+	batch, ok := b.pending[conn]
+	if !ok {
+		batch = &parameterReadBatch{
+			// The batch must not be canceled along with the first caller's request.
+			ctx: context.WithoutCancel(ctx),
+		}
+		b.pending[conn] = batch
+
+		time.AfterFunc(parameterReadBatchWindow, func() {
+			b.lock.Lock()
+			if b.pending[conn] != batch {
+				// Already flushed.
+				b.lock.Unlock()
+				return
+			}
+			delete(b.pending, conn)
+			b.lock.Unlock()
+
+			b.flush(conn, batch)
+		})
+	}
+	batch.requests = append(batch.requests, request)
+	if len(batch.requests) == parameterReadBatchMaxNames {
+		delete(b.pending, conn)
+		go b.flush(conn, batch)
+	}
+	b.lock.Unlock()
+
+	select {
+	case result := <-request.done:
+		return result.parameter, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// flush sends a batch's reads and distributes the results.
+// If a combined request fails, e.g. because one caller's parameter cannot be decrypted, each caller's parameter is
+// read in a separate request so that the error is reported only to the affected caller.
+func (b *parameterReadBatcher) flush(conn *ssm.SSM, batch *parameterReadBatch) {
+	if len(batch.requests) > 1 {
+		var names []string
+		for _, request := range batch.requests {
+			names = append(names, request.name)
+		}
+
+		log.Printf("[DEBUG] Reading %d batched SSM Parameters", len(names))
+		output, err := b.send(batch.ctx, conn, names)
+
+		if err == nil {
+			for _, request := range batch.requests {
+				request.done <- parameterReadResultFor(output, request.name)
+			}
+
+			return
+		}
+
+		log.Printf("[WARN] Reading batched SSM Parameters, retrying individually: %s", err)
+	}
+
+	for _, request := range batch.requests {
+		go func(request *parameterReadRequest) {
+			output, err := b.send(batch.ctx, conn, []string{request.name})
+
+			if err != nil {
+				request.done <- parameterReadResult{err: err}
+				return
+			}
+
+			request.done <- parameterReadResultFor(output, request.name)
+		}(request)
+	}
+}
+
+// parameterReadResultFor returns the result for the named parameter from a GetParameters response.
+// Parameters may be requested by name or ARN. Only parameters reported in InvalidParameters are not found.
+func parameterReadResultFor(output *ssm.GetParametersOutput, name string) parameterReadResult {
+	for _, v := range output.Parameters {
+		if v == nil {
+			continue
+		}
+
+		if aws.StringValue(v.Name) == name || aws.StringValue(v.ARN) == name {
+			return parameterReadResult{parameter: v}
+		}
+	}
+
+	for _, v := range output.InvalidParameters {
+		if aws.StringValue(v) == name {
+			return parameterReadResult{
+				err: awserr.New(ssm.ErrCodeParameterNotFound, fmt.Sprintf("SSM Parameter (%s) not found", name), nil),
+			}
+		}
+	}
+
+	return parameterReadResult{
+		err: fmt.Errorf("SSM Parameter (%s) missing from GetParameters response", name),
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+func TestParameterReadBatcher(t *testing.T) {
+	t.Parallel()
+
+	const (
+		parameters = 25
+	)
+
+	var (
+		lock     sync.Mutex
+		requests [][]string
+	)
+	batcher := newParameterReadBatcher(func(_ context.Context, _ *ssm.SSM, names []string) (*ssm.GetParametersOutput, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		requests = append(requests, names)
+
+		output := &ssm.GetParametersOutput{}
+		for _, name := range names {
+			if name == "missing" {
+				output.InvalidParameters = append(output.InvalidParameters, aws.String(name))
+				continue
+			}
+
+			output.Parameters = append(output.Parameters, &ssm.Parameter{
+				Name:  aws.String(name),
+				Value: aws.String("value-" + name),
+			})
+		}
+
+		return output, nil
+	})
+
+	get := func(names []string) ([]*ssm.Parameter, []error) {
+		params := make([]*ssm.Parameter, len(names))
+		errs := make([]error, len(names))
+
+		var wg sync.WaitGroup
+		for i, name := range names {
+			wg.Add(1)
+			go func(i int, name string) {
+				defer wg.Done()
+
+				params[i], errs[i] = batcher.get(context.Background(), nil, name)
+			}(i, name)
+		}
+		wg.Wait()
+
+		return params, errs
+	}
+
+	var names []string
+	for i := 0; i < parameters; i++ {
+		names = append(names, fmt.Sprintf("/param%d", i))
+	}
+
+	params, errs := get(names)
+
+	for i, name := range names {
+		if errs[i] != nil {
+			t.Errorf("%s: unexpected error: %s", name, errs[i])
+			continue
+		}
+
+		if got, want := aws.StringValue(params[i].Value), "value-"+name; got != want {
+			t.Errorf("%s: value = %q, want %q", name, got, want)
+		}
+	}
+
+	if got := len(requests); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+	for _, v := range requests {
+		if got := len(v); got > parameterReadBatchMaxNames {
+			t.Errorf("batched names = %d, want at most %d", got, parameterReadBatchMaxNames)
+		}
+	}
+
+	// A missing parameter is reported as not found.
+	_, errs = get([]string{"/param0", "missing"})
+
+	if errs[0] != nil {
+		t.Errorf("/param0: unexpected error: %s", errs[0])
+	}
+	if !tfawserr.ErrCodeEquals(errs[1], ssm.ErrCodeParameterNotFound) {
+		t.Errorf("missing: error = %v, want %s", errs[1], ssm.ErrCodeParameterNotFound)
+	}
+}
+
+func TestParameterReadBatcherFallback(t *testing.T) {
+	t.Parallel()
+
+	var (
+		lock     sync.Mutex
+		requests [][]string
+	)
+	batcher := newParameterReadBatcher(func(_ context.Context, _ *ssm.SSM, names []string) (*ssm.GetParametersOutput, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		requests = append(requests, names)
+
+		output := &ssm.GetParametersOutput{}
+		for _, name := range names {
+			// The whole request fails if any parameter cannot be decrypted.
+			if name == "/encrypted" {
+				return nil, awserr.New("AccessDeniedException", "not authorized to decrypt", nil)
+			}
+
+			output.Parameters = append(output.Parameters, &ssm.Parameter{
+				Name:  aws.String(name),
+				Value: aws.String("value-" + name),
+			})
+		}
+
+		return output, nil
+	})
+
+	names := []string{"/param0", "/encrypted", "/param1"}
+	params := make([]*ssm.Parameter, len(names))
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+
+			params[i], errs[i] = batcher.get(context.Background(), nil, name)
+		}(i, name)
+	}
+	wg.Wait()
+
+	for i, name := range names {
+		if name == "/encrypted" {
+			if !tfawserr.ErrCodeEquals(errs[i], "AccessDeniedException") {
+				t.Errorf("%s: error = %v, want AccessDeniedException", name, errs[i])
+			}
+			continue
+		}
+
+		if errs[i] != nil {
+			t.Errorf("%s: unexpected error: %s", name, errs[i])
+			continue
+		}
+
+		if got, want := aws.StringValue(params[i].Value), "value-"+name; got != want {
+			t.Errorf("%s: value = %q, want %q", name, got, want)
+		}
+	}
+
+	// One batched request followed by one request per parameter.
+	if got, want := len(requests), 1+len(names); got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestParameterReadResultFor(t *testing.T) {
+	t.Parallel()
+
+	output := &ssm.GetParametersOutput{
+		InvalidParameters: aws.StringSlice([]string{"/missing"}),
+		Parameters: []*ssm.Parameter{
+			{
+				ARN:  aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/byarn"), //lintignore:AWSAT003,AWSAT005
+				Name: aws.String("/byarn"),
+			},
+			{
+				Name: aws.String("/found"),
+			},
+		},
+	}
+
+	if result := parameterReadResultFor(output, "/found"); result.err != nil || result.parameter == nil {
+		t.Errorf("/found: result = %+v", result)
+	}
+	if result := parameterReadResultFor(output, "arn:aws:ssm:us-west-2:123456789012:parameter/byarn"); result.err != nil || result.parameter == nil { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("ARN: result = %+v", result)
+	}
+	if result := parameterReadResultFor(output, "/missing"); !tfawserr.ErrCodeEquals(result.err, ssm.ErrCodeParameterNotFound) {
+		t.Errorf("/missing: error = %v, want %s", result.err, ssm.ErrCodeParameterNotFound)
+	}
+	if result := parameterReadResultFor(output, "/other"); result.err == nil || tfawserr.ErrCodeEquals(result.err, ssm.ErrCodeParameterNotFound) {
+		t.Errorf("/other: error = %v, want an error other than %s", result.err, ssm.ErrCodeParameterNotFound)
+	}
+}
@@ -5,8 +5,10 @@ package sts
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -45,6 +47,15 @@ func (d *dataSourceCallerIdentity) Schema(ctx context.Context, req datasource.Sc
 				Optional: true,
 				Computed: true,
 			},
+			"role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"role_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"session_name": schema.StringAttribute{
+				Computed: true,
+			},
 			"user_id": schema.StringAttribute{
 				Computed: true,
 			},
@@ -77,14 +88,53 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 	data.AccountID = types.StringValue(accountID)
 	data.ARN = flex.StringToFrameworkLegacy(ctx, output.Arn)
 	data.ID = types.StringValue(accountID)
+	data.RoleARN = types.StringValue("")
+	data.RoleName = types.StringValue("")
+	data.SessionName = types.StringValue("")
 	data.UserID = flex.StringToFrameworkLegacy(ctx, output.UserId)
 
+	// Credentials obtained by assuming an IAM role identify the role and session.
+	if roleARN, roleName, sessionName, ok := assumedRoleFromARN(aws.ToString(output.Arn)); ok {
+		data.RoleARN = types.StringValue(roleARN)
+		data.RoleName = types.StringValue(roleName)
+		data.SessionName = types.StringValue(sessionName)
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type dataSourceCallerIdentityData struct {
-	AccountID types.String `tfsdk:"account_id"`
-	ARN       types.String `tfsdk:"arn"`
-	ID        types.String `tfsdk:"id"`
-	UserID    types.String `tfsdk:"user_id"`
+	AccountID   types.String `tfsdk:"account_id"`
+	ARN         types.String `tfsdk:"arn"`
+	ID          types.String `tfsdk:"id"`
+	RoleARN     types.String `tfsdk:"role_arn"`
+	RoleName    types.String `tfsdk:"role_name"`
+	SessionName types.String `tfsdk:"session_name"`
+	UserID      types.String `tfsdk:"user_id"`
+}
+
+// assumedRoleFromARN returns the IAM role ARN, role name and session name for an
+// assumed-role ARN, e.g. "arn:aws:sts::123456789012:assumed-role/example/session".
+// The role's path is not part of an assumed-role ARN, so the returned role ARN has no path.
+func assumedRoleFromARN(v string) (string, string, string, bool) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil || parsedARN.Service != "sts" {
+		return "", "", "", false
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 3 || parts[0] != "assumed-role" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", false
+	}
+
+	roleARN := arn.ARN{
+		Partition: parsedARN.Partition,
+		Service:   "iam",
+		AccountID: parsedARN.AccountID,
+		Resource:  "role/" + parts[1],
+	}.String()
+
+	return roleARN, parts[1], parts[2], true
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -59,6 +60,77 @@ func TestAccSTSCallerIdentityDataSource_alternateRegion(t *testing.T) {
 	})
 }
 
+func TestAssumedRoleFromARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		arn                 string
+		expectedRoleARN     string
+		expectedRoleName    string
+		expectedSessionName string
+		expectedOK          bool
+	}{
+		{
+			name: "invalid ARN",
+			arn:  "not an ARN",
+		},
+		{
+			name: "IAM user",
+			arn:  "arn:aws:iam::123456789012:user/example", //lintignore:AWSAT005
+		},
+		{
+			name: "federated user",
+			arn:  "arn:aws:sts::123456789012:federated-user/example", //lintignore:AWSAT005
+		},
+		{
+			name: "missing session name",
+			arn:  "arn:aws:sts::123456789012:assumed-role/example", //lintignore:AWSAT005
+		},
+		{
+			name:                "assumed role",
+			arn:                 "arn:aws:sts::123456789012:assumed-role/example/session", //lintignore:AWSAT005
+			expectedRoleARN:     "arn:aws:iam::123456789012:role/example",                 //lintignore:AWSAT005
+			expectedRoleName:    "example",
+			expectedSessionName: "session",
+			expectedOK:          true,
+		},
+		{
+			name:                "assumed role in other partition",
+			arn:                 "arn:aws-cn:sts::123456789012:assumed-role/example/user@example.com", //lintignore:AWSAT005
+			expectedRoleARN:     "arn:aws-cn:iam::123456789012:role/example",                          //lintignore:AWSAT005
+			expectedRoleName:    "example",
+			expectedSessionName: "user@example.com",
+			expectedOK:          true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			roleARN, roleName, sessionName, ok := tfsts.AssumedRoleFromARN(testCase.arn)
+
+			if ok != testCase.expectedOK {
+				t.Errorf("got ok %t, expected %t", ok, testCase.expectedOK)
+			}
+
+			if roleARN != testCase.expectedRoleARN {
+				t.Errorf("got role ARN %q, expected %q", roleARN, testCase.expectedRoleARN)
+			}
+
+			if roleName != testCase.expectedRoleName {
+				t.Errorf("got role name %q, expected %q", roleName, testCase.expectedRoleName)
+			}
+
+			if sessionName != testCase.expectedSessionName {
+				t.Errorf("got session name %q, expected %q", sessionName, testCase.expectedSessionName)
+			}
+		})
+	}
+}
+
 const testAccCallerIdentityConfig_basic = `
 data "aws_caller_identity" "current" {}
 `
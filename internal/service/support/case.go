@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/support"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/support/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	caseStatusResolved = "resolved"
+)
+
+const (
+	issueTypeCustomerService = "customer-service"
+	issueTypeTechnical       = "technical"
+)
+
+func issueType_Values() []string {
+	return []string{
+		issueTypeCustomerService,
+		issueTypeTechnical,
+	}
+}
+
+// @SDKResource("aws_support_case", name="Case")
+func resourceCase() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCaseCreate,
+		ReadWithoutTimeout:   resourceCaseRead,
+		DeleteWithoutTimeout: resourceCaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"category_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cc_email_addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"communication_body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 8000),
+			},
+			"display_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issue_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      issueTypeTechnical,
+				ValidateFunc: validation.StringInSlice(issueType_Values(), false),
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"severity_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"submitted_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportClient(ctx)
+
+	subject := d.Get("subject").(string)
+	input := &support.CreateCaseInput{
+		CommunicationBody: aws.String(d.Get("communication_body").(string)),
+		IssueType:         aws.String(d.Get("issue_type").(string)),
+		Subject:           aws.String(subject),
+	}
+
+	if v, ok := d.GetOk("category_code"); ok {
+		input.CategoryCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cc_email_addresses"); ok && v.(*schema.Set).Len() > 0 {
+		input.CcEmailAddresses = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("language"); ok {
+		input.Language = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("service_code"); ok {
+		input.ServiceCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("severity_code"); ok {
+		input.SeverityCode = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateCase(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Support Case (%s): %s", subject, err)
+	}
+
+	d.SetId(aws.ToString(output.CaseId))
+
+	return append(diags, resourceCaseRead(ctx, d, meta)...)
+}
+
+func resourceCaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportClient(ctx)
+
+	output, err := findCaseByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Support Case (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Support Case (%s): %s", d.Id(), err)
+	}
+
+	d.Set("category_code", output.CategoryCode)
+	d.Set("cc_email_addresses", output.CcEmailAddresses)
+	d.Set("display_id", output.DisplayId)
+	d.Set("language", output.Language)
+	d.Set("service_code", output.ServiceCode)
+	d.Set("severity_code", output.SeverityCode)
+	d.Set("status", output.Status)
+	d.Set("subject", output.Subject)
+	d.Set("submitted_by", output.SubmittedBy)
+	d.Set("time_created", output.TimeCreated)
+
+	return diags
+}
+
+func resourceCaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportClient(ctx)
+
+	// Support cases can't be deleted, only resolved.
+	if d.Get("status").(string) == caseStatusResolved {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Resolving Support Case: %s", d.Id())
+	_, err := conn.ResolveCase(ctx, &support.ResolveCaseInput{
+		CaseId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.CaseIdNotFound](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resolving Support Case (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findCaseByID(ctx context.Context, conn *support.Client, id string) (*awstypes.CaseDetails, error) {
+	input := &support.DescribeCasesInput{
+		CaseIdList:           []string{id},
+		IncludeResolvedCases: true,
+	}
+
+	output, err := conn.DescribeCases(ctx, input)
+
+	if errs.IsA[*awstypes.CaseIdNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Cases) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Cases); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.Cases[0], nil
+}
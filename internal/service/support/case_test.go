@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package support_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/support/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsupport "github.com/hashicorp/terraform-provider-aws/internal/service/support"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSupportCase_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Opening support cases requires a Business, Enterprise On-Ramp or Enterprise Support plan
+	// and notifies the account's support contacts, so the test only runs when explicitly enabled.
+	acctest.SkipIfEnvVarNotSet(t, "SUPPORT_CASE_TEST_ENABLED")
+	var v awstypes.CaseDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_support_case.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCaseResolved(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCaseConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCaseExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "display_id"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type", "customer-service"),
+					resource.TestCheckResourceAttr(resourceName, "service_code", "general-info"),
+					resource.TestCheckResourceAttr(resourceName, "severity_code", "low"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "subject", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"communication_body", "issue_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckCaseResolved(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_support_case" {
+				continue
+			}
+
+			output, err := tfsupport.FindCaseByID(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if status := aws.ToString(output.Status); status != "resolved" {
+				return fmt.Errorf("Support Case %s still has status %s", rs.Primary.ID, status)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCaseExists(ctx context.Context, n string, v *awstypes.CaseDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportClient(ctx)
+
+		output, err := tfsupport.FindCaseByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCaseConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_support_case" "test" {
+  subject            = %[1]q
+  communication_body = "Terraform acceptance test, please disregard."
+  issue_type         = "customer-service"
+  service_code       = "general-info"
+  category_code      = "other"
+  severity_code      = "low"
+}
+`, rName)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/supportapp"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/supportapp/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_supportapp_account_alias", name="Account Alias")
+func resourceAccountAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountAliasPut,
+		ReadWithoutTimeout:   resourceAccountAliasRead,
+		UpdateWithoutTimeout: resourceAccountAliasPut,
+		DeleteWithoutTimeout: resourceAccountAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_alias": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 30),
+			},
+		},
+	}
+}
+
+func resourceAccountAliasPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportAppClient(ctx)
+
+	accountAlias := d.Get("account_alias").(string)
+	input := &supportapp.PutAccountAliasInput{
+		AccountAlias: aws.String(accountAlias),
+	}
+
+	_, err := conn.PutAccountAlias(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Support App Account Alias (%s): %s", accountAlias, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return append(diags, resourceAccountAliasRead(ctx, d, meta)...)
+}
+
+func resourceAccountAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportAppClient(ctx)
+
+	output, err := findAccountAlias(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Support App Account Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Support App Account Alias (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_alias", output)
+
+	return diags
+}
+
+func resourceAccountAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportAppClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Support App Account Alias: %s", d.Id())
+	_, err := conn.DeleteAccountAlias(ctx, &supportapp.DeleteAccountAliasInput{})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Support App Account Alias (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAccountAlias(ctx context.Context, conn *supportapp.Client) (string, error) {
+	input := &supportapp.GetAccountAliasInput{}
+
+	output, err := conn.GetAccountAlias(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || aws.ToString(output.AccountAlias) == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.AccountAlias), nil
+}
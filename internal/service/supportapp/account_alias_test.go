@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsupportapp "github.com/hashicorp/terraform-provider-aws/internal/service/supportapp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSupportAppAccountAlias_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandString(20)
+	rNameUpdated := sdkacctest.RandString(20)
+	resourceName := "aws_supportapp_account_alias.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportAppServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAliasConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountAliasExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_alias", rName),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountAliasConfig_basic(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountAliasExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_alias", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountAliasDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_supportapp_account_alias" {
+				continue
+			}
+
+			_, err := tfsupportapp.FindAccountAlias(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Support App Account Alias %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountAliasExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppClient(ctx)
+
+		_, err := tfsupportapp.FindAccountAlias(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccAccountAliasConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_supportapp_account_alias" "test" {
+  account_alias = %[1]q
+}
+`, rName)
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/supportapp"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/supportapp/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_supportapp_slack_channel_configuration", name="Slack Channel Configuration")
+func resourceSlackChannelConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSlackChannelConfigurationCreate,
+		ReadWithoutTimeout:   resourceSlackChannelConfigurationRead,
+		UpdateWithoutTimeout: resourceSlackChannelConfigurationUpdate,
+		DeleteWithoutTimeout: resourceSlackChannelConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"channel_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"channel_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"notify_on_add_correspondence_to_case": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"notify_on_case_severity": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.NotificationSeverityLevel](),
+			},
+			"notify_on_create_or_reopen_case": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"notify_on_resolve_case": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+const (
+	slackChannelConfigurationResourceIDPartCount = 2
+)
+
+func resourceSlackChannelConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportAppClient(ctx)
+
+	teamID := d.Get("team_id").(string)
+	channelID := d.Get("channel_id").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{teamID, channelID}, slackChannelConfigurationResourceIDPartCount, false))
+	input := &supportapp.CreateSlackChannelConfigurationInput{
+		ChannelId:                       aws.String(channelID),
+		ChannelRoleArn:                  aws.String(d.Get("channel_role_arn").(string)),
+		NotifyOnAddCorrespondenceToCase: aws.Bool(d.Get("notify_on_add_correspondence_to_case").(bool)),
+		NotifyOnCaseSeverity:            awstypes.NotificationSeverityLevel(d.Get("notify_on_case_severity").(string)),
+		NotifyOnCreateOrReopenCase:      aws.Bool(d.Get("notify_on_create_or_reopen_case").(bool)),
+		NotifyOnResolveCase:             aws.Bool(d.Get("notify_on_resolve_case").(bool)),
+		TeamId:                          aws.String(teamID),
+	}
+
+	if v, ok := d.GetOk("channel_name"); ok {
+		input.ChannelName = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateSlackChannelConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Support App Slack Channel Configuration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceSlackChannelConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceSlackChannelConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportAppClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), slackChannelConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findSlackChannelConfigurationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Support App Slack Channel Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Support App Slack Channel Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("channel_id", output.ChannelId)
+	d.Set("channel_name", output.ChannelName)
+	d.Set("channel_role_arn", output.ChannelRoleArn)
+	d.Set("notify_on_add_correspondence_to_case", output.NotifyOnAddCorrespondenceToCase)
+	d.Set("notify_on_case_severity", output.NotifyOnCaseSeverity)
+	d.Set("notify_on_create_or_reopen_case", output.NotifyOnCreateOrReopenCase)
+	d.Set("notify_on_resolve_case", output.NotifyOnResolveCase)
+	d.Set("team_id", output.TeamId)
+
+	return diags
+}
+
+func resourceSlackChannelConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportAppClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), slackChannelConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &supportapp.UpdateSlackChannelConfigurationInput{
+		ChannelId:                       aws.String(parts[1]),
+		ChannelRoleArn:                  aws.String(d.Get("channel_role_arn").(string)),
+		NotifyOnAddCorrespondenceToCase: aws.Bool(d.Get("notify_on_add_correspondence_to_case").(bool)),
+		NotifyOnCaseSeverity:            awstypes.NotificationSeverityLevel(d.Get("notify_on_case_severity").(string)),
+		NotifyOnCreateOrReopenCase:      aws.Bool(d.Get("notify_on_create_or_reopen_case").(bool)),
+		NotifyOnResolveCase:             aws.Bool(d.Get("notify_on_resolve_case").(bool)),
+		TeamId:                          aws.String(parts[0]),
+	}
+
+	if d.HasChange("channel_name") {
+		input.ChannelName = aws.String(d.Get("channel_name").(string))
+	}
+
+	_, err = conn.UpdateSlackChannelConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Support App Slack Channel Configuration (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSlackChannelConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceSlackChannelConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SupportAppClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), slackChannelConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Support App Slack Channel Configuration: %s", d.Id())
+	_, err = conn.DeleteSlackChannelConfiguration(ctx, &supportapp.DeleteSlackChannelConfigurationInput{
+		ChannelId: aws.String(parts[1]),
+		TeamId:    aws.String(parts[0]),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Support App Slack Channel Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSlackChannelConfigurationByTwoPartKey(ctx context.Context, conn *supportapp.Client, teamID, channelID string) (*awstypes.SlackChannelConfiguration, error) {
+	input := &supportapp.ListSlackChannelConfigurationsInput{}
+
+	pages := supportapp.NewListSlackChannelConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SlackChannelConfigurations {
+			if aws.ToString(v.TeamId) == teamID && aws.ToString(v.ChannelId) == channelID {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
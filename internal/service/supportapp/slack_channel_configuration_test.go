@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package supportapp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/supportapp/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfsupportapp "github.com/hashicorp/terraform-provider-aws/internal/service/supportapp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Slack channel configurations require a Slack workspace that has been authorized for the AWS Support App
+// in the AWS Support Center console, which can't be automated.
+func testAccPreCheckSlackChannel(t *testing.T) (string, string) {
+	return acctest.SkipIfEnvVarNotSet(t, "SUPPORTAPP_SLACK_TEAM_ID"), acctest.SkipIfEnvVarNotSet(t, "SUPPORTAPP_SLACK_CHANNEL_ID")
+}
+
+func TestAccSupportAppSlackChannelConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	teamID, channelID := testAccPreCheckSlackChannel(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_supportapp_slack_channel_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportAppServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID, "high"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "channel_id", channelID),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_add_correspondence_to_case", "false"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_case_severity", "high"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_create_or_reopen_case", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_resolve_case", "false"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID, "all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "notify_on_case_severity", "all"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSupportAppSlackChannelConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	teamID, channelID := testAccPreCheckSlackChannel(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_supportapp_slack_channel_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SupportAppServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID, "high"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsupportapp.ResourceSlackChannelConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSlackChannelConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_supportapp_slack_channel_configuration" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfsupportapp.FindSlackChannelConfigurationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Support App Slack Channel Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSlackChannelConfigurationExists(ctx context.Context, n string, v *awstypes.SlackChannelConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SupportAppClient(ctx)
+
+		output, err := tfsupportapp.FindSlackChannelConfigurationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID, severity string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "supportapp.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AWSSupportAppFullAccess"
+}
+
+resource "aws_supportapp_slack_channel_configuration" "test" {
+  team_id                         = %[2]q
+  channel_id                      = %[3]q
+  channel_role_arn                = aws_iam_role.test.arn
+  notify_on_case_severity         = %[4]q
+  notify_on_create_or_reopen_case = true
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, teamID, channelID, severity)
+}
@@ -90,6 +90,27 @@ func ResourceCanary() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"dry_run_before_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"dry_run_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dry_run_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_dry_run_execution_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"engine_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -134,6 +155,12 @@ func ResourceCanary() *schema.Resource {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"ephemeral_storage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1024, 5120),
+						},
 						"memory_in_mb": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -384,6 +411,9 @@ func resourceCanaryRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}.String()
 	d.Set("arn", canaryArn)
 	d.Set("artifact_s3_location", canary.ArtifactS3Location)
+	if err := d.Set("dry_run_config", flattenCanaryDryRunConfig(canary.DryRunConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting dry_run_config: %s", err)
+	}
 	d.Set("engine_arn", canary.EngineArn)
 	d.Set("execution_role_arn", canary.ExecutionRoleArn)
 	d.Set("failure_retention_period", canary.FailureRetentionPeriodInDays)
@@ -428,7 +458,7 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SyntheticsClient(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all", "start_canary") {
+	if d.HasChangesExcept("tags", "tags_all", "start_canary", "dry_run_before_update") {
 		input := &synthetics.UpdateCanaryInput{
 			Name: aws.String(d.Id()),
 		}
@@ -480,6 +510,22 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.ExecutionRoleArn = aws.String(n.(string))
 		}
 
+		// Validate the new configuration with a dry run before it's applied to the canary.
+		// Only the schedule can be updated alongside a dry run.
+		if d.Get("dry_run_before_update").(bool) && d.HasChangesExcept("tags", "tags_all", "start_canary", "dry_run_before_update", "schedule") {
+			dryRunID, err := dryRunCanary(ctx, conn, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): %s", d.Id(), err)
+			}
+
+			input = &synthetics.UpdateCanaryInput{
+				DryRunId: aws.String(dryRunID),
+				Name:     input.Name,
+				Schedule: input.Schedule,
+			}
+		}
+
 		status := d.Get("status").(string)
 		if status == string(awstypes.CanaryStateRunning) {
 			if err := stopCanary(ctx, d.Id(), conn); err != nil {
@@ -706,6 +752,10 @@ func expandCanaryRunConfig(l []interface{}) *awstypes.CanaryRunConfigInput {
 		codeConfig.ActiveTracing = aws.Bool(v)
 	}
 
+	if v, ok := m["ephemeral_storage"].(int); ok && v > 0 {
+		codeConfig.EphemeralStorage = aws.Int32(int32(v))
+	}
+
 	if vars, ok := m["environment_variables"].(map[string]interface{}); ok && len(vars) > 0 {
 		codeConfig.EnvironmentVariables = flex.ExpandStringValueMap(vars)
 	}
@@ -722,6 +772,7 @@ func flattenCanaryRunConfig(canaryCodeOut *awstypes.CanaryRunConfigOutput, envVa
 		"timeout_in_seconds": aws.ToInt32(canaryCodeOut.TimeoutInSeconds),
 		"memory_in_mb":       aws.ToInt32(canaryCodeOut.MemoryInMB),
 		"active_tracing":     aws.ToBool(canaryCodeOut.ActiveTracing),
+		"ephemeral_storage":  aws.ToInt32(canaryCodeOut.EphemeralStorage),
 	}
 
 	if envVars != nil {
@@ -731,6 +782,19 @@ func flattenCanaryRunConfig(canaryCodeOut *awstypes.CanaryRunConfigOutput, envVa
 	return []interface{}{m}
 }
 
+func flattenCanaryDryRunConfig(apiObject *awstypes.DryRunConfigOutput) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"dry_run_id":                    aws.ToString(apiObject.DryRunId),
+		"last_dry_run_execution_status": aws.ToString(apiObject.LastDryRunExecutionStatus),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenCanaryVPCConfig(canaryVpcOutput *awstypes.VpcConfigOutput) []interface{} {
 	if canaryVpcOutput == nil {
 		return []interface{}{}
@@ -827,6 +891,36 @@ func stopCanary(ctx context.Context, name string, conn *synthetics.Client) error
 }
 
 // loadFileContent returns contents of a file in a given path
+func dryRunCanary(ctx context.Context, conn *synthetics.Client, in *synthetics.UpdateCanaryInput) (string, error) {
+	name := aws.ToString(in.Name)
+	input := &synthetics.StartCanaryDryRunInput{
+		ArtifactConfig:               in.ArtifactConfig,
+		ArtifactS3Location:           in.ArtifactS3Location,
+		Code:                         in.Code,
+		ExecutionRoleArn:             in.ExecutionRoleArn,
+		FailureRetentionPeriodInDays: in.FailureRetentionPeriodInDays,
+		Name:                         in.Name,
+		RunConfig:                    in.RunConfig,
+		RuntimeVersion:               in.RuntimeVersion,
+		SuccessRetentionPeriodInDays: in.SuccessRetentionPeriodInDays,
+		VpcConfig:                    in.VpcConfig,
+	}
+
+	output, err := conn.StartCanaryDryRun(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("starting dry run: %w", err)
+	}
+
+	dryRunID := aws.ToString(output.DryRunConfig.DryRunId)
+
+	if _, err := waitCanaryDryRunPassed(ctx, conn, name, dryRunID); err != nil {
+		return "", fmt.Errorf("waiting for dry run (%s) to pass: %w", dryRunID, err)
+	}
+
+	return dryRunID, nil
+}
+
 func loadFileContent(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
 	if err != nil {
@@ -62,7 +62,7 @@ func TestAccSyntheticsCanary_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 			{
 				Config: testAccCanaryConfig_zipUpdated(rName),
@@ -120,7 +120,7 @@ func TestAccSyntheticsCanary_artifactEncryption(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 			{
 				Config: testAccCanaryConfig_artifactEncryptionKMS(rName),
@@ -159,7 +159,7 @@ func TestAccSyntheticsCanary_runtimeVersion(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 			{
 				Config: testAccCanaryConfig_runtimeVersion(rName, "syn-nodejs-puppeteer-6.1"),
@@ -196,7 +196,7 @@ func TestAccSyntheticsCanary_startCanary(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 			{
 				Config: testAccCanaryConfig_start(rName, false),
@@ -246,7 +246,7 @@ func TestAccSyntheticsCanary_StartCanary_codeChanges(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 			{
 				Config: testAccCanaryConfig_startZipUpdated(rName, true),
@@ -302,7 +302,7 @@ func TestAccSyntheticsCanary_s3(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"s3_bucket", "s3_key", "s3_version", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"s3_bucket", "s3_key", "s3_version", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 		},
 	})
@@ -333,7 +333,7 @@ func TestAccSyntheticsCanary_run(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 			{
 				Config: testAccCanaryConfig_run2(rName),
@@ -378,7 +378,7 @@ func TestAccSyntheticsCanary_runTracing(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 			{
 				Config: testAccCanaryConfig_runTracing(rName, false),
@@ -422,7 +422,7 @@ func TestAccSyntheticsCanary_runEnvironmentVariables(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "run_config.0.environment_variables"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update", "run_config.0.environment_variables"},
 			},
 			{
 				Config: testAccCanaryConfig_runEnvVariables2(rName),
@@ -437,6 +437,77 @@ func TestAccSyntheticsCanary_runEnvironmentVariables(t *testing.T) {
 	})
 }
 
+func TestAccSyntheticsCanary_runEphemeralStorage(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCanaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryConfig_runEphemeralStorage(rName, 1024),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.ephemeral_storage", "1024"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
+			},
+			{
+				Config: testAccCanaryConfig_runEphemeralStorage(rName, 2048),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.ephemeral_storage", "2048"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyntheticsCanary_dryRunBeforeUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1, conf2 awstypes.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCanaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryConfig_dryRunBeforeUpdate(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "dry_run_before_update", "true"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "60"),
+				),
+			},
+			{
+				Config: testAccCanaryConfig_dryRunBeforeUpdate(rName, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf2),
+					testAccCheckCanaryIsUpdated(&conf1, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "dry_run_config.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "dry_run_config.0.dry_run_id"),
+					resource.TestCheckResourceAttr(resourceName, "dry_run_config.0.last_dry_run_execution_status", "PASSED"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "120"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSyntheticsCanary_vpc(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -466,7 +537,7 @@ func TestAccSyntheticsCanary_vpc(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 			{
 				Config: testAccCanaryConfig_vpc2(rName),
@@ -514,7 +585,7 @@ func TestAccSyntheticsCanary_tags(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run_before_update"},
 			},
 			{
 				Config: testAccCanaryConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
@@ -814,6 +885,56 @@ resource "aws_synthetics_canary" "test" {
 `, rName, tracing))
 }
 
+func testAccCanaryConfig_runEphemeralStorage(rName string, ephemeralStorage int) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/lambdatest.zip"
+  runtime_version      = "syn-nodejs-puppeteer-9.1"
+  delete_lambda        = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  run_config {
+    ephemeral_storage  = %[2]d
+    timeout_in_seconds = 60
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, ephemeralStorage))
+}
+
+func testAccCanaryConfig_dryRunBeforeUpdate(rName string, timeout int) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                  = %[1]q
+  artifact_s3_location  = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn    = aws_iam_role.test.arn
+  handler               = "exports.handler"
+  zip_file              = "test-fixtures/lambdatest.zip"
+  runtime_version       = "syn-nodejs-puppeteer-9.1"
+  delete_lambda         = true
+  dry_run_before_update = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  run_config {
+    timeout_in_seconds = %[2]d
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, timeout))
+}
+
 func testAccCanaryConfig_runEnvVariables1(rName string) string {
 	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
 resource "aws_synthetics_canary" "test" {
@@ -44,6 +44,33 @@ func FindCanaryByName(ctx context.Context, conn *synthetics.Client, name string)
 	return output.Canary, nil
 }
 
+func findCanaryDryRunByID(ctx context.Context, conn *synthetics.Client, name, dryRunID string) (*awstypes.CanaryRun, error) {
+	input := &synthetics.GetCanaryRunsInput{
+		DryRunId: aws.String(dryRunID),
+		Name:     aws.String(name),
+		RunType:  awstypes.RunTypeDryRun,
+	}
+
+	output, err := conn.GetCanaryRuns(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CanaryRuns) == 0 || output.CanaryRuns[0].Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return &output.CanaryRuns[0], nil
+}
+
 func FindGroupByName(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Group, error) {
 	input := &synthetics.GetGroupInput{
 		GroupIdentifier: aws.String(name),
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synthetics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/synthetics/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_synthetics_runtime_versions", name="Runtime Versions")
+func dataSourceRuntimeVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuntimeVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"latest_version_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runtime_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"deprecation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"release_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"skip_deprecated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func dataSourceRuntimeVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SyntheticsClient(ctx)
+
+	prefix := d.Get("prefix").(string)
+	skipDeprecated := d.Get("skip_deprecated").(bool)
+	now := time.Now()
+
+	output, err := findRuntimeVersions(ctx, conn, &synthetics.DescribeRuntimeVersionsInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Synthetics Runtime Versions: %s", err)
+	}
+
+	var latest *awstypes.RuntimeVersion
+	var runtimeVersions []awstypes.RuntimeVersion
+	for _, v := range output {
+		if !strings.HasPrefix(aws.ToString(v.VersionName), prefix) {
+			continue
+		}
+
+		deprecated := v.DeprecationDate != nil && v.DeprecationDate.Before(now)
+
+		if skipDeprecated && deprecated {
+			continue
+		}
+
+		runtimeVersions = append(runtimeVersions, v)
+
+		// The latest runtime is the most recently released one that isn't deprecated.
+		if !deprecated && (latest == nil || aws.ToTime(v.ReleaseDate).After(aws.ToTime(latest.ReleaseDate))) {
+			latest = &v
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if latest != nil {
+		d.Set("latest_version_name", latest.VersionName)
+	} else {
+		d.Set("latest_version_name", nil)
+	}
+	if err := d.Set("runtime_versions", flattenRuntimeVersions(runtimeVersions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting runtime_versions: %s", err)
+	}
+
+	return diags
+}
+
+func findRuntimeVersions(ctx context.Context, conn *synthetics.Client, input *synthetics.DescribeRuntimeVersionsInput) ([]awstypes.RuntimeVersion, error) {
+	var output []awstypes.RuntimeVersion
+
+	pages := synthetics.NewDescribeRuntimeVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RuntimeVersions...)
+	}
+
+	return output, nil
+}
+
+func flattenRuntimeVersions(apiObjects []awstypes.RuntimeVersion) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"description":  aws.ToString(apiObject.Description),
+			"version_name": aws.ToString(apiObject.VersionName),
+		}
+
+		if v := apiObject.DeprecationDate; v != nil {
+			tfMap["deprecation_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.ReleaseDate; v != nil {
+			tfMap["release_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synthetics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSyntheticsRuntimeVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_synthetics_runtime_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeVersionsDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "runtime_versions.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "latest_version_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyntheticsRuntimeVersionsDataSource_prefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_synthetics_runtime_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeVersionsDataSourceConfig_prefix("syn-nodejs-puppeteer-"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "runtime_versions.#", 0),
+					resource.TestMatchResourceAttr(dataSourceName, "latest_version_name", regexache.MustCompile(`^syn-nodejs-puppeteer-`)),
+					resource.TestMatchResourceAttr(dataSourceName, "runtime_versions.0.version_name", regexache.MustCompile(`^syn-nodejs-puppeteer-`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "runtime_versions.0.release_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuntimeVersionsDataSourceConfig_basic() string {
+	return `
+data "aws_synthetics_runtime_versions" "test" {}
+`
+}
+
+func testAccRuntimeVersionsDataSourceConfig_prefix(prefix string) string {
+	return fmt.Sprintf(`
+data "aws_synthetics_runtime_versions" "test" {
+  prefix          = %[1]q
+  skip_deprecated = true
+}
+`, prefix)
+}
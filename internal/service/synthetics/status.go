@@ -26,3 +26,19 @@ func statusCanaryState(ctx context.Context, conn *synthetics.Client, name string
 		return output, string(output.Status.State), nil
 	}
 }
+
+func statusCanaryDryRunState(ctx context.Context, conn *synthetics.Client, name, dryRunID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCanaryDryRunByID(ctx, conn, name, dryRunID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status.State), nil
+	}
+}
@@ -21,6 +21,7 @@ const (
 	canaryRunningTimeout = 5 * time.Minute
 	canaryStoppedTimeout = 5 * time.Minute
 	canaryDeletedTimeout = 5 * time.Minute
+	canaryDryRunTimeout  = 20 * time.Minute
 )
 
 func waitCanaryReady(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Canary, error) { //nolint:unparam
@@ -114,3 +115,26 @@ func waitCanaryDeleted(ctx context.Context, conn *synthetics.Client, name string
 
 	return nil, err
 }
+
+func waitCanaryDryRunPassed(ctx context.Context, conn *synthetics.Client, name, dryRunID string) (*awstypes.CanaryRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(awstypes.CanaryRunStateRunning),
+		Target:         enum.Slice(awstypes.CanaryRunStatePassed),
+		Refresh:        statusCanaryDryRunState(ctx, conn, name, dryRunID),
+		Timeout:        canaryDryRunTimeout,
+		Delay:          10 * time.Second,
+		NotFoundChecks: 30,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CanaryRun); ok {
+		if status := output.Status; status.State == awstypes.CanaryRunStateFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", status.StateReasonCode, aws.ToString(status.StateReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
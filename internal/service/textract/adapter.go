@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package textract
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/textract"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_textract_adapter", name="Adapter")
+// @Tags(identifierAttribute="arn")
+func resourceAdapter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAdapterCreate,
+		ReadWithoutTimeout:   resourceAdapterRead,
+		UpdateWithoutTimeout: resourceAdapterUpdate,
+		DeleteWithoutTimeout: resourceAdapterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"adapter_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores (_), and hyphens (-)"),
+				),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_update": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.AutoUpdate](),
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"feature_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.FeatureType](),
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAdapterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractClient(ctx)
+
+	name := d.Get("adapter_name").(string)
+	input := &textract.CreateAdapterInput{
+		AdapterName:  aws.String(name),
+		FeatureTypes: flex.ExpandStringyValueSet[awstypes.FeatureType](d.Get("feature_types").(*schema.Set)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("auto_update"); ok {
+		input.AutoUpdate = awstypes.AutoUpdate(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAdapter(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Textract Adapter (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.AdapterId))
+
+	return append(diags, resourceAdapterRead(ctx, d, meta)...)
+}
+
+func resourceAdapterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractClient(ctx)
+
+	output, err := findAdapterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Textract Adapter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Textract Adapter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("adapter_name", output.AdapterName)
+	d.Set("arn", adapterARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set("auto_update", output.AutoUpdate)
+	d.Set("creation_time", aws.ToTime(output.CreationTime).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("feature_types", flex.FlattenStringyValueSet(output.FeatureTypes))
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceAdapterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &textract.UpdateAdapterInput{
+			AdapterId:   aws.String(d.Id()),
+			AdapterName: aws.String(d.Get("adapter_name").(string)),
+		}
+
+		if d.HasChange("auto_update") {
+			input.AutoUpdate = awstypes.AutoUpdate(d.Get("auto_update").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		_, err := conn.UpdateAdapter(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Textract Adapter (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAdapterRead(ctx, d, meta)...)
+}
+
+func resourceAdapterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Textract Adapter: %s", d.Id())
+	_, err := conn.DeleteAdapter(ctx, &textract.DeleteAdapterInput{
+		AdapterId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Textract Adapter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func adapterARN(client *conns.AWSClient, id string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "textract",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  "/adapters/" + id,
+	}.String()
+}
+
+func findAdapterByID(ctx context.Context, conn *textract.Client, id string) (*textract.GetAdapterOutput, error) {
+	input := &textract.GetAdapterInput{
+		AdapterId: aws.String(id),
+	}
+
+	output, err := conn.GetAdapter(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
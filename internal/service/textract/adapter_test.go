@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package textract_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/textract"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/textract/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftextract "github.com/hashicorp/terraform-provider-aws/internal/service/textract"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTextractAdapter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v textract.GetAdapterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_textract_adapter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TextractServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAdapterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdapterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAdapterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "adapter_name", rName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "textract", regexache.MustCompile(`/adapters/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_update", string(awstypes.AutoUpdateDisabled)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "feature_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "feature_types.*", string(awstypes.FeatureTypeQueries)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTextractAdapter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v textract.GetAdapterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_textract_adapter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TextractServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAdapterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdapterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdapterExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftextract.ResourceAdapter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTextractAdapter_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v textract.GetAdapterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_textract_adapter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TextractServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAdapterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdapterConfig_full(rName, string(awstypes.AutoUpdateDisabled), "Initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAdapterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "adapter_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "auto_update", string(awstypes.AutoUpdateDisabled)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Initial"),
+					resource.TestCheckResourceAttr(resourceName, "feature_types.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAdapterConfig_full(rNameUpdated, string(awstypes.AutoUpdateEnabled), "Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAdapterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "adapter_name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "auto_update", string(awstypes.AutoUpdateEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTextractAdapter_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v textract.GetAdapterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_textract_adapter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TextractServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAdapterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdapterConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdapterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAdapterConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdapterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAdapterConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdapterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAdapterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TextractClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_textract_adapter" {
+				continue
+			}
+
+			_, err := tftextract.FindAdapterByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Textract Adapter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAdapterExists(ctx context.Context, n string, v *textract.GetAdapterOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Textract Adapter ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TextractClient(ctx)
+
+		output, err := tftextract.FindAdapterByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAdapterConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_textract_adapter" "test" {
+  adapter_name  = %[1]q
+  feature_types = ["QUERIES"]
+}
+`, rName)
+}
+
+func testAccAdapterConfig_full(rName, autoUpdate, description string) string {
+	return fmt.Sprintf(`
+resource "aws_textract_adapter" "test" {
+  adapter_name  = %[1]q
+  auto_update   = %[2]q
+  description   = %[3]q
+  feature_types = ["QUERIES", "TABLES"]
+}
+`, rName, autoUpdate, description)
+}
+
+func testAccAdapterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_textract_adapter" "test" {
+  adapter_name  = %[1]q
+  feature_types = ["QUERIES"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAdapterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_textract_adapter" "test" {
+  adapter_name  = %[1]q
+  feature_types = ["QUERIES"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,479 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package textract
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	adapterVersionResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_textract_adapter_version", name="Adapter Version")
+// @Tags(identifierAttribute="arn")
+func resourceAdapterVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAdapterVersionCreate,
+		ReadWithoutTimeout:   resourceAdapterVersionRead,
+		UpdateWithoutTimeout: resourceAdapterVersionUpdate,
+		DeleteWithoutTimeout: resourceAdapterVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"adapter_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"adapter_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"manifest_s3_object": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"evaluation_metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"adapter_version": evaluationMetricSchema(),
+						"baseline":        evaluationMetricSchema(),
+						"feature_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"feature_types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"output_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 255),
+						},
+						"s3_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func evaluationMetricSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"f1_score": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"precision": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"recall": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAdapterVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractClient(ctx)
+
+	adapterID := d.Get("adapter_id").(string)
+	input := &textract.CreateAdapterVersionInput{
+		AdapterId:     aws.String(adapterID),
+		DatasetConfig: expandAdapterVersionDatasetConfig(d.Get("dataset_config").([]interface{})),
+		OutputConfig:  expandOutputConfig(d.Get("output_config").([]interface{})),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KMSKeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAdapterVersion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Textract Adapter (%s) Version: %s", adapterID, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{adapterID, aws.ToString(output.AdapterVersion)}, adapterVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitAdapterVersionCreated(ctx, conn, adapterID, aws.ToString(output.AdapterVersion), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Textract Adapter Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAdapterVersionRead(ctx, d, meta)...)
+}
+
+func resourceAdapterVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), adapterVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	adapterID, version := parts[0], parts[1]
+	output, err := findAdapterVersionByTwoPartKey(ctx, conn, adapterID, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Textract Adapter Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Textract Adapter Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("adapter_id", output.AdapterId)
+	d.Set("adapter_version", output.AdapterVersion)
+	d.Set("arn", adapterARN(meta.(*conns.AWSClient), adapterID)+"/versions/"+version)
+	d.Set("creation_time", aws.ToTime(output.CreationTime).Format(time.RFC3339))
+	if err := d.Set("dataset_config", flattenAdapterVersionDatasetConfig(output.DatasetConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting dataset_config: %s", err)
+	}
+	if err := d.Set("evaluation_metrics", flattenAdapterVersionEvaluationMetrics(output.EvaluationMetrics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting evaluation_metrics: %s", err)
+	}
+	d.Set("feature_types", flex.FlattenStringyValueSet(output.FeatureTypes))
+	d.Set("kms_key_id", output.KMSKeyId)
+	if err := d.Set("output_config", flattenOutputConfig(output.OutputConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_config: %s", err)
+	}
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceAdapterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceAdapterVersionRead(ctx, d, meta)
+}
+
+func resourceAdapterVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), adapterVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Textract Adapter Version: %s", d.Id())
+	_, err = conn.DeleteAdapterVersion(ctx, &textract.DeleteAdapterVersionInput{
+		AdapterId:      aws.String(parts[0]),
+		AdapterVersion: aws.String(parts[1]),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Textract Adapter Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAdapterVersionByTwoPartKey(ctx context.Context, conn *textract.Client, adapterID, version string) (*textract.GetAdapterVersionOutput, error) {
+	input := &textract.GetAdapterVersionInput{
+		AdapterId:      aws.String(adapterID),
+		AdapterVersion: aws.String(version),
+	}
+
+	output, err := conn.GetAdapterVersion(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAdapterVersion(ctx context.Context, conn *textract.Client, adapterID, version string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAdapterVersionByTwoPartKey(ctx, conn, adapterID, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAdapterVersionCreated(ctx context.Context, conn *textract.Client, adapterID, version string, timeout time.Duration) (*textract.GetAdapterVersionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AdapterVersionStatusCreationInProgress),
+		Target:  enum.Slice(awstypes.AdapterVersionStatusActive, awstypes.AdapterVersionStatusAtRisk),
+		Refresh: statusAdapterVersion(ctx, conn, adapterID, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*textract.GetAdapterVersionOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAdapterVersionDatasetConfig(tfList []interface{}) *awstypes.AdapterVersionDatasetConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.AdapterVersionDatasetConfig{}
+
+	if v, ok := tfMap["manifest_s3_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		s3Object := &awstypes.S3Object{}
+
+		if v, ok := tfMap["bucket"].(string); ok && v != "" {
+			s3Object.Bucket = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			s3Object.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["version"].(string); ok && v != "" {
+			s3Object.Version = aws.String(v)
+		}
+
+		apiObject.ManifestS3Object = s3Object
+	}
+
+	return apiObject
+}
+
+func expandOutputConfig(tfList []interface{}) *awstypes.OutputConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.OutputConfig{}
+
+	if v, ok := tfMap["s3_bucket"].(string); ok && v != "" {
+		apiObject.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_prefix"].(string); ok && v != "" {
+		apiObject.S3Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAdapterVersionDatasetConfig(apiObject *awstypes.AdapterVersionDatasetConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ManifestS3Object; v != nil {
+		tfMap["manifest_s3_object"] = []interface{}{map[string]interface{}{
+			"bucket":  aws.ToString(v.Bucket),
+			"name":    aws.ToString(v.Name),
+			"version": aws.ToString(v.Version),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenOutputConfig(apiObject *awstypes.OutputConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_bucket": aws.ToString(apiObject.S3Bucket),
+		"s3_prefix": aws.ToString(apiObject.S3Prefix),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAdapterVersionEvaluationMetrics(apiObjects []awstypes.AdapterVersionEvaluationMetric) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"adapter_version": flattenEvaluationMetric(apiObject.AdapterVersion),
+			"baseline":        flattenEvaluationMetric(apiObject.Baseline),
+			"feature_type":    string(apiObject.FeatureType),
+		})
+	}
+
+	return tfList
+}
+
+func flattenEvaluationMetric(apiObject *awstypes.EvaluationMetric) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"f1_score":  float64(apiObject.F1Score),
+		"precision": float64(apiObject.Precision),
+		"recall":    float64(apiObject.Recall),
+	}
+
+	return []interface{}{tfMap}
+}
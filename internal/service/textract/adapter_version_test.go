@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package textract_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/textract"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftextract "github.com/hashicorp/terraform-provider-aws/internal/service/textract"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTextractAdapterVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	manifestBucket := acctest.SkipIfEnvVarNotSet(t, "TEXTRACT_ADAPTER_MANIFEST_BUCKET")
+	manifestKey := acctest.SkipIfEnvVarNotSet(t, "TEXTRACT_ADAPTER_MANIFEST_KEY")
+
+	var v textract.GetAdapterVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_textract_adapter_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TextractServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAdapterVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdapterVersionConfig_basic(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAdapterVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "adapter_id", "aws_textract_adapter.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "adapter_version"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "textract", regexache.MustCompile(`/adapters/.+/versions/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "dataset_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dataset_config.0.manifest_s3_object.0.bucket", manifestBucket),
+					resource.TestCheckResourceAttr(resourceName, "dataset_config.0.manifest_s3_object.0.name", manifestKey),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.s3_bucket", manifestBucket),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.s3_prefix", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTextractAdapterVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	manifestBucket := acctest.SkipIfEnvVarNotSet(t, "TEXTRACT_ADAPTER_MANIFEST_BUCKET")
+	manifestKey := acctest.SkipIfEnvVarNotSet(t, "TEXTRACT_ADAPTER_MANIFEST_KEY")
+
+	var v textract.GetAdapterVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_textract_adapter_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TextractServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAdapterVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdapterVersionConfig_basic(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdapterVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftextract.ResourceAdapterVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAdapterVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TextractClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_textract_adapter_version" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tftextract.FindAdapterVersionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Textract Adapter Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAdapterVersionExists(ctx context.Context, n string, v *textract.GetAdapterVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Textract Adapter Version ID is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TextractClient(ctx)
+
+		output, err := tftextract.FindAdapterVersionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAdapterVersionConfig_basic(rName, manifestBucket, manifestKey string) string {
+	return fmt.Sprintf(`
+resource "aws_textract_adapter" "test" {
+  adapter_name  = %[1]q
+  feature_types = ["QUERIES"]
+}
+
+resource "aws_textract_adapter_version" "test" {
+  adapter_id = aws_textract_adapter.test.id
+
+  dataset_config {
+    manifest_s3_object {
+      bucket = %[2]q
+      name   = %[3]q
+    }
+  }
+
+  output_config {
+    s3_bucket = %[2]q
+    s3_prefix = %[1]q
+  }
+}
+`, rName, manifestBucket, manifestKey)
+}
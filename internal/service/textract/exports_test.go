@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package textract
+
+// Exports for use in tests only.
+var (
+	ResourceAdapter        = resourceAdapter
+	ResourceAdapterVersion = resourceAdapterVersion
+
+	FindAdapterByID                = findAdapterByID
+	FindAdapterVersionByTwoPartKey = findAdapterVersionByTwoPartKey
+)
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package textract
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_textract_adapter", &resource.Sweeper{
+		Name: "aws_textract_adapter",
+		F:    sweepAdapters,
+		Dependencies: []string{
+			"aws_textract_adapter_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_textract_adapter_version", &resource.Sweeper{
+		Name: "aws_textract_adapter_version",
+		F:    sweepAdapterVersions,
+	})
+}
+
+func sweepAdapters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.TextractClient(ctx)
+	input := &textract.ListAdaptersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := textract.NewListAdaptersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Textract Adapter sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Textract Adapters (%s): %w", region, err)
+		}
+
+		for _, v := range page.Adapters {
+			r := resourceAdapter()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.AdapterId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Textract Adapters (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepAdapterVersions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.TextractClient(ctx)
+	input := &textract.ListAdapterVersionsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := textract.NewListAdapterVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Textract Adapter Version sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Textract Adapter Versions (%s): %w", region, err)
+		}
+
+		for _, v := range page.AdapterVersions {
+			r := resourceAdapterVersion()
+			d := r.Data(nil)
+			d.SetId(fmt.Sprintf("%s,%s", aws.ToString(v.AdapterId), aws.ToString(v.AdapterVersion)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Textract Adapter Versions (%s): %w", region, err)
+	}
+
+	return nil
+}
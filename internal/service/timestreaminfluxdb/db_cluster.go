@@ -0,0 +1,453 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_timestreaminfluxdb_db_cluster", name="DB Cluster")
+// @Tags(identifierAttribute="arn")
+func resourceDBCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDBClusterCreate,
+		ReadWithoutTimeout:   resourceDBClusterRead,
+		UpdateWithoutTimeout: resourceDBClusterUpdate,
+		DeleteWithoutTimeout: resourceDBClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_storage": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(20, 16384),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validInitialBucketName,
+			},
+			"db_instance_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DbInstanceType](),
+			},
+			"db_parameter_group_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+			"db_storage_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DbStorageType](),
+			},
+			"deployment_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          string(awstypes.ClusterDeploymentTypeMultiNodeReadReplicas),
+				ValidateDiagFunc: enum.Validate[awstypes.ClusterDeploymentType](),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failover_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.FailoverMode](),
+			},
+			"influx_auth_parameters_secret_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_delivery_configuration": logDeliveryConfigurationSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"network_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.NetworkType](),
+			},
+			"organization": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			// The password is only ever sent to the API and is never returned,
+			// so it is not read back into state.
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validPassword,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1024, 65535),
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"reader_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validUsername,
+			},
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDBClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &timestreaminfluxdb.CreateDbClusterInput{
+		AllocatedStorage:    aws.Int32(int32(d.Get("allocated_storage").(int))),
+		DbInstanceType:      awstypes.DbInstanceType(d.Get("db_instance_type").(string)),
+		DeploymentType:      awstypes.ClusterDeploymentType(d.Get("deployment_type").(string)),
+		Name:                aws.String(name),
+		Password:            aws.String(d.Get("password").(string)),
+		PubliclyAccessible:  aws.Bool(d.Get("publicly_accessible").(bool)),
+		Tags:                getTagsIn(ctx),
+		VpcSecurityGroupIds: flex.ExpandStringValueSet(d.Get("vpc_security_group_ids").(*schema.Set)),
+		VpcSubnetIds:        flex.ExpandStringValueSet(d.Get("vpc_subnet_ids").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("bucket"); ok {
+		input.Bucket = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_parameter_group_identifier"); ok {
+		input.DbParameterGroupIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_storage_type"); ok {
+		input.DbStorageType = awstypes.DbStorageType(v.(string))
+	}
+
+	if v, ok := d.GetOk("failover_mode"); ok {
+		input.FailoverMode = awstypes.FailoverMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_delivery_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogDeliveryConfiguration = expandLogDeliveryConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("network_type"); ok {
+		input.NetworkType = awstypes.NetworkType(v.(string))
+	}
+
+	if v, ok := d.GetOk("organization"); ok {
+		input.Organization = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		input.Port = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		input.Username = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDbCluster(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Timestream for InfluxDB DB Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.DbClusterId))
+
+	if _, err := waitDBClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Timestream for InfluxDB DB Cluster (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDBClusterRead(ctx, d, meta)...)
+}
+
+func resourceDBClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	cluster, err := findDBClusterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream for InfluxDB DB Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Timestream for InfluxDB DB Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allocated_storage", cluster.AllocatedStorage)
+	d.Set("arn", cluster.Arn)
+	d.Set("db_instance_type", cluster.DbInstanceType)
+	d.Set("db_parameter_group_identifier", cluster.DbParameterGroupIdentifier)
+	d.Set("db_storage_type", cluster.DbStorageType)
+	d.Set("deployment_type", cluster.DeploymentType)
+	d.Set("endpoint", cluster.Endpoint)
+	d.Set("failover_mode", cluster.FailoverMode)
+	d.Set("influx_auth_parameters_secret_arn", cluster.InfluxAuthParametersSecretArn)
+	if err := d.Set("log_delivery_configuration", flattenLogDeliveryConfiguration(cluster.LogDeliveryConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting log_delivery_configuration: %s", err)
+	}
+	d.Set("name", cluster.Name)
+	d.Set("network_type", cluster.NetworkType)
+	d.Set("port", cluster.Port)
+	d.Set("publicly_accessible", cluster.PubliclyAccessible)
+	d.Set("reader_endpoint", cluster.ReaderEndpoint)
+	d.Set("vpc_security_group_ids", cluster.VpcSecurityGroupIds)
+	d.Set("vpc_subnet_ids", cluster.VpcSubnetIds)
+
+	return diags
+}
+
+func resourceDBClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &timestreaminfluxdb.UpdateDbClusterInput{
+			DbClusterId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("db_instance_type") {
+			input.DbInstanceType = awstypes.DbInstanceType(d.Get("db_instance_type").(string))
+		}
+
+		if d.HasChange("db_parameter_group_identifier") {
+			input.DbParameterGroupIdentifier = aws.String(d.Get("db_parameter_group_identifier").(string))
+		}
+
+		if d.HasChange("failover_mode") {
+			input.FailoverMode = awstypes.FailoverMode(d.Get("failover_mode").(string))
+		}
+
+		if d.HasChange("log_delivery_configuration") {
+			if v, ok := d.GetOk("log_delivery_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LogDeliveryConfiguration = expandLogDeliveryConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("port") {
+			input.Port = aws.Int32(int32(d.Get("port").(int)))
+		}
+
+		_, err := conn.UpdateDbCluster(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Timestream for InfluxDB DB Cluster (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Timestream for InfluxDB DB Cluster (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDBClusterRead(ctx, d, meta)...)
+}
+
+func resourceDBClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Timestream for InfluxDB DB Cluster: %s", d.Id())
+	_, err := conn.DeleteDbCluster(ctx, &timestreaminfluxdb.DeleteDbClusterInput{
+		DbClusterId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Timestream for InfluxDB DB Cluster (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDBClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Timestream for InfluxDB DB Cluster (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDBClusterByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbClusterOutput, error) {
+	input := &timestreaminfluxdb.GetDbClusterInput{
+		DbClusterId: aws.String(id),
+	}
+
+	output, err := conn.GetDbCluster(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.ClusterStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusDBCluster(ctx context.Context, conn *timestreaminfluxdb.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDBClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDBClusterCreated(ctx context.Context, conn *timestreaminfluxdb.Client, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusCreating),
+		Target:  enum.Slice(awstypes.ClusterStatusAvailable),
+		Refresh: statusDBCluster(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBClusterUpdated(ctx context.Context, conn *timestreaminfluxdb.Client, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusUpdating),
+		Target:  enum.Slice(awstypes.ClusterStatusAvailable),
+		Refresh: statusDBCluster(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBClusterDeleted(ctx context.Context, conn *timestreaminfluxdb.Client, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusAvailable, awstypes.ClusterStatusDeleting),
+		Target:  []string{},
+		Refresh: statusDBCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,558 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_timestreaminfluxdb_db_instance", name="DB Instance")
+// @Tags(identifierAttribute="arn")
+func resourceDBInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDBInstanceCreate,
+		ReadWithoutTimeout:   resourceDBInstanceRead,
+		UpdateWithoutTimeout: resourceDBInstanceUpdate,
+		DeleteWithoutTimeout: resourceDBInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_storage": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(20, 16384),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validInitialBucketName,
+			},
+			"db_cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_instance_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DbInstanceType](),
+			},
+			"db_parameter_group_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+			"db_storage_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DbStorageType](),
+			},
+			"deployment_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DeploymentType](),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"influx_auth_parameters_secret_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_delivery_configuration": logDeliveryConfigurationSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"network_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.NetworkType](),
+			},
+			"organization": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			// The password is only ever sent to the API and is never returned,
+			// so it is not read back into state.
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validPassword,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1024, 65535),
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"secondary_availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validUsername,
+			},
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &timestreaminfluxdb.CreateDbInstanceInput{
+		AllocatedStorage:    aws.Int32(int32(d.Get("allocated_storage").(int))),
+		DbInstanceType:      awstypes.DbInstanceType(d.Get("db_instance_type").(string)),
+		Name:                aws.String(name),
+		Password:            aws.String(d.Get("password").(string)),
+		PubliclyAccessible:  aws.Bool(d.Get("publicly_accessible").(bool)),
+		Tags:                getTagsIn(ctx),
+		VpcSecurityGroupIds: flex.ExpandStringValueSet(d.Get("vpc_security_group_ids").(*schema.Set)),
+		VpcSubnetIds:        flex.ExpandStringValueSet(d.Get("vpc_subnet_ids").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("bucket"); ok {
+		input.Bucket = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_parameter_group_identifier"); ok {
+		input.DbParameterGroupIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_storage_type"); ok {
+		input.DbStorageType = awstypes.DbStorageType(v.(string))
+	}
+
+	if v, ok := d.GetOk("deployment_type"); ok {
+		input.DeploymentType = awstypes.DeploymentType(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_delivery_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogDeliveryConfiguration = expandLogDeliveryConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("network_type"); ok {
+		input.NetworkType = awstypes.NetworkType(v.(string))
+	}
+
+	if v, ok := d.GetOk("organization"); ok {
+		input.Organization = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		input.Port = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		input.Username = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDbInstance(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Timestream for InfluxDB DB Instance (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	if _, err := waitDBInstanceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Timestream for InfluxDB DB Instance (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDBInstanceRead(ctx, d, meta)...)
+}
+
+func resourceDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	instance, err := findDBInstanceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream for InfluxDB DB Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Timestream for InfluxDB DB Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allocated_storage", instance.AllocatedStorage)
+	d.Set("arn", instance.Arn)
+	d.Set("availability_zone", instance.AvailabilityZone)
+	d.Set("db_cluster_id", instance.DbClusterId)
+	d.Set("db_instance_type", instance.DbInstanceType)
+	d.Set("db_parameter_group_identifier", instance.DbParameterGroupIdentifier)
+	d.Set("db_storage_type", instance.DbStorageType)
+	d.Set("deployment_type", instance.DeploymentType)
+	d.Set("endpoint", instance.Endpoint)
+	d.Set("influx_auth_parameters_secret_arn", instance.InfluxAuthParametersSecretArn)
+	if err := d.Set("log_delivery_configuration", flattenLogDeliveryConfiguration(instance.LogDeliveryConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting log_delivery_configuration: %s", err)
+	}
+	d.Set("name", instance.Name)
+	d.Set("network_type", instance.NetworkType)
+	d.Set("port", instance.Port)
+	d.Set("publicly_accessible", instance.PubliclyAccessible)
+	d.Set("secondary_availability_zone", instance.SecondaryAvailabilityZone)
+	d.Set("vpc_security_group_ids", instance.VpcSecurityGroupIds)
+	d.Set("vpc_subnet_ids", instance.VpcSubnetIds)
+
+	return diags
+}
+
+func resourceDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &timestreaminfluxdb.UpdateDbInstanceInput{
+			Identifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("allocated_storage") {
+			input.AllocatedStorage = aws.Int32(int32(d.Get("allocated_storage").(int)))
+		}
+
+		if d.HasChange("db_instance_type") {
+			input.DbInstanceType = awstypes.DbInstanceType(d.Get("db_instance_type").(string))
+		}
+
+		if d.HasChange("db_parameter_group_identifier") {
+			input.DbParameterGroupIdentifier = aws.String(d.Get("db_parameter_group_identifier").(string))
+		}
+
+		if d.HasChange("db_storage_type") {
+			input.DbStorageType = awstypes.DbStorageType(d.Get("db_storage_type").(string))
+		}
+
+		if d.HasChange("deployment_type") {
+			input.DeploymentType = awstypes.DeploymentType(d.Get("deployment_type").(string))
+		}
+
+		if d.HasChange("log_delivery_configuration") {
+			if v, ok := d.GetOk("log_delivery_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LogDeliveryConfiguration = expandLogDeliveryConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("port") {
+			input.Port = aws.Int32(int32(d.Get("port").(int)))
+		}
+
+		_, err := conn.UpdateDbInstance(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Timestream for InfluxDB DB Instance (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDBInstanceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Timestream for InfluxDB DB Instance (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDBInstanceRead(ctx, d, meta)...)
+}
+
+func resourceDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Timestream for InfluxDB DB Instance: %s", d.Id())
+	_, err := conn.DeleteDbInstance(ctx, &timestreaminfluxdb.DeleteDbInstanceInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Timestream for InfluxDB DB Instance (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDBInstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Timestream for InfluxDB DB Instance (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDBInstanceByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	input := &timestreaminfluxdb.GetDbInstanceInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDbInstance(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.StatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusDBInstance(ctx context.Context, conn *timestreaminfluxdb.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDBInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDBInstanceCreated(ctx context.Context, conn *timestreaminfluxdb.Client, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusCreating),
+		Target:  enum.Slice(awstypes.StatusAvailable),
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceUpdated(ctx context.Context, conn *timestreaminfluxdb.Client, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusModifying, awstypes.StatusUpdating, awstypes.StatusUpdatingDeploymentType, awstypes.StatusUpdatingInstanceType),
+		Target:  enum.Slice(awstypes.StatusAvailable),
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceDeleted(ctx context.Context, conn *timestreaminfluxdb.Client, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusAvailable, awstypes.StatusDeleting),
+		Target:  []string{},
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+var (
+	validName = validation.All(
+		validation.StringLenBetween(3, 40),
+		validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z]*(-[0-9A-Za-z]+)*$`), "must start with a letter, contain only letters, numbers and hyphens, and not end with a hyphen or contain two consecutive hyphens"),
+	)
+	validInitialBucketName = validation.All(
+		validation.StringLenBetween(2, 64),
+		validation.StringMatch(regexache.MustCompile(`^[^_"][^"]*$`), "must not start with an underscore or contain double quotes"),
+	)
+	validPassword = validation.All(
+		validation.StringLenBetween(8, 64),
+		validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z]+$`), "must contain only letters and numbers"),
+	)
+	validUsername = validation.All(
+		validation.StringLenBetween(1, 64),
+		validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z]*(-[0-9A-Za-z]+)*$`), "must start with a letter, contain only letters, numbers and hyphens, and not end with a hyphen or contain two consecutive hyphens"),
+	)
+)
+
+func logDeliveryConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"s3_configuration": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(3, 63),
+							},
+							"enabled": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandLogDeliveryConfiguration(tfMap map[string]interface{}) *awstypes.LogDeliveryConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.LogDeliveryConfiguration{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Configuration = expandS3Configuration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3Configuration(tfMap map[string]interface{}) *awstypes.S3Configuration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.S3Configuration{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenLogDeliveryConfiguration(apiObject *awstypes.LogDeliveryConfiguration) []interface{} {
+	if apiObject == nil || apiObject.S3Configuration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_configuration": []interface{}{map[string]interface{}{
+			"bucket_name": aws.ToString(apiObject.S3Configuration.BucketName),
+			"enabled":     aws.ToBool(apiObject.S3Configuration.Enabled),
+		}},
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_timestreaminfluxdb_db_parameter_group", name="DB Parameter Group")
+// @Tags(identifierAttribute="arn")
+func resourceDBParameterGroup() *schema.Resource {
+	durationSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"duration_type": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						ValidateDiagFunc: enum.Validate[awstypes.DurationType](),
+					},
+					"value": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		}
+	}
+	optionalBoolSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		}
+	}
+	optionalIntSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDBParameterGroupCreate,
+		ReadWithoutTimeout:   resourceDBParameterGroupRead,
+		UpdateWithoutTimeout: resourceDBParameterGroupUpdate,
+		// DB parameter groups can't be deleted.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z]*(-[0-9A-Za-z]+)*$`), "must start with a letter, contain only letters, numbers and hyphens, and not end with a hyphen or contain two consecutive hyphens"),
+				),
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"influxdbv2": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"flux_log_enabled":            optionalBoolSchema(),
+									"http_idle_timeout":           durationSchema(),
+									"http_read_header_timeout":    durationSchema(),
+									"http_read_timeout":           durationSchema(),
+									"http_write_timeout":          durationSchema(),
+									"influxql_max_select_buckets": optionalIntSchema(),
+									"influxql_max_select_point":   optionalIntSchema(),
+									"influxql_max_select_series":  optionalIntSchema(),
+									"log_level": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.LogLevel](),
+									},
+									"metrics_disabled":                                        optionalBoolSchema(),
+									"no_tasks":                                                optionalBoolSchema(),
+									"pprof_disabled":                                          optionalBoolSchema(),
+									"query_concurrency":                                       optionalIntSchema(),
+									"query_initial_memory_bytes":                              optionalIntSchema(),
+									"query_max_memory_bytes":                                  optionalIntSchema(),
+									"query_memory_bytes":                                      optionalIntSchema(),
+									"query_queue_size":                                        optionalIntSchema(),
+									"session_length":                                          optionalIntSchema(),
+									"session_renew_disabled":                                  optionalBoolSchema(),
+									"storage_cache_max_memory_size":                           optionalIntSchema(),
+									"storage_cache_snapshot_memory_size":                      optionalIntSchema(),
+									"storage_cache_snapshot_write_cold_duration":              durationSchema(),
+									"storage_compact_full_write_cold_duration":                durationSchema(),
+									"storage_compact_throughput_burst":                        optionalIntSchema(),
+									"storage_max_concurrent_compactions":                      optionalIntSchema(),
+									"storage_max_index_log_file_size":                         optionalIntSchema(),
+									"storage_no_validate_field_size":                          optionalBoolSchema(),
+									"storage_retention_check_interval":                        durationSchema(),
+									"storage_series_file_max_concurrent_snapshot_compactions": optionalIntSchema(),
+									"storage_series_id_set_cache_size":                        optionalIntSchema(),
+									"storage_wal_max_concurrent_writes":                       optionalIntSchema(),
+									"storage_wal_max_write_delay":                             durationSchema(),
+									"tracing_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.TracingType](),
+									},
+									"ui_disabled": optionalBoolSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDBParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &timestreaminfluxdb.CreateDbParameterGroupInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Parameters = expandParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateDbParameterGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Timestream for InfluxDB DB Parameter Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	return append(diags, resourceDBParameterGroupRead(ctx, d, meta)...)
+}
+
+func resourceDBParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	parameterGroup, err := findDBParameterGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream for InfluxDB DB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Timestream for InfluxDB DB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", parameterGroup.Arn)
+	d.Set("description", parameterGroup.Description)
+	d.Set("name", parameterGroup.Name)
+	if err := d.Set("parameters", flattenParameters(parameterGroup.Parameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDBParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceDBParameterGroupRead(ctx, d, meta)...)
+}
+
+func findDBParameterGroupByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbParameterGroupOutput, error) {
+	input := &timestreaminfluxdb.GetDbParameterGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDbParameterGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandParameters(tfMap map[string]interface{}) awstypes.Parameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["influxdbv2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return &awstypes.ParametersMemberInfluxDBv2{
+			Value: expandInfluxDBv2Parameters(v[0].(map[string]interface{})),
+		}
+	}
+
+	return nil
+}
+
+func expandInfluxDBv2Parameters(tfMap map[string]interface{}) awstypes.InfluxDBv2Parameters {
+	apiObject := awstypes.InfluxDBv2Parameters{}
+
+	if v, ok := tfMap["flux_log_enabled"].(bool); ok && v {
+		apiObject.FluxLogEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["http_idle_timeout"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HttpIdleTimeout = expandDuration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["http_read_header_timeout"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HttpReadHeaderTimeout = expandDuration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["http_read_timeout"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HttpReadTimeout = expandDuration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["http_write_timeout"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HttpWriteTimeout = expandDuration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["influxql_max_select_buckets"].(int); ok && v != 0 {
+		apiObject.InfluxqlMaxSelectBuckets = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["influxql_max_select_point"].(int); ok && v != 0 {
+		apiObject.InfluxqlMaxSelectPoint = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["influxql_max_select_series"].(int); ok && v != 0 {
+		apiObject.InfluxqlMaxSelectSeries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["log_level"].(string); ok && v != "" {
+		apiObject.LogLevel = awstypes.LogLevel(v)
+	}
+
+	if v, ok := tfMap["metrics_disabled"].(bool); ok && v {
+		apiObject.MetricsDisabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["no_tasks"].(bool); ok && v {
+		apiObject.NoTasks = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["pprof_disabled"].(bool); ok && v {
+		apiObject.PprofDisabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["query_concurrency"].(int); ok && v != 0 {
+		apiObject.QueryConcurrency = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["query_initial_memory_bytes"].(int); ok && v != 0 {
+		apiObject.QueryInitialMemoryBytes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["query_max_memory_bytes"].(int); ok && v != 0 {
+		apiObject.QueryMaxMemoryBytes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["query_memory_bytes"].(int); ok && v != 0 {
+		apiObject.QueryMemoryBytes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["query_queue_size"].(int); ok && v != 0 {
+		apiObject.QueryQueueSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["session_length"].(int); ok && v != 0 {
+		apiObject.SessionLength = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["session_renew_disabled"].(bool); ok && v {
+		apiObject.SessionRenewDisabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["storage_cache_max_memory_size"].(int); ok && v != 0 {
+		apiObject.StorageCacheMaxMemorySize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["storage_cache_snapshot_memory_size"].(int); ok && v != 0 {
+		apiObject.StorageCacheSnapshotMemorySize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["storage_cache_snapshot_write_cold_duration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StorageCacheSnapshotWriteColdDuration = expandDuration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["storage_compact_full_write_cold_duration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StorageCompactFullWriteColdDuration = expandDuration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["storage_compact_throughput_burst"].(int); ok && v != 0 {
+		apiObject.StorageCompactThroughputBurst = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["storage_max_concurrent_compactions"].(int); ok && v != 0 {
+		apiObject.StorageMaxConcurrentCompactions = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["storage_max_index_log_file_size"].(int); ok && v != 0 {
+		apiObject.StorageMaxIndexLogFileSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["storage_no_validate_field_size"].(bool); ok && v {
+		apiObject.StorageNoValidateFieldSize = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["storage_retention_check_interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StorageRetentionCheckInterval = expandDuration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["storage_series_file_max_concurrent_snapshot_compactions"].(int); ok && v != 0 {
+		apiObject.StorageSeriesFileMaxConcurrentSnapshotCompactions = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["storage_series_id_set_cache_size"].(int); ok && v != 0 {
+		apiObject.StorageSeriesIdSetCacheSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["storage_wal_max_concurrent_writes"].(int); ok && v != 0 {
+		apiObject.StorageWalMaxConcurrentWrites = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["storage_wal_max_write_delay"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StorageWalMaxWriteDelay = expandDuration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["tracing_type"].(string); ok && v != "" {
+		apiObject.TracingType = awstypes.TracingType(v)
+	}
+
+	if v, ok := tfMap["ui_disabled"].(bool); ok && v {
+		apiObject.UiDisabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandDuration(tfMap map[string]interface{}) *awstypes.Duration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.Duration{}
+
+	if v, ok := tfMap["duration_type"].(string); ok && v != "" {
+		apiObject.DurationType = awstypes.DurationType(v)
+	}
+
+	if v, ok := tfMap["value"].(int); ok {
+		apiObject.Value = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenParameters(apiObject awstypes.Parameters) []interface{} {
+	v, ok := apiObject.(*awstypes.ParametersMemberInfluxDBv2)
+
+	if !ok {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"influxdbv2": []interface{}{flattenInfluxDBv2Parameters(v.Value)},
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInfluxDBv2Parameters(apiObject awstypes.InfluxDBv2Parameters) map[string]interface{} {
+	return map[string]interface{}{
+		"flux_log_enabled":                                        aws.ToBool(apiObject.FluxLogEnabled),
+		"http_idle_timeout":                                       flattenDuration(apiObject.HttpIdleTimeout),
+		"http_read_header_timeout":                                flattenDuration(apiObject.HttpReadHeaderTimeout),
+		"http_read_timeout":                                       flattenDuration(apiObject.HttpReadTimeout),
+		"http_write_timeout":                                      flattenDuration(apiObject.HttpWriteTimeout),
+		"influxql_max_select_buckets":                             aws.ToInt64(apiObject.InfluxqlMaxSelectBuckets),
+		"influxql_max_select_point":                               aws.ToInt64(apiObject.InfluxqlMaxSelectPoint),
+		"influxql_max_select_series":                              aws.ToInt64(apiObject.InfluxqlMaxSelectSeries),
+		"log_level":                                               apiObject.LogLevel,
+		"metrics_disabled":                                        aws.ToBool(apiObject.MetricsDisabled),
+		"no_tasks":                                                aws.ToBool(apiObject.NoTasks),
+		"pprof_disabled":                                          aws.ToBool(apiObject.PprofDisabled),
+		"query_concurrency":                                       aws.ToInt32(apiObject.QueryConcurrency),
+		"query_initial_memory_bytes":                              aws.ToInt64(apiObject.QueryInitialMemoryBytes),
+		"query_max_memory_bytes":                                  aws.ToInt64(apiObject.QueryMaxMemoryBytes),
+		"query_memory_bytes":                                      aws.ToInt64(apiObject.QueryMemoryBytes),
+		"query_queue_size":                                        aws.ToInt32(apiObject.QueryQueueSize),
+		"session_length":                                          aws.ToInt32(apiObject.SessionLength),
+		"session_renew_disabled":                                  aws.ToBool(apiObject.SessionRenewDisabled),
+		"storage_cache_max_memory_size":                           aws.ToInt64(apiObject.StorageCacheMaxMemorySize),
+		"storage_cache_snapshot_memory_size":                      aws.ToInt64(apiObject.StorageCacheSnapshotMemorySize),
+		"storage_cache_snapshot_write_cold_duration":              flattenDuration(apiObject.StorageCacheSnapshotWriteColdDuration),
+		"storage_compact_full_write_cold_duration":                flattenDuration(apiObject.StorageCompactFullWriteColdDuration),
+		"storage_compact_throughput_burst":                        aws.ToInt64(apiObject.StorageCompactThroughputBurst),
+		"storage_max_concurrent_compactions":                      aws.ToInt32(apiObject.StorageMaxConcurrentCompactions),
+		"storage_max_index_log_file_size":                         aws.ToInt64(apiObject.StorageMaxIndexLogFileSize),
+		"storage_no_validate_field_size":                          aws.ToBool(apiObject.StorageNoValidateFieldSize),
+		"storage_retention_check_interval":                        flattenDuration(apiObject.StorageRetentionCheckInterval),
+		"storage_series_file_max_concurrent_snapshot_compactions": aws.ToInt32(apiObject.StorageSeriesFileMaxConcurrentSnapshotCompactions),
+		"storage_series_id_set_cache_size":                        aws.ToInt64(apiObject.StorageSeriesIdSetCacheSize),
+		"storage_wal_max_concurrent_writes":                       aws.ToInt32(apiObject.StorageWalMaxConcurrentWrites),
+		"storage_wal_max_write_delay":                             flattenDuration(apiObject.StorageWalMaxWriteDelay),
+		"tracing_type":                                            apiObject.TracingType,
+		"ui_disabled":                                             aws.ToBool(apiObject.UiDisabled),
+	}
+}
+
+func flattenDuration(apiObject *awstypes.Duration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"duration_type": apiObject.DurationType,
+		"value":         aws.ToInt64(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+// Exports for use in tests only.
+var (
+	ResourceDBCluster        = resourceDBCluster
+	ResourceDBInstance       = resourceDBInstance
+	ResourceDBParameterGroup = resourceDBParameterGroup
+
+	FindDBClusterByID        = findDBClusterByID
+	FindDBInstanceByID       = findDBInstanceByID
+	FindDBParameterGroupByID = findDBParameterGroupByID
+)
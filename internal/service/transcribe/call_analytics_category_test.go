@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transcribe_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/transcribe/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTranscribeCallAnalyticsCategory_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var category types.CategoryProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_call_analytics_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TranscribeEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranscribeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCallAnalyticsCategoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(ctx, resourceName, &category),
+					resource.TestCheckResourceAttr(resourceName, "category_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "input_type", "POST_CALL"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.targets.0", "cancel my subscription"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.transcript_filter_type", "EXACT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeCallAnalyticsCategory_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var category types.CategoryProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_call_analytics_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TranscribeEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranscribeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCallAnalyticsCategoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(ctx, resourceName, &category),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftranscribe.ResourceCallAnalyticsCategory(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeCallAnalyticsCategory_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var category types.CategoryProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_call_analytics_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TranscribeEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranscribeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCallAnalyticsCategoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(ctx, resourceName, &category),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccCallAnalyticsCategoryConfig_multipleRules(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(ctx, resourceName, &category),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.sentiment_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.sentiment_filter.0.participant_role", "CUSTOMER"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.sentiment_filter.0.sentiments.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.0.sentiment_filter.0.sentiments.*", "NEGATIVE"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.non_talk_time_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.non_talk_time_filter.0.threshold", "30000"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.interruption_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.interruption_filter.0.relative_time_range.0.start_percentage", "10"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.interruption_filter.0.relative_time_range.0.end_percentage", "90"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCallAnalyticsCategoryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_transcribe_call_analytics_category" {
+				continue
+			}
+
+			_, err := tftranscribe.FindCallAnalyticsCategoryByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Transcribe, create.ErrActionCheckingDestroyed, tftranscribe.ResNameCallAnalyticsCategory, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCallAnalyticsCategoryExists(ctx context.Context, name string, category *types.CategoryProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Transcribe, create.ErrActionCheckingExistence, tftranscribe.ResNameCallAnalyticsCategory, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Transcribe, create.ErrActionCheckingExistence, tftranscribe.ResNameCallAnalyticsCategory, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeClient(ctx)
+		resp, err := tftranscribe.FindCallAnalyticsCategoryByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Transcribe, create.ErrActionCheckingExistence, tftranscribe.ResNameCallAnalyticsCategory, rs.Primary.ID, err)
+		}
+
+		*category = *resp
+
+		return nil
+	}
+}
+
+func testAccCallAnalyticsCategoryConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_call_analytics_category" "test" {
+  category_name = %[1]q
+  input_type    = "POST_CALL"
+
+  rule {
+    transcript_filter {
+      targets                = ["cancel my subscription"]
+      transcript_filter_type = "EXACT"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCallAnalyticsCategoryConfig_multipleRules(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_call_analytics_category" "test" {
+  category_name = %[1]q
+  input_type    = "POST_CALL"
+
+  rule {
+    sentiment_filter {
+      participant_role = "CUSTOMER"
+      sentiments       = ["NEGATIVE"]
+    }
+  }
+
+  rule {
+    non_talk_time_filter {
+      threshold = 30000
+    }
+  }
+
+  rule {
+    interruption_filter {
+      participant_role = "AGENT"
+
+      relative_time_range {
+        start_percentage = 10
+        end_percentage   = 90
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/transcribe/types"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -59,6 +60,10 @@ func ResourceMedicalVocabulary() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"en-US"}, false), // en-US is the only supported language for this service
 			},
+			"vocabulary_file_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"vocabulary_file_uri": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -74,7 +79,10 @@ func ResourceMedicalVocabulary() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			vocabularyFileETagCustomizeDiff("vocabulary_file_uri"),
+		),
 	}
 }
 
@@ -104,6 +112,8 @@ func resourceMedicalVocabularyCreate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("waiting for Amazon Transcribe MedicalVocabulary (%s) create: %s", d.Id(), err)
 	}
 
+	setVocabularyFileETag(ctx, d, meta, "vocabulary_file_uri")
+
 	return resourceMedicalVocabularyRead(ctx, d, meta)
 }
 
@@ -147,7 +157,7 @@ func resourceMedicalVocabularyUpdate(ctx context.Context, d *schema.ResourceData
 			LanguageCode:   types.LanguageCode(d.Get("language_code").(string)),
 		}
 
-		if d.HasChanges("vocabulary_file_uri") {
+		if d.HasChanges("vocabulary_file_etag", "vocabulary_file_uri") {
 			in.VocabularyFileUri = aws.String(d.Get("vocabulary_file_uri").(string))
 		}
 
@@ -160,6 +170,8 @@ func resourceMedicalVocabularyUpdate(ctx context.Context, d *schema.ResourceData
 		if _, err := waitMedicalVocabularyUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.Errorf("waiting for Transcribe MedicalVocabulary (%s) update: %s", d.Id(), err)
 		}
+
+		setVocabularyFileETag(ctx, d, meta, "vocabulary_file_uri")
 	}
 
 	return resourceMedicalVocabularyRead(ctx, d, meta)
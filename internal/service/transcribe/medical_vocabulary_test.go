@@ -46,13 +46,14 @@ func TestAccTranscribeMedicalVocabulary_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "download_uri"),
 					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttrPair(resourceName, "vocabulary_file_etag", "aws_s3_object.object1", "etag"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"vocabulary_file_uri", "download_uri"},
+				ImportStateVerifyIgnore: []string{"vocabulary_file_etag", "vocabulary_file_uri", "download_uri"},
 			},
 		},
 	})
@@ -28,6 +28,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceCallAnalyticsCategory,
+			TypeName: "aws_transcribe_call_analytics_category",
+			Name:     "Call Analytics Category",
+		},
 		{
 			Factory:  ResourceLanguageModel,
 			TypeName: "aws_transcribe_language_model",
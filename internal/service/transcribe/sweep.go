@@ -15,6 +15,11 @@ import (
 )
 
 func RegisterSweepers() {
+	resource.AddTestSweepers("aws_transcribe_call_analytics_category", &resource.Sweeper{
+		Name: "aws_transcribe_call_analytics_category",
+		F:    sweepCallAnalyticsCategories,
+	})
+
 	resource.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
 		Name: "aws_transcribe_language_model",
 		F:    sweepLanguageModels,
@@ -49,6 +54,50 @@ func RegisterSweepers() {
 	})
 }
 
+func sweepCallAnalyticsCategories(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.TranscribeClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+	in := &transcribe.ListCallAnalyticsCategoriesInput{}
+
+	pages := transcribe.NewListCallAnalyticsCategoriesPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Transcribe Call Analytics Categories sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error retrieving Transcribe Call Analytics Categories: %w", err)
+		}
+
+		for _, category := range page.Categories {
+			name := aws.ToString(category.CategoryName)
+			log.Printf("[INFO] Deleting Transcribe Call Analytics Category: %s", name)
+
+			r := ResourceCallAnalyticsCategory()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Transcribe Call Analytics Categories for %s: %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepLanguageModels(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/transcribe/types"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -69,6 +70,10 @@ func ResourceVocabulary() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vocabulary_file_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"vocabulary_file_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -84,7 +89,10 @@ func ResourceVocabulary() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			vocabularyFileETagCustomizeDiff("vocabulary_file_uri"),
+		),
 	}
 }
 
@@ -124,6 +132,8 @@ func resourceVocabularyCreate(ctx context.Context, d *schema.ResourceData, meta
 		return create.DiagError(names.Transcribe, create.ErrActionWaitingForCreation, ResNameVocabulary, d.Id(), err)
 	}
 
+	setVocabularyFileETag(ctx, d, meta, "vocabulary_file_uri")
+
 	return resourceVocabularyRead(ctx, d, meta)
 }
 
@@ -167,7 +177,7 @@ func resourceVocabularyUpdate(ctx context.Context, d *schema.ResourceData, meta
 			LanguageCode:   types.LanguageCode(d.Get("language_code").(string)),
 		}
 
-		if d.HasChanges("vocabulary_file_uri", "phrases") {
+		if d.HasChanges("phrases", "vocabulary_file_etag", "vocabulary_file_uri") {
 			if d.Get("vocabulary_file_uri").(string) != "" {
 				in.VocabularyFileUri = aws.String(d.Get("vocabulary_file_uri").(string))
 			} else {
@@ -184,6 +194,8 @@ func resourceVocabularyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if _, err := waitVocabularyUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return create.DiagError(names.Transcribe, create.ErrActionWaitingForUpdate, ResNameVocabulary, d.Id(), err)
 		}
+
+		setVocabularyFileETag(ctx, d, meta, "vocabulary_file_uri")
 	}
 
 	return resourceVocabularyRead(ctx, d, meta)
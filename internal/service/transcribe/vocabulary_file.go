@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transcribe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Vocabulary files are read from S3 once, when the vocabulary is created or updated.
+// The ETag of the S3 object is recorded so that later changes to the file content
+// are detected as drift even though the vocabulary_file_uri itself is unchanged.
+
+// parseVocabularyFileURI returns the bucket and key of an S3 vocabulary file URI.
+// Both s3://bucket/key and https:// (path-style or virtual-hosted-style) URIs are supported.
+func parseVocabularyFileURI(uri string) (string, string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", "", err
+	}
+
+	path := strings.TrimPrefix(u.Path, "/")
+
+	switch u.Scheme {
+	case "s3":
+		if u.Host != "" && path != "" {
+			return u.Host, path, nil
+		}
+	case "https":
+		if strings.HasPrefix(u.Host, "s3.") || strings.HasPrefix(u.Host, "s3-") {
+			if bucket, key, ok := strings.Cut(path, "/"); ok && bucket != "" && key != "" {
+				return bucket, key, nil
+			}
+		} else if bucket, _, ok := strings.Cut(u.Host, ".s3"); ok && bucket != "" && path != "" {
+			return bucket, path, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("unsupported vocabulary file URI (%s)", uri)
+}
+
+func findVocabularyFileETag(ctx context.Context, conn *s3.Client, uri string) (string, error) {
+	bucket, key, err := parseVocabularyFileURI(uri)
+	if err != nil {
+		return "", err
+	}
+
+	output, err := conn.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Trim(aws.ToString(output.ETag), `"`), nil
+}
+
+// setVocabularyFileETag records the ETag of the vocabulary file after a create or update.
+// Failure to read the object only disables drift detection, so it is logged rather than returned.
+func setVocabularyFileETag(ctx context.Context, d *schema.ResourceData, meta interface{}, uriKey string) {
+	uri := d.Get(uriKey).(string)
+	if uri == "" {
+		d.Set("vocabulary_file_etag", "")
+		return
+	}
+
+	etag, err := findVocabularyFileETag(ctx, meta.(*conns.AWSClient).S3Client(ctx), uri)
+	if err != nil {
+		log.Printf("[WARN] reading Transcribe vocabulary file (%s) ETag: %s", uri, err)
+		etag = ""
+	}
+
+	d.Set("vocabulary_file_etag", etag)
+}
+
+// vocabularyFileETagCustomizeDiff plans an update when the content of the vocabulary file
+// referenced by uriKey has changed since it was last loaded.
+func vocabularyFileETagCustomizeDiff(uriKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+
+		if d.HasChange(uriKey) {
+			return d.SetNewComputed("vocabulary_file_etag")
+		}
+
+		// An empty ETag means the file was never successfully read, e.g. after import.
+		uri, old := d.Get(uriKey).(string), d.Get("vocabulary_file_etag").(string)
+		if uri == "" || old == "" {
+			return nil
+		}
+
+		etag, err := findVocabularyFileETag(ctx, meta.(*conns.AWSClient).S3Client(ctx), uri)
+		if err != nil {
+			log.Printf("[WARN] reading Transcribe vocabulary file (%s) ETag: %s", uri, err)
+			return nil
+		}
+
+		if etag != old {
+			return d.SetNew("vocabulary_file_etag", etag)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transcribe
+
+import (
+	"testing"
+)
+
+func TestParseVocabularyFileURI(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		uri            string
+		expectedBucket string
+		expectedKey    string
+		wantErr        bool
+	}{
+		"s3": {
+			uri:            "s3://test-bucket/transcribe/test1.txt",
+			expectedBucket: "test-bucket",
+			expectedKey:    "transcribe/test1.txt",
+		},
+		"https path-style": {
+			uri:            "https://s3.us-west-2.amazonaws.com/test-bucket/transcribe/test1.txt", //lintignore:AWSAT003
+			expectedBucket: "test-bucket",
+			expectedKey:    "transcribe/test1.txt",
+		},
+		"https virtual-hosted-style": {
+			uri:            "https://test-bucket.s3.us-west-2.amazonaws.com/transcribe/test1.txt", //lintignore:AWSAT003
+			expectedBucket: "test-bucket",
+			expectedKey:    "transcribe/test1.txt",
+		},
+		"s3 no key": {
+			uri:     "s3://test-bucket/",
+			wantErr: true,
+		},
+		"unsupported scheme": {
+			uri:     "http://example.com/test1.txt",
+			wantErr: true,
+		},
+		"https not S3": {
+			uri:     "https://example.com/test1.txt",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bucket, key, err := parseVocabularyFileURI(testCase.uri)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("parseVocabularyFileURI(%q) err %t, want %t: %s", testCase.uri, got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if bucket != testCase.expectedBucket {
+				t.Errorf("bucket = %q, want %q", bucket, testCase.expectedBucket)
+			}
+
+			if key != testCase.expectedKey {
+				t.Errorf("key = %q, want %q", key, testCase.expectedKey)
+			}
+		})
+	}
+}
@@ -48,13 +48,14 @@ func TestAccTranscribeVocabulary_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "download_uri"),
 					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttrPair(resourceName, "vocabulary_file_etag", "aws_s3_object.object1", "etag"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"vocabulary_file_uri", "download_uri"},
+				ImportStateVerifyIgnore: []string{"vocabulary_file_etag", "vocabulary_file_uri", "download_uri"},
 			},
 		},
 	})
@@ -135,6 +136,54 @@ func TestAccTranscribeVocabulary_updateS3URI(t *testing.T) {
 	})
 }
 
+func TestAccTranscribeVocabulary_updateFileContent(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var vocabulary transcribe.GetVocabularyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary.test"
+	content1 := "Phrase\tSoundsLike\tIPA\tDisplayAs\nLos-Angeles\t\t\tLos Angeles\n"
+	content2 := "Phrase\tSoundsLike\tIPA\tDisplayAs\nAmazon-dot-com\t\t\tAmazon.com\n"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TranscribeEndpointID)
+			testAccVocabulariesPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranscribeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyConfig_fileContent(rName, content1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(ctx, resourceName, &vocabulary),
+					resource.TestCheckResourceAttrPair(resourceName, "vocabulary_file_etag", "aws_s3_object.test", "etag"),
+				),
+			},
+			{
+				// Only the S3 object changes; the vocabulary is updated on the following apply.
+				Config: testAccVocabularyConfig_fileContent(rName, content2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(ctx, resourceName, &vocabulary),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVocabularyConfig_fileContent(rName, content2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyExists(ctx, resourceName, &vocabulary),
+					resource.TestCheckResourceAttrPair(resourceName, "vocabulary_file_etag", "aws_s3_object.test", "etag"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTranscribeVocabulary_updateTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -368,6 +417,31 @@ resource "aws_transcribe_vocabulary" "test" {
 `, rName, fileName))
 }
 
+func testAccVocabularyConfig_fileContent(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "transcribe/content.txt"
+  content = %[2]q
+}
+
+resource "aws_transcribe_vocabulary" "test" {
+  vocabulary_name     = %[1]q
+  language_code       = "en-US"
+  vocabulary_file_uri = "s3://${aws_s3_bucket.test.id}/${aws_s3_object.test.key}"
+
+  depends_on = [
+    aws_s3_object.test
+  ]
+}
+`, rName, content)
+}
+
 func testAccVocabularyConfig_tags1(rName, key1, value1 string) string {
 	return acctest.ConfigCompose(
 		testAccVocabularyBaseConfig(rName),
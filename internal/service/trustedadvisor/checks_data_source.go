@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/trustedadvisor/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_trustedadvisor_checks", name="Checks")
+func dataSourceChecks() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceChecksRead,
+
+		Schema: map[string]*schema.Schema{
+			"aws_service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 30),
+			},
+			"checks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"aws_services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pillars": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"language": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RecommendationLanguage](),
+			},
+			"pillar": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RecommendationPillar](),
+			},
+			"source": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RecommendationSource](),
+			},
+		},
+	}
+}
+
+func dataSourceChecksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TrustedAdvisorClient(ctx)
+
+	input := &trustedadvisor.ListChecksInput{}
+
+	if v, ok := d.GetOk("aws_service"); ok {
+		input.AwsService = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("language"); ok {
+		input.Language = awstypes.RecommendationLanguage(v.(string))
+	}
+
+	if v, ok := d.GetOk("pillar"); ok {
+		input.Pillar = awstypes.RecommendationPillar(v.(string))
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		input.Source = awstypes.RecommendationSource(v.(string))
+	}
+
+	checks, err := findChecks(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Trusted Advisor Checks: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	if err := d.Set("checks", flattenCheckSummaries(checks)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting checks: %s", err)
+	}
+
+	return diags
+}
+
+func findChecks(ctx context.Context, conn *trustedadvisor.Client, input *trustedadvisor.ListChecksInput) ([]awstypes.CheckSummary, error) {
+	var output []awstypes.CheckSummary
+
+	pages := trustedadvisor.NewListChecksPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CheckSummaries...)
+	}
+
+	return output, nil
+}
+
+func flattenCheckSummaries(apiObjects []awstypes.CheckSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":          aws.ToString(apiObject.Arn),
+			"aws_services": apiObject.AwsServices,
+			"description":  aws.ToString(apiObject.Description),
+			"id":           aws.ToString(apiObject.Id),
+			"name":         aws.ToString(apiObject.Name),
+			"pillars":      flex.FlattenStringyValueList(apiObject.Pillars),
+			"source":       string(apiObject.Source),
+		})
+	}
+
+	return tfList
+}
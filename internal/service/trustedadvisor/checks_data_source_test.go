@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTrustedAdvisorChecksDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_trustedadvisor_checks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TrustedAdvisorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChecksDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "checks.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "checks.0.arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "checks.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "checks.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "checks.0.source", "ta_check"),
+				),
+			},
+		},
+	})
+}
+
+const testAccChecksDataSourceConfig_basic = `
+data "aws_trustedadvisor_checks" "test" {
+  pillar = "security"
+  source = "ta_check"
+}
+`
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/trustedadvisor/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_trustedadvisor_recommendation_resources", name="Recommendation Resources")
+func dataSourceRecommendationResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecommendationResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"recommendation_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"region_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"aws_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"region_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ResourceStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceRecommendationResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TrustedAdvisorClient(ctx)
+
+	recommendationID := d.Get("recommendation_identifier").(string)
+	input := &trustedadvisor.ListRecommendationResourcesInput{
+		RecommendationIdentifier: aws.String(recommendationID),
+	}
+
+	if v, ok := d.GetOk("region_code"); ok {
+		input.RegionCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = awstypes.ResourceStatus(v.(string))
+	}
+
+	resources, err := findRecommendationResources(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Trusted Advisor Recommendation (%s) Resources: %s", recommendationID, err)
+	}
+
+	d.SetId(recommendationID)
+	if err := d.Set("resources", flattenRecommendationResourceSummaries(resources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+	}
+
+	return diags
+}
+
+func findRecommendationResources(ctx context.Context, conn *trustedadvisor.Client, input *trustedadvisor.ListRecommendationResourcesInput) ([]awstypes.RecommendationResourceSummary, error) {
+	var output []awstypes.RecommendationResourceSummary
+
+	pages := trustedadvisor.NewListRecommendationResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RecommendationResourceSummaries...)
+	}
+
+	return output, nil
+}
+
+func flattenRecommendationResourceSummaries(apiObjects []awstypes.RecommendationResourceSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":             aws.ToString(apiObject.Arn),
+			"aws_resource_id": aws.ToString(apiObject.AwsResourceId),
+			"id":              aws.ToString(apiObject.Id),
+			"metadata":        apiObject.Metadata,
+			"region_code":     aws.ToString(apiObject.RegionCode),
+			"status":          string(apiObject.Status),
+		}
+
+		if v := apiObject.LastUpdatedAt; v != nil {
+			tfMap["last_updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/trustedadvisor/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_trustedadvisor_recommendations", name="Recommendations")
+func dataSourceRecommendations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecommendationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"aws_service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 30),
+			},
+			"check_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"pillar": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RecommendationPillar](),
+			},
+			"recommendations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"aws_services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"check_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle_stage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pillars": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resources_aggregates": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"ok_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"warning_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RecommendationSource](),
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RecommendationStatus](),
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RecommendationType](),
+			},
+		},
+	}
+}
+
+func dataSourceRecommendationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TrustedAdvisorClient(ctx)
+
+	input := &trustedadvisor.ListRecommendationsInput{}
+
+	if v, ok := d.GetOk("aws_service"); ok {
+		input.AwsService = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("check_identifier"); ok {
+		input.CheckIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pillar"); ok {
+		input.Pillar = awstypes.RecommendationPillar(v.(string))
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		input.Source = awstypes.RecommendationSource(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = awstypes.RecommendationStatus(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = awstypes.RecommendationType(v.(string))
+	}
+
+	recommendations, err := findRecommendations(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Trusted Advisor Recommendations: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	if err := d.Set("recommendations", flattenRecommendationSummaries(recommendations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting recommendations: %s", err)
+	}
+
+	return diags
+}
+
+func findRecommendations(ctx context.Context, conn *trustedadvisor.Client, input *trustedadvisor.ListRecommendationsInput) ([]awstypes.RecommendationSummary, error) {
+	var output []awstypes.RecommendationSummary
+
+	pages := trustedadvisor.NewListRecommendationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RecommendationSummaries...)
+	}
+
+	return output, nil
+}
+
+func flattenRecommendationSummaries(apiObjects []awstypes.RecommendationSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":             aws.ToString(apiObject.Arn),
+			"aws_services":    apiObject.AwsServices,
+			"check_arn":       aws.ToString(apiObject.CheckArn),
+			"id":              aws.ToString(apiObject.Id),
+			"lifecycle_stage": string(apiObject.LifecycleStage),
+			"name":            aws.ToString(apiObject.Name),
+			"pillars":         flex.FlattenStringyValueList(apiObject.Pillars),
+			"source":          string(apiObject.Source),
+			"status":          string(apiObject.Status),
+			"type":            string(apiObject.Type),
+		}
+
+		if v := apiObject.LastUpdatedAt; v != nil {
+			tfMap["last_updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.ResourcesAggregates; v != nil {
+			tfMap["resources_aggregates"] = []interface{}{map[string]interface{}{
+				"error_count":   aws.ToInt64(v.ErrorCount),
+				"ok_count":      aws.ToInt64(v.OkCount),
+				"warning_count": aws.ToInt64(v.WarningCount),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
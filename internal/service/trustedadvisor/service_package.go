@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package trustedadvisor
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/trustedadvisor"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*trustedadvisor.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
+
+	return trustedadvisor.NewFromConfig(cfg, func(o *trustedadvisor.Options) {
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		} else if config["partition"].(string) == names.StandardPartitionID {
+			// The Trusted Advisor API is only available in the AWS Commercial us-east-1 Region.
+			o.Region = names.USEast1RegionID
+		}
+	}), nil
+}
@@ -0,0 +1,53 @@
+// Code generated by internal/generate/servicepackages/main.go; DO NOT EDIT.
+
+package trustedadvisor
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type servicePackage struct{}
+
+func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
+	return []*types.ServicePackageFrameworkDataSource{}
+}
+
+func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
+	return []*types.ServicePackageFrameworkResource{}
+}
+
+func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceChecks,
+			TypeName: "aws_trustedadvisor_checks",
+			Name:     "Checks",
+		},
+		{
+			Factory:  dataSourceRecommendationResources,
+			TypeName: "aws_trustedadvisor_recommendation_resources",
+			Name:     "Recommendation Resources",
+		},
+		{
+			Factory:  dataSourceRecommendations,
+			TypeName: "aws_trustedadvisor_recommendations",
+			Name:     "Recommendations",
+		},
+	}
+}
+
+func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{}
+}
+
+func (p *servicePackage) ServicePackageName() string {
+	return names.TrustedAdvisor
+}
+
+func ServicePackage(ctx context.Context) conns.ServicePackage {
+	return &servicePackage{}
+}
@@ -5,11 +5,17 @@ package xray
 
 // Exports for use in tests only.
 var (
-	FindEncryptionConfig   = findEncryptionConfig
-	FindGroupByARN         = findGroupByARN
-	FindSamplingRuleByName = findSamplingRuleByName
+	FindEncryptionConfig        = findEncryptionConfig
+	FindGroupByARN              = findGroupByARN
+	FindIndexingRuleByName      = findIndexingRuleByName
+	FindResourcePolicyByName    = findResourcePolicyByName
+	FindSamplingRuleByName      = findSamplingRuleByName
+	FindTraceSegmentDestination = findTraceSegmentDestination
 
-	ResourceEncryptionConfig = resourceEncryptionConfig
-	ResourceGroup            = resourceGroup
-	ResourceSamplingRule     = resourceSamplingRule
+	ResourceEncryptionConfig        = resourceEncryptionConfig
+	ResourceGroup                   = resourceGroup
+	ResourceIndexingRule            = resourceIndexingRule
+	ResourceResourcePolicy          = resourceResourcePolicy
+	ResourceSamplingRule            = resourceSamplingRule
+	ResourceTraceSegmentDestination = resourceTraceSegmentDestination
 )
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package xray
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/aws/aws-sdk-go-v2/service/xray/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_xray_indexing_rule", name="Indexing Rule")
+func resourceIndexingRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIndexingRulePut,
+		ReadWithoutTimeout:   resourceIndexingRuleRead,
+		UpdateWithoutTimeout: resourceIndexingRulePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"probabilistic": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actual_sampling_percentage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"desired_sampling_percentage": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIndexingRulePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).XRayClient(ctx)
+
+	// Indexing rules are predefined by X-Ray and can only be updated.
+	name := d.Get("name").(string)
+	input := &xray.UpdateIndexingRuleInput{
+		Name: aws.String(name),
+		Rule: expandIndexingRuleValueUpdate(d.Get("probabilistic").([]interface{})),
+	}
+
+	_, err := conn.UpdateIndexingRule(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating XRay Indexing Rule (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return append(diags, resourceIndexingRuleRead(ctx, d, meta)...)
+}
+
+func resourceIndexingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).XRayClient(ctx)
+
+	rule, err := findIndexingRuleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] XRay Indexing Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading XRay Indexing Rule (%s): %s", d.Id(), err)
+	}
+
+	if rule.ModifiedAt != nil {
+		d.Set("modified_at", aws.ToTime(rule.ModifiedAt).Format(time.RFC3339))
+	}
+	d.Set("name", rule.Name)
+	if err := d.Set("probabilistic", flattenIndexingRuleValue(rule.Rule)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting probabilistic: %s", err)
+	}
+
+	return diags
+}
+
+func findIndexingRuleByName(ctx context.Context, conn *xray.Client, name string) (*types.IndexingRule, error) {
+	input := &xray.GetIndexingRulesInput{}
+
+	return findIndexingRule(ctx, conn, input, func(v *types.IndexingRule) bool {
+		return aws.ToString(v.Name) == name
+	})
+}
+
+func findIndexingRule(ctx context.Context, conn *xray.Client, input *xray.GetIndexingRulesInput, filter tfslices.Predicate[*types.IndexingRule]) (*types.IndexingRule, error) {
+	output, err := findIndexingRules(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findIndexingRules(ctx context.Context, conn *xray.Client, input *xray.GetIndexingRulesInput, filter tfslices.Predicate[*types.IndexingRule]) ([]types.IndexingRule, error) {
+	var output []types.IndexingRule
+
+	err := getIndexingRulesPages(ctx, conn, input, func(page *xray.GetIndexingRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IndexingRules {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandIndexingRuleValueUpdate(tfList []interface{}) types.IndexingRuleValueUpdate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.IndexingRuleValueUpdateMemberProbabilistic{
+		Value: types.ProbabilisticRuleValueUpdate{
+			DesiredSamplingPercentage: aws.Float64(tfMap["desired_sampling_percentage"].(float64)),
+		},
+	}
+}
+
+func flattenIndexingRuleValue(apiObject types.IndexingRuleValue) []interface{} {
+	v, ok := apiObject.(*types.IndexingRuleValueMemberProbabilistic)
+	if !ok {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"actual_sampling_percentage":  aws.ToFloat64(v.Value.ActualSamplingPercentage),
+		"desired_sampling_percentage": aws.ToFloat64(v.Value.DesiredSamplingPercentage),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package xray_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/xray/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfxray "github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccXRayIndexingRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.IndexingRule
+	resourceName := "aws_xray_indexing_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.XRayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexingRuleConfig_basic(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIndexingRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "modified_at"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Default"),
+					resource.TestCheckResourceAttr(resourceName, "probabilistic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "probabilistic.0.desired_sampling_percentage", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIndexingRuleConfig_basic(5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIndexingRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "probabilistic.0.desired_sampling_percentage", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIndexingRuleExists(ctx context.Context, n string, v *types.IndexingRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).XRayClient(ctx)
+
+		output, err := tfxray.FindIndexingRuleByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIndexingRuleConfig_basic(desiredSamplingPercentage float64) string {
+	return fmt.Sprintf(`
+resource "aws_xray_indexing_rule" "test" {
+  name = "Default"
+
+  probabilistic {
+    desired_sampling_percentage = %[1]g
+  }
+}
+`, desiredSamplingPercentage)
+}
@@ -0,0 +1,27 @@
+// Code generated by "internal/generate/listpages/main.go -AWSSDKVersion=2 -ListOps=GetIndexingRules"; DO NOT EDIT.
+
+package xray
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+)
+
+func getIndexingRulesPages(ctx context.Context, conn *xray.Client, input *xray.GetIndexingRulesInput, fn func(*xray.GetIndexingRulesOutput, bool) bool) error {
+	for {
+		output, err := conn.GetIndexingRules(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -40,6 +40,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  resourceIndexingRule,
+			TypeName: "aws_xray_indexing_rule",
+			Name:     "Indexing Rule",
+		},
+		{
+			Factory:  resourceResourcePolicy,
+			TypeName: "aws_xray_resource_policy",
+			Name:     "Resource Policy",
+		},
 		{
 			Factory:  resourceSamplingRule,
 			TypeName: "aws_xray_sampling_rule",
@@ -48,6 +58,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  resourceTraceSegmentDestination,
+			TypeName: "aws_xray_trace_segment_destination",
+			Name:     "Trace Segment Destination",
+		},
 	}
 }
 
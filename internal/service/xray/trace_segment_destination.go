@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package xray
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	"github.com/aws/aws-sdk-go-v2/service/xray/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_xray_trace_segment_destination", name="Trace Segment Destination")
+func resourceTraceSegmentDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTraceSegmentDestinationPut,
+		ReadWithoutTimeout:   resourceTraceSegmentDestinationRead,
+		UpdateWithoutTimeout: resourceTraceSegmentDestinationPut,
+		DeleteWithoutTimeout: resourceTraceSegmentDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.TraceSegmentDestination](),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTraceSegmentDestinationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).XRayClient(ctx)
+
+	input := &xray.UpdateTraceSegmentDestinationInput{
+		Destination: types.TraceSegmentDestination(d.Get("destination").(string)),
+	}
+
+	_, err := conn.UpdateTraceSegmentDestination(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating XRay Trace Segment Destination: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitTraceSegmentDestinationActive(ctx, conn, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for XRay Trace Segment Destination (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTraceSegmentDestinationRead(ctx, d, meta)...)
+}
+
+func resourceTraceSegmentDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).XRayClient(ctx)
+
+	output, err := findTraceSegmentDestination(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] XRay Trace Segment Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading XRay Trace Segment Destination (%s): %s", d.Id(), err)
+	}
+
+	d.Set("destination", output.Destination)
+	d.Set("status", output.Status)
+
+	return diags
+}
+
+func resourceTraceSegmentDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).XRayClient(ctx)
+
+	if types.TraceSegmentDestination(d.Get("destination").(string)) == types.TraceSegmentDestinationXRay {
+		return diags
+	}
+
+	// Deleting the resource restores the default destination.
+	log.Printf("[INFO] Resetting XRay Trace Segment Destination: %s", d.Id())
+	_, err := conn.UpdateTraceSegmentDestination(ctx, &xray.UpdateTraceSegmentDestinationInput{
+		Destination: types.TraceSegmentDestinationXRay,
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resetting XRay Trace Segment Destination (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTraceSegmentDestinationActive(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for XRay Trace Segment Destination (%s) reset: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findTraceSegmentDestination(ctx context.Context, conn *xray.Client) (*xray.GetTraceSegmentDestinationOutput, error) {
+	input := &xray.GetTraceSegmentDestinationInput{}
+
+	output, err := conn.GetTraceSegmentDestination(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusTraceSegmentDestination(ctx context.Context, conn *xray.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTraceSegmentDestination(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTraceSegmentDestinationActive(ctx context.Context, conn *xray.Client, timeout time.Duration) (*xray.GetTraceSegmentDestinationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.TraceSegmentDestinationStatusPending),
+		Target:  enum.Slice(types.TraceSegmentDestinationStatusActive),
+		Refresh: statusTraceSegmentDestination(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*xray.GetTraceSegmentDestinationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package xray_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/xray"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfxray "github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccXRayTraceSegmentDestination_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v xray.GetTraceSegmentDestinationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_xray_trace_segment_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.XRayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTraceSegmentDestinationConfig_cloudWatchLogs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTraceSegmentDestinationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination", "CloudWatchLogs"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTraceSegmentDestinationConfig_xRay(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTraceSegmentDestinationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination", "XRay"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTraceSegmentDestinationExists(ctx context.Context, n string, v *xray.GetTraceSegmentDestinationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No XRay Trace Segment Destination ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).XRayClient(ctx)
+
+		output, err := tfxray.FindTraceSegmentDestination(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTraceSegmentDestinationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_log_resource_policy" "test" {
+  policy_name = %[1]q
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "TransactionSearchXRayAccess"
+      Effect = "Allow"
+      Principal = {
+        Service = "xray.amazonaws.com"
+      }
+      Action = "logs:PutLogEvents"
+      Resource = [
+        "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:log-group:aws/spans:*",
+        "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:log-group:/aws/application-signals/data:*",
+      ]
+      Condition = {
+        ArnLike = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:xray:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:*"
+        }
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccTraceSegmentDestinationConfig_cloudWatchLogs(rName string) string {
+	return acctest.ConfigCompose(testAccTraceSegmentDestinationConfig_base(rName), `
+resource "aws_xray_trace_segment_destination" "test" {
+  destination = "CloudWatchLogs"
+
+  depends_on = [aws_cloudwatch_log_resource_policy.test]
+}
+`)
+}
+
+func testAccTraceSegmentDestinationConfig_xRay(rName string) string {
+	return acctest.ConfigCompose(testAccTraceSegmentDestinationConfig_base(rName), `
+resource "aws_xray_trace_segment_destination" "test" {
+  destination = "XRay"
+
+  depends_on = [aws_cloudwatch_log_resource_policy.test]
+}
+`)
+}
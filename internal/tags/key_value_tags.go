@@ -41,6 +41,12 @@ type IgnoreConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// DeletionProtectionConfig contains the tag identifying resources that must not be deleted.
+type DeletionProtectionConfig struct {
+	Key   string
+	Value string
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -86,6 +92,36 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// Protects returns true if the given tags contain the configuration's tag key
+// and, if a value is configured, the tag's value matches.
+// A nil configuration protects nothing.
+func (dpc *DeletionProtectionConfig) Protects(tags KeyValueTags) bool {
+	if dpc == nil || dpc.Key == "" {
+		return false
+	}
+
+	if !tags.KeyExists(dpc.Key) {
+		return false
+	}
+
+	if dpc.Value == "" {
+		return true
+	}
+
+	v := tags.KeyValue(dpc.Key)
+
+	return v != nil && *v == dpc.Value
+}
+
+// String returns the configuration's tag in "key" or "key=value" form.
+func (dpc *DeletionProtectionConfig) String() string {
+	if dpc.Value == "" {
+		return dpc.Key
+	}
+
+	return dpc.Key + "=" + dpc.Value
+}
+
 // IgnoreAWS returns non-AWS tag keys.
 func (tags KeyValueTags) IgnoreAWS() KeyValueTags { // nosemgrep:ci.aws-in-func-name
 	result := make(KeyValueTags)
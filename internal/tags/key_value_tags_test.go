@@ -317,6 +317,91 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDeletionProtectionConfigProtects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name   string
+		tags   KeyValueTags
+		config *DeletionProtectionConfig
+		want   bool
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"tf-protected": "true",
+			}),
+			config: nil,
+			want:   false,
+		},
+		{
+			name: "empty config",
+			tags: New(ctx, map[string]string{
+				"tf-protected": "true",
+			}),
+			config: &DeletionProtectionConfig{},
+			want:   false,
+		},
+		{
+			name: "no tags",
+			tags: nil,
+			config: &DeletionProtectionConfig{
+				Key: "tf-protected",
+			},
+			want: false,
+		},
+		{
+			name: "key matching",
+			tags: New(ctx, map[string]string{
+				"key1":         "value1",
+				"tf-protected": "yes",
+			}),
+			config: &DeletionProtectionConfig{
+				Key: "tf-protected",
+			},
+			want: true,
+		},
+		{
+			name: "key and value matching",
+			tags: New(ctx, map[string]string{
+				"key1":         "value1",
+				"tf-protected": "true",
+			}),
+			config: &DeletionProtectionConfig{
+				Key:   "tf-protected",
+				Value: "true",
+			},
+			want: true,
+		},
+		{
+			name: "only key matching",
+			tags: New(ctx, map[string]string{
+				"key1":         "value1",
+				"tf-protected": "false",
+			}),
+			config: &DeletionProtectionConfig{
+				Key:   "tf-protected",
+				Value: "true",
+			},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.Protects(testCase.tags)
+
+			if got != testCase.want {
+				t.Errorf("got %t; want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
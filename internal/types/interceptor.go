@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// InterceptorWhen represents the point in the CRUD request lifecycle that an interceptor is run.
+// Multiple values can be ORed together.
+type InterceptorWhen uint16
+
+const (
+	InterceptorBefore  InterceptorWhen = 1 << iota // Interceptor is invoked before call to method in schema
+	InterceptorAfter                               // Interceptor is invoked after successful call to method in schema
+	InterceptorOnError                             // Interceptor is invoked after unsuccessful call to method in schema
+	InterceptorFinally                             // Interceptor is invoked after After or OnError
+)
+
+// InterceptorWhy represents the operation(s) that an interceptor is run for.
+// Multiple values can be ORed together.
+type InterceptorWhy uint16
+
+const (
+	InterceptorCreate InterceptorWhy = 1 << iota // Interceptor is invoked for a Create call
+	InterceptorRead                              // Interceptor is invoked for a Read call
+	InterceptorUpdate                            // Interceptor is invoked for an Update call
+	InterceptorDelete                            // Interceptor is invoked for a Delete call
+	InterceptorImport                            // Interceptor is invoked for an Import call
+	InterceptorDiff                              // Interceptor is invoked for a CustomizeDiff call
+
+	InterceptorAllOps = InterceptorCreate | InterceptorRead | InterceptorUpdate | InterceptorDelete // Interceptor is invoked for all CRUD calls
+)
+
+// SDKResourceData is the subset of schema.ResourceData functionality available to Plugin SDK interceptors.
+// During CustomizeDiff Set sets the planned value of a computed attribute.
+type SDKResourceData interface {
+	Get(key string) any
+	GetChange(key string) (any, any)
+	GetRawConfig() cty.Value
+	GetRawPlan() cty.Value
+	GetRawState() cty.Value
+	HasChange(key string) bool
+	Id() string
+	Set(string, any) error
+}
+
+// SDKInterceptorFunc is functionality invoked during the Plugin SDK CRUD request lifecycle.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+type SDKInterceptorFunc func(context.Context, SDKResourceData, any, InterceptorWhen, InterceptorWhy, diag.Diagnostics) (context.Context, diag.Diagnostics)
+
+// ServicePackageSDKInterceptor represents an interceptor contributed by a service package
+// to the Plugin SDK resources that it implements.
+type ServicePackageSDKInterceptor struct {
+	Interceptor SDKInterceptorFunc
+	TypeNames   []string // Resource types the interceptor is run for. All the service package's resources if empty.
+	When        InterceptorWhen
+	Why         InterceptorWhy
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +18,12 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceFound represents how to check that a newly created resource is visible to the service API.
+type ServicePackageResourceFound struct {
+	Func    func(context.Context, SDKResourceData, any) error // Returns an error for which tfresource.NotFound is true while the resource isn't visible
+	Timeout time.Duration                                     // How long to wait for the resource to be visible. Defaults to 2 minutes
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -49,4 +56,5 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Found    *ServicePackageResourceFound // Wait for the resource to be visible after creation
 }
@@ -7,8 +7,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -38,6 +40,13 @@ func main() {
 		serveOpts...,
 	)
 
+	// Send any OpenTelemetry spans still queued before exiting.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := conns.FlushTracers(ctx); err != nil {
+		log.Printf("[WARN] flushing OpenTelemetry spans: %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
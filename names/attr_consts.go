@@ -5,11 +5,13 @@ package names
 
 const (
 	AttrARN         = "arn"
+	AttrAssumeRole  = "assume_role"
 	AttrDescription = "description"
 	AttrEnabled     = "enabled"
 	AttrID          = "id" // Should be explicitly declared only for Framework resources
 	AttrKMSKeyARN   = "kms_key_arn"
 	AttrName        = "name"
+	AttrRegion      = "region"
 	AttrTags        = "tags"
 	AttrTagsAll     = "tags_all"
 	AttrTimeouts    = "timeouts" // Should be explicitly declared only for Framework resources
@@ -20,10 +20,13 @@ const (
 	AppStream                    = "appstream"
 	AppSync                      = "appsync"
 	ApplicationInsights          = "applicationinsights"
+	ApplicationSignals           = "applicationsignals"
+	Artifact                     = "artifact"
 	Athena                       = "athena"
 	AuditManager                 = "auditmanager"
 	AutoScaling                  = "autoscaling"
 	AutoScalingPlans             = "autoscalingplans"
+	B2BI                         = "b2bi"
 	BCMDataExports               = "bcmdataexports"
 	Backup                       = "backup"
 	Batch                        = "batch"
@@ -95,6 +98,7 @@ const (
 	ElasticBeanstalk             = "elasticbeanstalk"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -108,8 +112,10 @@ const (
 	Glue                         = "glue"
 	Grafana                      = "grafana"
 	Greengrass                   = "greengrass"
+	GreengrassV2                 = "greengrassv2"
 	GroundStation                = "groundstation"
 	GuardDuty                    = "guardduty"
+	Health                       = "health"
 	HealthLake                   = "healthlake"
 	IAM                          = "iam"
 	IVS                          = "ivs"
@@ -121,11 +127,13 @@ const (
 	InternetMonitor              = "internetmonitor"
 	IoT                          = "iot"
 	IoTAnalytics                 = "iotanalytics"
+	IoTDeviceAdvisor             = "iotdeviceadvisor"
 	IoTEvents                    = "iotevents"
 	KMS                          = "kms"
 	Kafka                        = "kafka"
 	KafkaConnect                 = "kafkaconnect"
 	Kendra                       = "kendra"
+	KendraRanking                = "kendraranking"
 	Keyspaces                    = "keyspaces"
 	Kinesis                      = "kinesis"
 	KinesisAnalytics             = "kinesisanalytics"
@@ -219,11 +227,17 @@ const (
 	Shield                       = "shield"
 	Signer                       = "signer"
 	SimpleDB                     = "simpledb"
+	Snowball                     = "snowball"
 	StorageGateway               = "storagegateway"
+	Support                      = "support"
+	SupportApp                   = "supportapp"
 	Synthetics                   = "synthetics"
+	Textract                     = "textract"
+	TimestreamInfluxDB           = "timestreaminfluxdb"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
 	Transfer                     = "transfer"
+	TrustedAdvisor               = "trustedadvisor"
 	VPCLattice                   = "vpclattice"
 	VerifiedPermissions          = "verifiedpermissions"
 	WAF                          = "waf"
@@ -256,10 +270,13 @@ const (
 	AppStreamServiceID                    = "AppStream"
 	AppSyncServiceID                      = "AppSync"
 	ApplicationInsightsServiceID          = "Application Insights"
+	ApplicationSignalsServiceID           = "Application Signals"
+	ArtifactServiceID                     = "Artifact"
 	AthenaServiceID                       = "Athena"
 	AuditManagerServiceID                 = "AuditManager"
 	AutoScalingServiceID                  = "Auto Scaling"
 	AutoScalingPlansServiceID             = "Auto Scaling Plans"
+	B2BIServiceID                         = "b2bi"
 	BCMDataExportsServiceID               = "BCM Data Exports"
 	BackupServiceID                       = "Backup"
 	BatchServiceID                        = "Batch"
@@ -331,6 +348,7 @@ const (
 	ElasticBeanstalkServiceID             = "Elastic Beanstalk"
 	ElasticTranscoderServiceID            = "Elastic Transcoder"
 	ElasticsearchServiceID                = "Elasticsearch Service"
+	EntityResolutionServiceID             = "EntityResolution"
 	EventsServiceID                       = "EventBridge"
 	EvidentlyServiceID                    = "Evidently"
 	FISServiceID                          = "fis"
@@ -344,8 +362,10 @@ const (
 	GlueServiceID                         = "Glue"
 	GrafanaServiceID                      = "grafana"
 	GreengrassServiceID                   = "Greengrass"
+	GreengrassV2ServiceID                 = "GreengrassV2"
 	GroundStationServiceID                = "GroundStation"
 	GuardDutyServiceID                    = "GuardDuty"
+	HealthServiceID                       = "Health"
 	HealthLakeServiceID                   = "HealthLake"
 	IAMServiceID                          = "IAM"
 	IVSServiceID                          = "ivs"
@@ -357,11 +377,13 @@ const (
 	InternetMonitorServiceID              = "InternetMonitor"
 	IoTServiceID                          = "IoT"
 	IoTAnalyticsServiceID                 = "IoTAnalytics"
+	IoTDeviceAdvisorServiceID             = "IotDeviceAdvisor"
 	IoTEventsServiceID                    = "IoT Events"
 	KMSServiceID                          = "KMS"
 	KafkaServiceID                        = "Kafka"
 	KafkaConnectServiceID                 = "KafkaConnect"
 	KendraServiceID                       = "kendra"
+	KendraRankingServiceID                = "Kendra Ranking"
 	KeyspacesServiceID                    = "Keyspaces"
 	KinesisServiceID                      = "Kinesis"
 	KinesisAnalyticsServiceID             = "Kinesis Analytics"
@@ -455,11 +477,17 @@ const (
 	ShieldServiceID                       = "Shield"
 	SignerServiceID                       = "signer"
 	SimpleDBServiceID                     = "SimpleDB"
+	SnowballServiceID                     = "Snowball"
 	StorageGatewayServiceID               = "Storage Gateway"
+	SupportServiceID                      = "Support"
+	SupportAppServiceID                   = "Support App"
 	SyntheticsServiceID                   = "synthetics"
+	TextractServiceID                     = "Textract"
+	TimestreamInfluxDBServiceID           = "Timestream InfluxDB"
 	TimestreamWriteServiceID              = "Timestream Write"
 	TranscribeServiceID                   = "Transcribe"
 	TransferServiceID                     = "Transfer"
+	TrustedAdvisorServiceID               = "TrustedAdvisor"
 	VPCLatticeServiceID                   = "VPC Lattice"
 	VerifiedPermissionsServiceID          = "VerifiedPermissions"
 	WAFServiceID                          = "WAF"
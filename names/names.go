@@ -32,6 +32,7 @@ const (
 	AmplifyEndpointID                    = "amplify"
 	APIGatewayID                         = "apigateway"
 	APIGatewayV2EndpointID               = "apigateway"
+	ArtifactEndpointID                   = "artifact"
 	AthenaEndpointID                     = "athena"
 	AuditManagerEndpointID               = "auditmanager"
 	AutoScalingPlansEndpointID           = "autoscaling-plans"
@@ -189,6 +190,31 @@ func IsOptInRegion(region string) bool {
 	}
 }
 
+// IsGlobalService returns whether the specified service's resources are global, i.e. not located in an AWS Region.
+func IsGlobalService(service string) bool {
+	switch service {
+	case Account,
+		Budgets,
+		CE,
+		CloudFront,
+		CUR,
+		GlobalAccelerator,
+		IAM,
+		NetworkManager,
+		Organizations,
+		Pricing,
+		Route53,
+		Route53Domains,
+		Route53RecoveryControlConfig,
+		Route53RecoveryReadiness,
+		Shield,
+		WAF:
+		return true
+	default:
+		return false
+	}
+}
+
 func PartitionForRegion(region string) string {
 	switch region {
 	case "":